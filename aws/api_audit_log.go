@@ -0,0 +1,288 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// apiAuditLogRedacted replaces the value of redacted request parameters.
+const apiAuditLogRedacted = "REDACTED"
+
+// apiAuditLogSecretKeys are lowercase substrings of request parameter names
+// whose values are redacted from the API audit log.
+var apiAuditLogSecretKeys = []string{
+	"authtoken",
+	"passphrase",
+	"password",
+	"plaintext",
+	"privatekey",
+	"secret",
+	"sessiontoken",
+	"webidentitytoken",
+}
+
+// apiAuditLogSecretKeySuffixes are lowercase suffixes of request parameter
+// names whose values are redacted from the API audit log, e.g. the S3
+// SSECustomerKey and CopySourceSSECustomerKey parameters.
+var apiAuditLogSecretKeySuffixes = []string{
+	"customerkey",
+}
+
+// apiAuditLogValueKeys are lowercase request parameter names holding
+// arbitrary user data, e.g. SSM parameter and tag values, EC2 user data,
+// Lambda and ECS environment variables or CloudFormation parameters, whose
+// values are redacted from the API audit log.
+var apiAuditLogValueKeys = []string{
+	"environment",
+	"parameters",
+	"parametervalue",
+	"userdata",
+	"value",
+	"values",
+	"variables",
+}
+
+// requestResourceSuffixes are suffixes of request parameter names
+// identifying the resource an API call acts on, in order of preference.
+var requestResourceSuffixes = []string{
+	"Arn",
+	"ARN",
+	"Id",
+	"Name",
+	"Bucket",
+	"Url",
+}
+
+// apiAuditLogEntry is a single line of the API audit log.
+type apiAuditLogEntry struct {
+	Time       string      `json:"time"`
+	Service    string      `json:"service"`
+	Operation  string      `json:"operation"`
+	Region     string      `json:"region"`
+	Resource   string      `json:"resource,omitempty"`
+	DurationMs int64       `json:"duration_ms"`
+	Retries    int         `json:"retries"`
+	HTTPStatus int         `json:"http_status,omitempty"`
+	ErrorCode  string      `json:"error_code,omitempty"`
+	RequestID  string      `json:"request_id,omitempty"`
+	Params     interface{} `json:"params,omitempty"`
+}
+
+// apiAuditLogger writes one JSON line per completed AWS API call.
+type apiAuditLogger struct {
+	sync.Mutex
+
+	w io.Writer
+}
+
+// completeHandler logs a request once all of its attempts are done.
+func (l *apiAuditLogger) completeHandler(r *request.Request) {
+	entry := apiAuditLogEntry{
+		Time:       r.Time.UTC().Format(time.RFC3339Nano),
+		Service:    retryServiceName(r),
+		Region:     aws.StringValue(r.Config.Region),
+		Resource:   requestResource(r.Params),
+		DurationMs: int64(time.Since(r.Time) / time.Millisecond),
+		Retries:    r.RetryCount,
+		RequestID:  r.RequestID,
+		Params:     apiAuditLogParams(r.Params),
+	}
+
+	if r.Operation != nil {
+		entry.Operation = r.Operation.Name
+	}
+
+	if r.HTTPResponse != nil {
+		entry.HTTPStatus = r.HTTPResponse.StatusCode
+	}
+
+	if err, ok := r.Error.(awserr.Error); ok {
+		entry.ErrorCode = err.Code()
+	}
+
+	line, err := json.Marshal(entry)
+
+	if err != nil {
+		log.Printf("[WARN] Error encoding API audit log entry for %s %s: %s", entry.Service, entry.Operation, err)
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	if _, err := l.w.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] Error writing API audit log entry for %s %s: %s", entry.Service, entry.Operation, err)
+	}
+}
+
+// apiAuditLogParams returns the request parameters of an API call with
+// secret values and user data redacted, dropping unset parameters.
+func apiAuditLogParams(params interface{}) interface{} {
+	return apiAuditLogValue(reflect.ValueOf(params))
+}
+
+// apiAuditLogValue returns a request parameter value for the API audit log.
+// Values of free-form maps, binary data and streamed payloads are redacted,
+// as are values of parameters whose names indicate secrets or user data.
+func apiAuditLogValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Kind() == reflect.Interface {
+			return apiAuditLogRedacted
+		}
+
+		return apiAuditLogValue(v.Elem())
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return t.Format(time.RFC3339Nano)
+		}
+
+		m := make(map[string]interface{})
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			if field.PkgPath != "" {
+				continue
+			}
+
+			value := apiAuditLogValue(v.Field(i))
+
+			if value == nil {
+				continue
+			}
+
+			if apiAuditLogSecretKey(field.Name) {
+				value = apiAuditLogRedacted
+			}
+
+			m[field.Name] = value
+		}
+
+		return m
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+
+		m := make(map[string]interface{}, v.Len())
+
+		for _, key := range v.MapKeys() {
+			m[fmt.Sprint(key.Interface())] = apiAuditLogRedacted
+		}
+
+		return m
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return apiAuditLogRedacted
+		}
+
+		l := make([]interface{}, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			l[i] = apiAuditLogValue(v.Index(i))
+		}
+
+		return l
+	}
+
+	return v.Interface()
+}
+
+// apiAuditLogSecretKey returns whether a request parameter holds a secret or
+// user data.
+func apiAuditLogSecretKey(key string) bool {
+	key = strings.ToLower(key)
+
+	for _, secretKey := range apiAuditLogSecretKeys {
+		if strings.Contains(key, secretKey) {
+			return true
+		}
+	}
+
+	for _, suffix := range apiAuditLogSecretKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+
+	for _, valueKey := range apiAuditLogValueKeys {
+		if key == valueKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requestResource returns the identifier of the resource an API call
+// acts on, taken from the first non-empty top level string parameter with
+// a known identifier suffix.
+func requestResource(params interface{}) string {
+	v := reflect.Indirect(reflect.ValueOf(params))
+
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	for _, suffix := range requestResourceSuffixes {
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			if field.PkgPath != "" || !strings.HasSuffix(field.Name, suffix) || apiAuditLogSecretKey(field.Name) {
+				continue
+			}
+
+			if value, ok := v.Field(i).Interface().(*string); ok && aws.StringValue(value) != "" {
+				return aws.StringValue(value)
+			}
+		}
+	}
+
+	return ""
+}
+
+// configureAPIAuditLog installs the API audit log handler on a session
+// before any service client is created from it.
+func (c *Config) configureAPIAuditLog(sess *session.Session) error {
+	if c.APIAuditLogPath == "" {
+		return nil
+	}
+
+	f, err := os.OpenFile(c.APIAuditLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return fmt.Errorf("error opening API audit log (%s): %s", c.APIAuditLogPath, err)
+	}
+
+	logger := &apiAuditLogger{w: f}
+
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.APIAuditLog",
+		Fn:   logger.completeHandler,
+	})
+
+	return nil
+}
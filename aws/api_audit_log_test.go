@@ -0,0 +1,317 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+func TestApiAuditLogParams(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Params   interface{}
+		Expected interface{}
+	}{
+		{
+			Name:     "nil",
+			Expected: nil,
+		},
+		{
+			Name: "password",
+			Params: &rds.CreateDBInstanceInput{
+				AllocatedStorage:     aws.Int64(20),
+				DBInstanceIdentifier: aws.String("test"),
+				MasterUserPassword:   aws.String("hunter2"),
+				Tags: []*rds.Tag{
+					{Key: aws.String("Name"), Value: aws.String("test")},
+				},
+			},
+			Expected: map[string]interface{}{
+				"AllocatedStorage":     int64(20),
+				"DBInstanceIdentifier": "test",
+				"MasterUserPassword":   apiAuditLogRedacted,
+				"Tags": []interface{}{
+					map[string]interface{}{"Key": "Name", "Value": apiAuditLogRedacted},
+				},
+			},
+		},
+		{
+			Name: "secrets manager secret",
+			Params: &secretsmanager.PutSecretValueInput{
+				SecretId:     aws.String("test"),
+				SecretBinary: []byte("hunter2"),
+				SecretString: aws.String("hunter2"),
+			},
+			Expected: map[string]interface{}{
+				"SecretId":     apiAuditLogRedacted,
+				"SecretBinary": apiAuditLogRedacted,
+				"SecretString": apiAuditLogRedacted,
+			},
+		},
+		{
+			Name: "ssm SecureString parameter",
+			Params: &ssm.PutParameterInput{
+				Name:  aws.String("test"),
+				Type:  aws.String(ssm.ParameterTypeSecureString),
+				Value: aws.String("hunter2"),
+			},
+			Expected: map[string]interface{}{
+				"Name":  "test",
+				"Type":  ssm.ParameterTypeSecureString,
+				"Value": apiAuditLogRedacted,
+			},
+		},
+		{
+			Name: "ssm command parameters",
+			Params: &ssm.SendCommandInput{
+				DocumentName: aws.String("AWS-RunShellScript"),
+				Parameters: map[string][]*string{
+					"commands": {aws.String("echo hunter2")},
+				},
+			},
+			Expected: map[string]interface{}{
+				"DocumentName": "AWS-RunShellScript",
+				"Parameters":   apiAuditLogRedacted,
+			},
+		},
+		{
+			Name: "s3 customer key",
+			Params: &s3.CopyObjectInput{
+				Bucket:                   aws.String("test"),
+				CopySourceSSECustomerKey: aws.String("hunter2"),
+				SSECustomerKey:           aws.String("hunter2"),
+				SSECustomerKeyMD5:        aws.String("md5"),
+			},
+			Expected: map[string]interface{}{
+				"Bucket":                   "test",
+				"CopySourceSSECustomerKey": apiAuditLogRedacted,
+				"SSECustomerKey":           apiAuditLogRedacted,
+				"SSECustomerKeyMD5":        "md5",
+			},
+		},
+		{
+			Name: "s3 object body",
+			Params: &s3.PutObjectInput{
+				Body:   strings.NewReader("hunter2"),
+				Bucket: aws.String("test"),
+				Key:    aws.String("test"),
+			},
+			Expected: map[string]interface{}{
+				"Body":   apiAuditLogRedacted,
+				"Bucket": "test",
+				"Key":    "test",
+			},
+		},
+		{
+			Name: "lambda environment variables",
+			Params: &lambda.CreateFunctionInput{
+				FunctionName: aws.String("test"),
+				Environment: &lambda.Environment{
+					Variables: map[string]*string{
+						"API_KEY": aws.String("hunter2"),
+						"TOKEN":   aws.String("hunter2"),
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"FunctionName": "test",
+				"Environment":  apiAuditLogRedacted,
+			},
+		},
+		{
+			Name: "ecs container environment",
+			Params: &ecs.RegisterTaskDefinitionInput{
+				Family: aws.String("test"),
+				ContainerDefinitions: []*ecs.ContainerDefinition{
+					{
+						Name: aws.String("test"),
+						Environment: []*ecs.KeyValuePair{
+							{Name: aws.String("API_KEY"), Value: aws.String("hunter2")},
+						},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"Family": "test",
+				"ContainerDefinitions": []interface{}{
+					map[string]interface{}{
+						"Name":        "test",
+						"Environment": apiAuditLogRedacted,
+					},
+				},
+			},
+		},
+		{
+			Name: "ec2 user data",
+			Params: &ec2.RunInstancesInput{
+				ImageId:  aws.String("ami-12345678"),
+				UserData: aws.String("aHVudGVyMg=="),
+			},
+			Expected: map[string]interface{}{
+				"ImageId":  "ami-12345678",
+				"UserData": apiAuditLogRedacted,
+			},
+		},
+		{
+			Name: "cloudformation parameters",
+			Params: &cloudformation.CreateStackInput{
+				StackName: aws.String("test"),
+				Parameters: []*cloudformation.Parameter{
+					{ParameterKey: aws.String("DBPassword"), ParameterValue: aws.String("hunter2")},
+				},
+			},
+			Expected: map[string]interface{}{
+				"StackName":  "test",
+				"Parameters": apiAuditLogRedacted,
+			},
+		},
+		{
+			Name: "free-form map",
+			Params: &sqs.SetQueueAttributesInput{
+				QueueUrl: aws.String("https://sqs.us-west-2.amazonaws.com/123456789012/test"),
+				Attributes: map[string]*string{
+					"Policy": aws.String("hunter2"),
+				},
+			},
+			Expected: map[string]interface{}{
+				"QueueUrl": "https://sqs.us-west-2.amazonaws.com/123456789012/test",
+				"Attributes": map[string]interface{}{
+					"Policy": apiAuditLogRedacted,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := apiAuditLogParams(testCase.Params)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("expected %#v, got: %#v", testCase.Expected, got)
+			}
+
+			if b, _ := json.Marshal(got); strings.Contains(string(b), "hunter2") {
+				t.Errorf("expected secret to be redacted, got: %s", b)
+			}
+		})
+	}
+}
+
+func TestRequestResource(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Params   interface{}
+		Expected string
+	}{
+		{
+			Name:     "nil",
+			Expected: "",
+		},
+		{
+			Name:     "identifier",
+			Params:   &rds.DeleteDBInstanceInput{DBInstanceIdentifier: aws.String("test")},
+			Expected: "",
+		},
+		{
+			Name:     "url",
+			Params:   &sqs.GetQueueAttributesInput{QueueUrl: aws.String("https://sqs.us-west-2.amazonaws.com/123456789012/test")},
+			Expected: "https://sqs.us-west-2.amazonaws.com/123456789012/test",
+		},
+		{
+			Name: "arn preferred",
+			Params: &rds.ModifyDBInstanceInput{
+				DBInstanceIdentifier:        aws.String("test"),
+				DBParameterGroupName:        aws.String("default"),
+				PerformanceInsightsKMSKeyId: aws.String("arn:aws:kms:us-west-2:123456789012:key/test"),
+			},
+			Expected: "arn:aws:kms:us-west-2:123456789012:key/test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := requestResource(testCase.Params); got != testCase.Expected {
+				t.Errorf("expected %q, got: %q", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func TestApiAuditLoggerCompleteHandler(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-amzn-RequestId", "test-request-id")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>AWS.SimpleQueueService.NonExistentQueue</Code><Message>The specified queue does not exist.</Message></Error><RequestId>test-request-id</RequestId></ErrorResponse>`))
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Endpoint:    aws.String(ts.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var buf bytes.Buffer
+	logger := &apiAuditLogger{w: &buf}
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.APIAuditLog",
+		Fn:   logger.completeHandler,
+	})
+
+	queueURL := ts.URL + "/123456789012/test"
+	sqs.New(sess).GetQueueAttributes(&sqs.GetQueueAttributesInput{QueueUrl: aws.String(queueURL)})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 audit log line, got: %q", lines)
+	}
+
+	var entry apiAuditLogEntry
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if entry.Service != "sqs" {
+		t.Errorf("expected service sqs, got: %s", entry.Service)
+	}
+	if entry.Operation != "GetQueueAttributes" {
+		t.Errorf("expected operation GetQueueAttributes, got: %s", entry.Operation)
+	}
+	if entry.Region != "us-west-2" {
+		t.Errorf("expected region us-west-2, got: %s", entry.Region)
+	}
+	if entry.Resource != queueURL {
+		t.Errorf("expected resource %s, got: %s", queueURL, entry.Resource)
+	}
+	if entry.HTTPStatus != http.StatusBadRequest {
+		t.Errorf("expected HTTP status 400, got: %d", entry.HTTPStatus)
+	}
+	if entry.ErrorCode != "AWS.SimpleQueueService.NonExistentQueue" {
+		t.Errorf("expected error code AWS.SimpleQueueService.NonExistentQueue, got: %s", entry.ErrorCode)
+	}
+	if entry.RequestID != "test-request-id" {
+		t.Errorf("expected request ID test-request-id, got: %s", entry.RequestID)
+	}
+}
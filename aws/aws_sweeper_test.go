@@ -1,17 +1,106 @@
 package aws
 
 import (
+	"flag"
 	"fmt"
+	"log"
 	"os"
+	"regexp"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+)
+
+// Flags for the sweepers, which run with the -sweep flag. On shared accounts,
+// -sweep-min-age keeps the resources of running tests and -sweep-dry-run
+// reports what would be deleted first, e.g.
+//
+//	go test ./aws -v -sweep=us-west-2 -sweep-run=aws_vpc -sweep-min-age=6h -sweep-dry-run
+var (
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "Report the resources the sweepers would delete without deleting them")
+	flagSweepMinAge      = flag.Duration("sweep-min-age", 0, "Only sweep resources created at least this long ago")
+	flagSweepNamePattern = flag.String("sweep-name-pattern", "", "Only sweep resources whose name matches this regular expression")
+	flagSweepTags        = flag.String("sweep-tags", "", "Only sweep resources with all of these tags, as a comma separated list of KEY or KEY=VALUE")
+	flagSweepParallelism = flag.Int("sweep-parallelism", 4, "Number of concurrent deletions per sweeper")
+	flagSweepRateLimit   = flag.Float64("sweep-rate-limit", 5, "Maximum number of deletions started per second per sweeper, 0 for no limit")
+)
+
+var (
+	testSweeperGraph = sweep.NewGraph()
+
+	testSweepOptionsOnce sync.Once
+	testSweepOptions     *sweep.Options
+	testSweepOptionsErr  error
 )
 
 func TestMain(m *testing.M) {
 	resource.TestMain(m)
 }
 
+// addTestSweepers registers a sweeper with the Terraform test runner and the
+// sweeper dependency graph.
+func addTestSweepers(name string, s *resource.Sweeper) {
+	if err := testSweeperGraph.Add(name, s.Dependencies); err != nil {
+		log.Fatalf("[ERR] %s", err)
+	}
+
+	resource.AddTestSweepers(name, s)
+}
+
+// testSweepResources deletes the listed resources that match the sweeper's
+// filter and the filters set with the sweeper flags.
+func testSweepResources(region, resourceType string, filter sweep.Filter, resources []*sweep.Resource) error {
+	testSweepOptionsOnce.Do(func() {
+		testSweepOptions, testSweepOptionsErr = testSweepOptionsFromFlags()
+	})
+
+	if testSweepOptionsErr != nil {
+		return testSweepOptionsErr
+	}
+
+	return testSweepOptions.Sweep(region, resourceType, filter, resources)
+}
+
+func testSweepOptionsFromFlags() (*sweep.Options, error) {
+	options := &sweep.Options{
+		DryRun:      *flagSweepDryRun,
+		Parallelism: *flagSweepParallelism,
+		RateLimit:   *flagSweepRateLimit,
+		Report:      os.Stdout,
+		Filter: sweep.Filter{
+			MinAge: *flagSweepMinAge,
+		},
+	}
+
+	if *flagSweepNamePattern != "" {
+		pattern, err := regexp.Compile(*flagSweepNamePattern)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing -sweep-name-pattern: %s", err)
+		}
+
+		options.Filter.NamePatterns = []*regexp.Regexp{pattern}
+	}
+
+	if *flagSweepTags != "" {
+		tags, err := sweep.ParseTags(*flagSweepTags)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing -sweep-tags: %s", err)
+		}
+
+		options.Filter.Tags = tags
+	}
+
+	return options, nil
+}
+
+func TestSweeperDependencies(t *testing.T) {
+	if err := testSweeperGraph.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // sharedClientForRegion returns a common AWSClient setup needed for the sweeper
 // functions for a given region
 func sharedClientForRegion(region string) (interface{}, error) {
@@ -19,9 +19,11 @@ func isAWSErr(err error, code string, message string) bool {
 	return false
 }
 
-func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, error) {
+// retryOnAwsCode retries an AWS error code for two minutes, or longer when
+// the retry configuration of the provider meta allows it.
+func retryOnAwsCode(meta interface{}, code string, f func() (interface{}, error)) (interface{}, error) {
 	var resp interface{}
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(awsCodeRetryTimeout(meta, 2*time.Minute), func() *resource.RetryError {
 		var err error
 		resp, err = f()
 		if err != nil {
@@ -36,11 +38,12 @@ func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, er
 	return resp, err
 }
 
-// RetryOnAwsCodes retries AWS error codes for one minute
+// RetryOnAwsCodes retries AWS error codes for one minute, or longer when the
+// retry configuration of the provider meta allows it.
 // Note: This function will be moved out of the aws package in the future.
-func RetryOnAwsCodes(codes []string, f func() (interface{}, error)) (interface{}, error) {
+func RetryOnAwsCodes(meta interface{}, codes []string, f func() (interface{}, error)) (interface{}, error) {
 	var resp interface{}
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(awsCodeRetryTimeout(meta, 1*time.Minute), func() *resource.RetryError {
 		var err error
 		resp, err = f()
 		if err != nil {
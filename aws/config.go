@@ -3,11 +3,10 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	"github.com/aws/aws-sdk-go/service/apigateway"
@@ -128,6 +127,7 @@ import (
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 	"github.com/hashicorp/terraform/helper/logging"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 type Config struct {
@@ -139,17 +139,44 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	RetryMaxBackoff          time.Duration
+	RetryMode                string
+	RetryServiceRequestRates map[string]float64
+
+	AssumeRoleARN               string
+	AssumeRoleDurationSeconds   int
+	AssumeRoleExternalID        string
+	AssumeRoleSessionName       string
+	AssumeRolePolicy            string
+	AssumeRolePolicyARNs        []string
+	AssumeRoleTags              map[string]string
+	AssumeRoleTransitiveTagKeys []string
+
+	AssumeRoleWithWebIdentityDurationSeconds int
+	AssumeRoleWithWebIdentityPolicy          string
+	AssumeRoleWithWebIdentityPolicyARNs      []string
+	AssumeRoleWithWebIdentityRoleARN         string
+	AssumeRoleWithWebIdentitySessionName     string
+	AssumeRoleWithWebIdentityTokenFile       string
 
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
+	DefaultTags           map[string]string
+	IgnoreTagsKeys        []string
+	IgnoreTagsKeyPrefixes []string
+
 	Endpoints map[string]string
 	Insecure  bool
 
+	APIAuditLogPath string
+	ReadOnly        bool
+
+	CustomCABundle       string
+	HTTPProxy            string
+	UseDualStackEndpoint bool
+	UseFIPSEndpoint      bool
+
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
 	SkipRegionValidation    bool
@@ -187,11 +214,13 @@ type AWSClient struct {
 	codepipelineconn                    *codepipeline.CodePipeline
 	cognitoconn                         *cognitoidentity.CognitoIdentity
 	cognitoidpconn                      *cognitoidentityprovider.CognitoIdentityProvider
+	config                              *Config
 	configconn                          *configservice.ConfigService
 	costandusagereportconn              *costandusagereportservice.CostandUsageReportService
 	datapipelineconn                    *datapipeline.DataPipeline
 	datasyncconn                        *datasync.DataSync
 	daxconn                             *dax.DAX
+	defaultTagsConfig                   *keyvaluetags.DefaultConfig
 	devicefarmconn                      *devicefarm.DeviceFarm
 	dlmconn                             *dlm.DLM
 	dmsconn                             *databasemigrationservice.DatabaseMigrationService
@@ -220,6 +249,7 @@ type AWSClient struct {
 	glueconn                            *glue.Glue
 	guarddutyconn                       *guardduty.GuardDuty
 	iamconn                             *iam.IAM
+	ignoreTagsConfig                    *keyvaluetags.IgnoreConfig
 	inspectorconn                       *inspector.Inspector
 	iotconn                             *iot.IoT
 	kafkaconn                           *kafka.Kafka
@@ -253,7 +283,9 @@ type AWSClient struct {
 	rdsconn                             *rds.RDS
 	redshiftconn                        *redshift.Redshift
 	region                              string
+	regionalClients                     *awsRegionalClients
 	resourcegroupsconn                  *resourcegroups.ResourceGroups
+	retryTimeout                        time.Duration
 	route53resolverconn                 *route53resolver.Route53Resolver
 	s3conn                              *s3.S3
 	s3controlconn                       *s3control.S3Control
@@ -264,6 +296,7 @@ type AWSClient struct {
 	securityhubconn                     *securityhub.SecurityHub
 	serverlessapplicationrepositoryconn *serverlessapplicationrepository.ServerlessApplicationRepository
 	sesConn                             *ses.SES
+	session                             *session.Session
 	sfnconn                             *sfn.SFN
 	shieldconn                          *shield.Shield
 	simpledbconn                        *simpledb.SimpleDB
@@ -319,11 +352,59 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
+	// Role assumption beyond what awsbase supports is handled by the provider,
+	// with awsbase only seeing the resulting temporary credentials.
+	creds, err := c.assumeRoleCredentials(awsbaseConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if creds != nil {
+		value, err := creds.Get()
+		if err != nil {
+			return nil, err
+		}
+
+		awsbaseConfig.AccessKey = value.AccessKeyID
+		awsbaseConfig.SecretKey = value.SecretAccessKey
+		awsbaseConfig.Token = value.SessionToken
+		awsbaseConfig.AssumeRoleARN = ""
+		awsbaseConfig.AssumeRoleExternalID = ""
+		awsbaseConfig.AssumeRolePolicy = ""
+		awsbaseConfig.AssumeRoleSessionName = ""
+	}
+
+	// awsbase API calls do not use the custom transport, so the provider
+	// makes them itself once the session is configured.
+	customTransport := c.requiresCustomTransport()
+	if customTransport {
+		awsbaseConfig.SkipCredsValidation = true
+		awsbaseConfig.SkipRequestingAccountId = true
+	}
+
 	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, err
 	}
 
+	if creds != nil {
+		// Swap in the refreshable credentials so long running operations
+		// outlive the initial temporary credentials.
+		sess.Config.Credentials = creds
+	}
+
+	if customTransport {
+		sess, err = c.configureTransport(sess)
+		if err != nil {
+			return nil, err
+		}
+
+		accountID, partition, err = c.accountIDAndPartition(sess)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -332,6 +413,25 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	c.configureRetries(sess)
+
+	if err := c.configureAPIAuditLog(sess); err != nil {
+		return nil, err
+	}
+
+	c.configureReadOnly(sess)
+
+	client := c.clientFromSession(sess, accountID, partition)
+	client.regionalClients = &awsRegionalClients{
+		clients: make(map[string]*AWSClient),
+	}
+
+	return client, nil
+}
+
+// clientFromSession builds the AWSClient service connections for a session
+// that already carries credentials and the region of the Config.
+func (c *Config) clientFromSession(sess *session.Session, accountID, partition string) *AWSClient {
 	client := &AWSClient{
 		accountid:                           accountID,
 		acmconn:                             acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acm"])})),
@@ -426,8 +526,10 @@ func (c *Config) Client() (interface{}, error) {
 		ramconn:                             ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ram"])})),
 		rdsconn:                             rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["rds"])})),
 		redshiftconn:                        redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["redshift"])})),
+		config:                              c,
 		region:                              c.Region,
 		resourcegroupsconn:                  resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroups"])})),
+		retryTimeout:                        c.retryTimeout(),
 		route53resolverconn:                 route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53resolver"])})),
 		s3conn:                              s3.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3"]), S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle)})),
 		s3controlconn:                       s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3control"])})),
@@ -438,6 +540,7 @@ func (c *Config) Client() (interface{}, error) {
 		securityhubconn:                     securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["securityhub"])})),
 		serverlessapplicationrepositoryconn: serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["serverlessrepo"])})),
 		sesConn:                             ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ses"])})),
+		session:                             sess,
 		sfnconn:                             sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["stepfunctions"])})),
 		shieldconn:                          shield.New(sess.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.Endpoints["shield"])})),
 		simpledbconn:                        simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sdb"])})),
@@ -455,6 +558,19 @@ func (c *Config) Client() (interface{}, error) {
 		xrayconn:                            xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["xray"])})),
 	}
 
+	if len(c.DefaultTags) > 0 {
+		client.defaultTagsConfig = &keyvaluetags.DefaultConfig{
+			Tags: keyvaluetags.New(c.DefaultTags),
+		}
+	}
+
+	if len(c.IgnoreTagsKeys) > 0 || len(c.IgnoreTagsKeyPrefixes) > 0 {
+		client.ignoreTagsConfig = &keyvaluetags.IgnoreConfig{
+			Keys:        keyvaluetags.New(c.IgnoreTagsKeys),
+			KeyPrefixes: keyvaluetags.New(c.IgnoreTagsKeyPrefixes),
+		}
+	}
+
 	// Handle deprecated endpoint configurations
 	if c.Endpoints["kinesis_analytics"] != "" {
 		client.kinesisanalyticsconn = kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesis_analytics"])}))
@@ -463,92 +579,6 @@ func (c *Config) Client() (interface{}, error) {
 		client.r53conn = route53.New(sess.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.Endpoints["r53"])}))
 	}
 
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == kinesis.ErrCodeLimitExceededException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == applicationautoscaling.ErrCodeFailedResourceAccessException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.appsyncconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateGraphqlApi" {
-			if isAWSErr(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.dynamodbconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.ec2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateVpnConnection" {
-			if isAWSErr(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-
-		if r.Operation.Name == "CreateVpnGateway" {
-			if isAWSErr(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.kafkaconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.storagegatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
-		if isAWSErr(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
 		if err != nil {
@@ -560,7 +590,7 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
-	return client, nil
+	return client
 }
 
 func hasEc2Classic(platforms []string) bool {
@@ -0,0 +1,331 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+const (
+	assumeRoleCredentialsProviderName  = "AssumeRoleProvider"
+	webIdentityCredentialsProviderName = "WebIdentityCredentials"
+)
+
+// assumeRoleCredentialsProvider retrieves credentials via sts:AssumeRole.
+// It supports the session policy ARNs and session tags that the AWS Go SDK
+// stscreds.AssumeRoleProvider used by awsbase does not.
+type assumeRoleCredentialsProvider struct {
+	credentials.Expiry
+
+	Client            *sts.STS
+	Duration          time.Duration
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	RoleARN           string
+	RoleSessionName   string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// Retrieve assumes the role, implementing the credentials.Provider interface.
+func (p *assumeRoleCredentialsProvider) Retrieve() (credentials.Value, error) {
+	input := &sts.AssumeRoleInput{
+		PolicyArns:      expandStsPolicyDescriptorTypes(p.PolicyARNs),
+		RoleArn:         aws.String(p.RoleARN),
+		RoleSessionName: aws.String(stsRoleSessionName(p.RoleSessionName)),
+	}
+
+	if p.Duration > 0 {
+		input.DurationSeconds = aws.Int64(int64(p.Duration / time.Second))
+	}
+
+	if p.ExternalID != "" {
+		input.ExternalId = aws.String(p.ExternalID)
+	}
+
+	if p.Policy != "" {
+		input.Policy = aws.String(p.Policy)
+	}
+
+	req, output := p.Client.AssumeRoleRequest(input)
+
+	if len(p.Tags) > 0 || len(p.TransitiveTagKeys) > 0 {
+		req.Handlers.Build.PushBack(stsSessionTagsBuildHandler(p.Tags, p.TransitiveTagKeys))
+	}
+
+	if err := req.Send(); err != nil {
+		return credentials.Value{ProviderName: assumeRoleCredentialsProviderName}, err
+	}
+
+	p.SetExpiration(aws.TimeValue(output.Credentials.Expiration), 0)
+
+	return stsCredentialsValue(output.Credentials, assumeRoleCredentialsProviderName), nil
+}
+
+// webIdentityCredentialsProvider retrieves credentials via
+// sts:AssumeRoleWithWebIdentity using a token read from a file.
+// The file is read on every retrieval so rotated tokens are picked up.
+type webIdentityCredentialsProvider struct {
+	credentials.Expiry
+
+	Client          *sts.STS
+	Duration        time.Duration
+	Policy          string
+	PolicyARNs      []string
+	RoleARN         string
+	RoleSessionName string
+	TokenFile       string
+}
+
+// Retrieve assumes the role, implementing the credentials.Provider interface.
+func (p *webIdentityCredentialsProvider) Retrieve() (credentials.Value, error) {
+	token, err := ioutil.ReadFile(p.TokenFile)
+
+	if err != nil {
+		return credentials.Value{ProviderName: webIdentityCredentialsProviderName}, fmt.Errorf("error reading web identity token file (%s): %s", p.TokenFile, err)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		PolicyArns:       expandStsPolicyDescriptorTypes(p.PolicyARNs),
+		RoleArn:          aws.String(p.RoleARN),
+		RoleSessionName:  aws.String(stsRoleSessionName(p.RoleSessionName)),
+		WebIdentityToken: aws.String(strings.TrimSpace(string(token))),
+	}
+
+	if p.Duration > 0 {
+		input.DurationSeconds = aws.Int64(int64(p.Duration / time.Second))
+	}
+
+	if p.Policy != "" {
+		input.Policy = aws.String(p.Policy)
+	}
+
+	output, err := p.Client.AssumeRoleWithWebIdentity(input)
+
+	if err != nil {
+		return credentials.Value{ProviderName: webIdentityCredentialsProviderName}, err
+	}
+
+	p.SetExpiration(aws.TimeValue(output.Credentials.Expiration), 0)
+
+	return stsCredentialsValue(output.Credentials, webIdentityCredentialsProviderName), nil
+}
+
+// requiresProviderAssumeRole returns whether the configured role assumption
+// is beyond what awsbase supports and must be handled by the provider.
+func (c *Config) requiresProviderAssumeRole() bool {
+	if c.AssumeRoleWithWebIdentityRoleARN != "" {
+		return true
+	}
+
+	if c.AssumeRoleARN == "" {
+		return false
+	}
+
+	// The awsbase role assumption does not use the custom transport.
+	if c.requiresCustomTransport() {
+		return true
+	}
+
+	return c.AssumeRoleDurationSeconds > 0 || len(c.AssumeRolePolicyARNs) > 0 || len(c.AssumeRoleTags) > 0 || len(c.AssumeRoleTransitiveTagKeys) > 0
+}
+
+// assumeRoleCredentials returns refreshable credentials for the configured
+// assume_role_with_web_identity and assume_role blocks, chaining the former
+// into the latter when both are present. Returns nil when awsbase can handle
+// the configuration itself.
+func (c *Config) assumeRoleCredentials(awsbaseConfig *awsbase.Config) (*credentials.Credentials, error) {
+	if !c.requiresProviderAssumeRole() {
+		return nil, nil
+	}
+
+	var creds *credentials.Credentials
+
+	if c.AssumeRoleWithWebIdentityRoleARN != "" {
+		log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q, TokenFile: %q)",
+			c.AssumeRoleWithWebIdentityRoleARN, c.AssumeRoleWithWebIdentitySessionName, c.AssumeRoleWithWebIdentityTokenFile)
+
+		// AssumeRoleWithWebIdentity requests are not signed.
+		sess, err := c.stsSession(credentials.AnonymousCredentials)
+
+		if err != nil {
+			return nil, err
+		}
+
+		creds = credentials.NewCredentials(&webIdentityCredentialsProvider{
+			Client:          sts.New(sess),
+			Duration:        time.Duration(c.AssumeRoleWithWebIdentityDurationSeconds) * time.Second,
+			Policy:          c.AssumeRoleWithWebIdentityPolicy,
+			PolicyARNs:      c.AssumeRoleWithWebIdentityPolicyARNs,
+			RoleARN:         c.AssumeRoleWithWebIdentityRoleARN,
+			RoleSessionName: c.AssumeRoleWithWebIdentitySessionName,
+			TokenFile:       c.AssumeRoleWithWebIdentityTokenFile,
+		})
+	}
+
+	if c.AssumeRoleARN != "" {
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, DurationSeconds: %d, PolicyARNs: %q, TransitiveTagKeys: %q)",
+			c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRoleDurationSeconds, c.AssumeRolePolicyARNs, c.AssumeRoleTransitiveTagKeys)
+
+		var sess *session.Session
+		var err error
+
+		if creds != nil {
+			sess, err = c.stsSession(creds)
+		} else {
+			// Resolve the source credentials with the usual awsbase chain.
+			baseConfig := *awsbaseConfig
+			baseConfig.AssumeRoleARN = ""
+			baseConfig.AssumeRoleExternalID = ""
+			baseConfig.AssumeRolePolicy = ""
+			baseConfig.AssumeRoleSessionName = ""
+			baseConfig.SkipCredsValidation = true
+
+			sess, err = awsbase.GetSession(&baseConfig)
+
+			if err == nil && c.requiresCustomTransport() {
+				sess, err = c.configureTransport(sess)
+			}
+
+			if err == nil {
+				sess = sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])})
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		creds = credentials.NewCredentials(&assumeRoleCredentialsProvider{
+			Client:            sts.New(sess),
+			Duration:          time.Duration(c.AssumeRoleDurationSeconds) * time.Second,
+			ExternalID:        c.AssumeRoleExternalID,
+			Policy:            c.AssumeRolePolicy,
+			PolicyARNs:        c.AssumeRolePolicyARNs,
+			RoleARN:           c.AssumeRoleARN,
+			RoleSessionName:   c.AssumeRoleSessionName,
+			Tags:              c.AssumeRoleTags,
+			TransitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
+		})
+	}
+
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("error assuming IAM Role: %s", err)
+	}
+
+	return creds, nil
+}
+
+// stsSession returns a session for STS API calls using the given credentials.
+func (c *Config) stsSession(creds *credentials.Credentials) (*session.Session, error) {
+	cfg, err := c.transportConfig()
+
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := session.NewSession(cfg.WithCredentials(creds).
+		WithEndpoint(c.Endpoints["sts"]).
+		WithMaxRetries(c.MaxRetries).
+		WithRegion(c.Region))
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating STS session: %s", err)
+	}
+
+	c.configureFIPSEndpointNotFound(sess)
+
+	return sess, nil
+}
+
+// stsSessionTagsBuildHandler returns a request handler adding session tags
+// and transitive tag keys to a built AssumeRole query request, as the
+// sts.AssumeRoleInput type has no fields for them.
+func stsSessionTagsBuildHandler(tags map[string]string, transitiveTagKeys []string) func(*request.Request) {
+	return func(r *request.Request) {
+		if r.Error != nil {
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.GetBody())
+
+		if err != nil {
+			r.Error = fmt.Errorf("error reading STS request body: %s", err)
+			return
+		}
+
+		values, err := url.ParseQuery(string(body))
+
+		if err != nil {
+			r.Error = fmt.Errorf("error parsing STS request body: %s", err)
+			return
+		}
+
+		keys := make([]string, 0, len(tags))
+		for k := range tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for i, k := range keys {
+			values.Set(fmt.Sprintf("Tags.member.%d.Key", i+1), k)
+			values.Set(fmt.Sprintf("Tags.member.%d.Value", i+1), tags[k])
+		}
+
+		transitiveKeys := append([]string(nil), transitiveTagKeys...)
+		sort.Strings(transitiveKeys)
+
+		for i, k := range transitiveKeys {
+			values.Set(fmt.Sprintf("TransitiveTagKeys.member.%d", i+1), k)
+		}
+
+		r.SetBufferBody([]byte(values.Encode()))
+	}
+}
+
+func expandStsPolicyDescriptorTypes(policyARNs []string) []*sts.PolicyDescriptorType {
+	if len(policyARNs) == 0 {
+		return nil
+	}
+
+	result := make([]*sts.PolicyDescriptorType, 0, len(policyARNs))
+
+	for _, policyARN := range policyARNs {
+		result = append(result, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	return result
+}
+
+func stsCredentialsValue(c *sts.Credentials, providerName string) credentials.Value {
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(c.AccessKeyId),
+		SecretAccessKey: aws.StringValue(c.SecretAccessKey),
+		SessionToken:    aws.StringValue(c.SessionToken),
+		ProviderName:    providerName,
+	}
+}
+
+// stsRoleSessionName returns the given session name or, as the AWS Go SDK
+// does when none is configured, a timestamp based one.
+func stsRoleSessionName(name string) string {
+	if name != "" {
+		return name
+	}
+
+	return fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+}
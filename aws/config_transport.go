@@ -0,0 +1,204 @@
+package aws
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// requiresCustomTransport returns whether the configured HTTP proxy, CA
+// bundle or endpoint variants must be applied to the provider session.
+// awsbase builds its own HTTP client, so API calls it would otherwise make
+// are made by the provider instead.
+func (c *Config) requiresCustomTransport() bool {
+	return c.HTTPProxy != "" || c.CustomCABundle != "" || c.UseFIPSEndpoint || c.UseDualStackEndpoint
+}
+
+// transportConfig returns the AWS Go SDK configuration applying the
+// http_proxy, custom_ca_bundle, insecure, use_fips_endpoint and
+// use_dualstack_endpoint provider arguments.
+func (c *Config) transportConfig() (*aws.Config, error) {
+	httpClient, err := c.httpClient()
+
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &aws.Config{
+		HTTPClient: httpClient,
+	}
+
+	if c.UseDualStackEndpoint {
+		cfg.UseDualStack = aws.Bool(true)
+	}
+
+	if c.UseFIPSEndpoint {
+		cfg.EndpointResolver = fipsEndpointResolver(endpoints.DefaultResolver())
+	}
+
+	return cfg, nil
+}
+
+// httpClient returns the HTTP client for AWS API calls.
+func (c *Config) httpClient() (*http.Client, error) {
+	httpClient := cleanhttp.DefaultClient()
+	transport := httpClient.Transport.(*http.Transport)
+
+	if c.Insecure || c.CustomCABundle != "" {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: c.Insecure,
+		}
+	}
+
+	if c.CustomCABundle != "" {
+		pem, err := ioutil.ReadFile(c.CustomCABundle)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom CA bundle (%s): %s", c.CustomCABundle, err)
+		}
+
+		pool := x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("error reading custom CA bundle (%s): no PEM encoded certificates found", c.CustomCABundle)
+		}
+
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL (%s): %s", c.HTTPProxy, err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return httpClient, nil
+}
+
+// ErrCodeFIPSEndpointNotFound is the error code of API calls to services
+// without a modeled FIPS endpoint when use_fips_endpoint is set.
+const ErrCodeFIPSEndpointNotFound = "FIPSEndpointNotFound"
+
+// fipsEndpointResolver returns an endpoint resolver of the FIPS 140-2
+// validated endpoint of a service, as modeled by the AWS Go SDK with
+// "<region>-fips" or "fips-<region>" pseudo regions. Services without a
+// modeled FIPS endpoint in the region are not resolved.
+func fipsEndpointResolver(resolver endpoints.Resolver) endpoints.ResolverFunc {
+	return func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		opts = append(opts, func(o *endpoints.Options) {
+			o.StrictMatching = true
+		})
+
+		for _, fipsRegion := range []string{region + "-fips", "fips-" + region} {
+			if endpoint, err := resolver.EndpointFor(service, fipsRegion, opts...); err == nil {
+				if endpoint.SigningRegion == fipsRegion {
+					endpoint.SigningRegion = region
+				}
+
+				return endpoint, nil
+			}
+		}
+
+		return endpoints.ResolvedEndpoint{}, awserr.New(ErrCodeFIPSEndpointNotFound, fmt.Sprintf("no FIPS endpoint for %s in %s", service, region), nil)
+	}
+}
+
+// fipsEndpointNotFoundHandler rejects requests of service clients without an
+// endpoint, as the AWS Go SDK does not return endpoint resolution errors
+// when creating service clients.
+func fipsEndpointNotFoundHandler(r *request.Request) {
+	if r.ClientInfo.Endpoint != "" {
+		return
+	}
+
+	r.Error = awserr.New(ErrCodeFIPSEndpointNotFound, fmt.Sprintf("no FIPS endpoint for %s in %s, the provider is configured with use_fips_endpoint; configure the service in the provider endpoints block or disable use_fips_endpoint", retryServiceName(r), aws.StringValue(r.Config.Region)), nil)
+	r.Retryable = aws.Bool(false)
+}
+
+// configureFIPSEndpointNotFound installs fipsEndpointNotFoundHandler on a
+// session when use_fips_endpoint is set.
+func (c *Config) configureFIPSEndpointNotFound(sess *session.Session) {
+	if !c.UseFIPSEndpoint {
+		return
+	}
+
+	sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.FIPSEndpointNotFound",
+		Fn:   fipsEndpointNotFoundHandler,
+	})
+}
+
+// configureTransport returns a copy of the session applying the provider
+// transport configuration.
+func (c *Config) configureTransport(sess *session.Session) (*session.Session, error) {
+	cfg, err := c.transportConfig()
+
+	if err != nil {
+		return nil, err
+	}
+
+	sess = sess.Copy(cfg)
+	c.configureFIPSEndpointNotFound(sess)
+
+	return sess, nil
+}
+
+// accountIDAndPartition validates the session credentials and looks up the
+// account ID and partition, as awsbase does for sessions it configures.
+func (c *Config) accountIDAndPartition(sess *session.Session) (string, string, error) {
+	iamClient := iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iam"])}))
+	stsClient := sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])}))
+
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(stsClient)
+
+		if err != nil {
+			return "", "", fmt.Errorf("error validating provider credentials: %s", err)
+		}
+
+		return accountID, partition, nil
+	}
+
+	if !c.SkipRequestingAccountId {
+		credentialsProviderName := ""
+
+		if credentialsValue, err := sess.Config.Credentials.Get(); err == nil {
+			credentialsProviderName = credentialsValue.ProviderName
+		}
+
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iamClient, stsClient, credentialsProviderName)
+
+		if err != nil {
+			return "", "", fmt.Errorf(
+				"AWS account ID not previously found and failed retrieving via all available methods. "+
+					"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+					"Errors: %s", err)
+		}
+
+		return accountID, partition, nil
+	}
+
+	var partition string
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	return "", partition, nil
+}
@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestConfigHTTPClient_customCABundle(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	file, err := ioutil.TempFile("", "ca-bundle")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.Remove(file.Name())
+
+	if err := pem.Encode(file, &pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	file.Close()
+
+	httpClient, err := (&Config{}).httpClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := httpClient.Get(ts.URL); err == nil {
+		t.Fatal("expected certificate error without custom CA bundle")
+	}
+
+	httpClient, err = (&Config{CustomCABundle: file.Name()}).httpClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp, err := httpClient.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("expected trusted server certificate, got: %s", err)
+	}
+	resp.Body.Close()
+
+	if _, err := (&Config{CustomCABundle: file.Name() + "-missing"}).httpClient(); err == nil {
+		t.Fatal("expected error for missing CA bundle")
+	}
+}
+
+func TestConfigHTTPClient_httpProxy(t *testing.T) {
+	httpClient, err := (&Config{HTTPProxy: "http://proxy.example.com:3128"}).httpClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	req, _ := http.NewRequest("GET", "https://ec2.us-west-2.amazonaws.com", nil)
+	proxyURL, err := httpClient.Transport.(*http.Transport).Proxy(req)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if proxyURL == nil || proxyURL.String() != "http://proxy.example.com:3128" {
+		t.Fatalf("expected proxy http://proxy.example.com:3128, got: %s", proxyURL)
+	}
+
+	if _, err := (&Config{HTTPProxy: "http://proxy.example.com:port"}).httpClient(); err == nil {
+		t.Fatal("expected error for invalid proxy URL")
+	}
+}
+
+func TestFipsEndpointResolver(t *testing.T) {
+	testCases := []struct {
+		Service       string
+		Region        string
+		URL           string
+		SigningRegion string
+		ExpectError   bool
+	}{
+		{
+			Service:       "sqs",
+			Region:        "us-east-1",
+			URL:           "https://sqs-fips.us-east-1.amazonaws.com",
+			SigningRegion: "us-east-1",
+		},
+		{
+			Service:       "s3-control",
+			Region:        "us-east-1",
+			URL:           "https://s3-control-fips.us-east-1.amazonaws.com",
+			SigningRegion: "us-east-1",
+		},
+		{
+			Service:     "ec2",
+			Region:      "us-west-2",
+			ExpectError: true,
+		},
+	}
+
+	resolver := fipsEndpointResolver(endpoints.DefaultResolver())
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Service, func(t *testing.T) {
+			endpoint, err := resolver.EndpointFor(testCase.Service, testCase.Region)
+
+			if testCase.ExpectError {
+				if !isAWSErr(err, ErrCodeFIPSEndpointNotFound, "") {
+					t.Fatalf("expected %s error, got: %v", ErrCodeFIPSEndpointNotFound, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			if endpoint.URL != testCase.URL {
+				t.Errorf("expected URL %s, got: %s", testCase.URL, endpoint.URL)
+			}
+			if endpoint.SigningRegion != testCase.SigningRegion {
+				t.Errorf("expected signing region %s, got: %s", testCase.SigningRegion, endpoint.SigningRegion)
+			}
+		})
+	}
+}
+
+func TestConfigConfigureTransport_fipsEndpointNotFound(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	sess, err = (&Config{UseFIPSEndpoint: true}).configureTransport(sess)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	_, err = ec2.New(sess).DescribeVpcs(&ec2.DescribeVpcsInput{})
+
+	if !isAWSErr(err, ErrCodeFIPSEndpointNotFound, "use_fips_endpoint") {
+		t.Fatalf("expected %s error, got: %v", ErrCodeFIPSEndpointNotFound, err)
+	}
+}
+
+func TestConfigTransportConfig(t *testing.T) {
+	cfg, err := (&Config{}).transportConfig()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if cfg.HTTPClient == nil {
+		t.Fatal("expected HTTP client")
+	}
+	if cfg.UseDualStack != nil || cfg.EndpointResolver != nil {
+		t.Fatal("expected default endpoint resolution")
+	}
+
+	cfg, err = (&Config{UseDualStackEndpoint: true, UseFIPSEndpoint: true}).transportConfig()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !aws.BoolValue(cfg.UseDualStack) {
+		t.Fatal("expected dual-stack endpoints")
+	}
+
+	endpoint, err := cfg.EndpointResolver.EndpointFor("sts", "us-east-1", func(o *endpoints.Options) {
+		o.UseDualStack = true
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if expected := "https://sts-fips.us-east-1.amazonaws.com"; endpoint.URL != expected {
+		t.Fatalf("expected URL %s, got: %s", expected, endpoint.URL)
+	}
+
+	_, err = cfg.EndpointResolver.EndpointFor("s3", "us-west-2", func(o *endpoints.Options) {
+		o.UseDualStack = true
+	})
+	if !isAWSErr(err, ErrCodeFIPSEndpointNotFound, "") {
+		t.Fatalf("expected %s error, got: %v", ErrCodeFIPSEndpointNotFound, err)
+	}
+}
@@ -0,0 +1,294 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIAMPrincipalPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMPrincipalPolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								iam.ContextKeyTypeEnumBinary,
+								iam.ContextKeyTypeEnumBinaryList,
+								iam.ContextKeyTypeEnumBoolean,
+								iam.ContextKeyTypeEnumBooleanList,
+								iam.ContextKeyTypeEnumDate,
+								iam.ContextKeyTypeEnumDateList,
+								iam.ContextKeyTypeEnumIp,
+								iam.ContextKeyTypeEnumIpList,
+								iam.ContextKeyTypeEnumNumeric,
+								iam.ContextKeyTypeEnumNumericList,
+								iam.ContextKeyTypeEnumString,
+								iam.ContextKeyTypeEnumStringList,
+							}, false),
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMPolicyJson,
+				},
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIAMPolicyJson,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision_details": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMPrincipalPolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	actionNames := expandStringSet(d.Get("action_names").(*schema.Set))
+	contextEntries := expandIamPolicySimulationContextEntries(d.Get("context").(*schema.Set).List())
+	policies := expandStringList(d.Get("policies_json").([]interface{}))
+	resourceArns := expandStringSet(d.Get("resource_arns").(*schema.Set))
+
+	var results []*iam.EvaluationResult
+	collect := func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		results = append(results, page.EvaluationResults...)
+		return !lastPage
+	}
+
+	var id string
+	var err error
+
+	if v, ok := d.GetOk("principal_arn"); ok {
+		input := &iam.SimulatePrincipalPolicyInput{
+			ActionNames:     actionNames,
+			ContextEntries:  contextEntries,
+			PolicySourceArn: aws.String(v.(string)),
+		}
+
+		if len(policies) > 0 {
+			input.PolicyInputList = policies
+		}
+
+		if len(resourceArns) > 0 {
+			input.ResourceArns = resourceArns
+		}
+
+		if v, ok := d.GetOk("caller_arn"); ok {
+			input.CallerArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_owner_account_id"); ok {
+			input.ResourceOwner = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_policy_json"); ok {
+			input.ResourcePolicy = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Simulating IAM principal policy: %s", input)
+		id = input.String()
+		err = conn.SimulatePrincipalPolicyPages(input, collect)
+	} else if len(policies) > 0 {
+		input := &iam.SimulateCustomPolicyInput{
+			ActionNames:     actionNames,
+			ContextEntries:  contextEntries,
+			PolicyInputList: policies,
+		}
+
+		if len(resourceArns) > 0 {
+			input.ResourceArns = resourceArns
+		}
+
+		if v, ok := d.GetOk("caller_arn"); ok {
+			input.CallerArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_owner_account_id"); ok {
+			input.ResourceOwner = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_policy_json"); ok {
+			input.ResourcePolicy = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Simulating IAM custom policy: %s", input)
+		id = input.String()
+		err = conn.SimulateCustomPolicyPages(input, collect)
+	} else {
+		return fmt.Errorf("one of principal_arn or policies_json must be set")
+	}
+
+	if err != nil {
+		return fmt.Errorf("error simulating IAM policy: %s", err)
+	}
+
+	allAllowed := true
+	for _, result := range results {
+		if aws.StringValue(result.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+			allAllowed = false
+		}
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(id)))
+	d.Set("all_allowed", allAllowed)
+
+	if err := d.Set("results", flattenIamPolicySimulationResults(results)); err != nil {
+		return fmt.Errorf("error setting results: %s", err)
+	}
+
+	return nil
+}
+
+func expandIamPolicySimulationContextEntries(l []interface{}) []*iam.ContextEntry {
+	if len(l) == 0 {
+		return nil
+	}
+
+	entries := make([]*iam.ContextEntry, 0, len(l))
+
+	for _, v := range l {
+		m := v.(map[string]interface{})
+
+		entries = append(entries, &iam.ContextEntry{
+			ContextKeyName:   aws.String(m["key"].(string)),
+			ContextKeyType:   aws.String(m["type"].(string)),
+			ContextKeyValues: expandStringList(m["values"].([]interface{})),
+		})
+	}
+
+	return entries
+}
+
+func flattenIamPolicySimulationResults(results []*iam.EvaluationResult) []interface{} {
+	l := make([]interface{}, 0, len(results))
+
+	for _, result := range results {
+		statements := make([]interface{}, 0, len(result.MatchedStatements))
+		for _, statement := range result.MatchedStatements {
+			statements = append(statements, map[string]interface{}{
+				"source_policy_id":   aws.StringValue(statement.SourcePolicyId),
+				"source_policy_type": aws.StringValue(statement.SourcePolicyType),
+			})
+		}
+
+		l = append(l, map[string]interface{}{
+			"action_name":          aws.StringValue(result.EvalActionName),
+			"allowed":              aws.StringValue(result.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed,
+			"decision":             aws.StringValue(result.EvalDecision),
+			"decision_details":     pointersMapToStringList(result.EvalDecisionDetails),
+			"matched_statements":   statements,
+			"missing_context_keys": flattenStringList(result.MissingContextValues),
+			"resource_arn":         aws.StringValue(result.EvalResourceName),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestFlattenIamPolicySimulationResults(t *testing.T) {
+	results := []*iam.EvaluationResult{
+		{
+			EvalActionName:   aws.String("s3:GetObject"),
+			EvalDecision:     aws.String(iam.PolicyEvaluationDecisionTypeAllowed),
+			EvalResourceName: aws.String("arn:aws:s3:::example/*"),
+			MatchedStatements: []*iam.Statement{
+				{
+					SourcePolicyId:   aws.String("PolicyInputList.1"),
+					SourcePolicyType: aws.String(iam.PolicySourceTypeUser),
+				},
+			},
+		},
+		{
+			EvalActionName:       aws.String("s3:PutObject"),
+			EvalDecision:         aws.String(iam.PolicyEvaluationDecisionTypeImplicitDeny),
+			EvalDecisionDetails:  map[string]*string{"PolicyInputList.1": aws.String(iam.PolicyEvaluationDecisionTypeImplicitDeny)},
+			EvalResourceName:     aws.String("arn:aws:s3:::example/*"),
+			MissingContextValues: []*string{aws.String("aws:SourceIp")},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"action_name":      "s3:GetObject",
+			"allowed":          true,
+			"decision":         iam.PolicyEvaluationDecisionTypeAllowed,
+			"decision_details": map[string]interface{}{},
+			"matched_statements": []interface{}{
+				map[string]interface{}{
+					"source_policy_id":   "PolicyInputList.1",
+					"source_policy_type": iam.PolicySourceTypeUser,
+				},
+			},
+			"missing_context_keys": []interface{}{},
+			"resource_arn":         "arn:aws:s3:::example/*",
+		},
+		map[string]interface{}{
+			"action_name":          "s3:PutObject",
+			"allowed":              false,
+			"decision":             iam.PolicyEvaluationDecisionTypeImplicitDeny,
+			"decision_details":     map[string]interface{}{"PolicyInputList.1": iam.PolicyEvaluationDecisionTypeImplicitDeny},
+			"matched_statements":   []interface{}{},
+			"missing_context_keys": []interface{}{"aws:SourceIp"},
+			"resource_arn":         "arn:aws:s3:::example/*",
+		},
+	}
+
+	if got := flattenIamPolicySimulationResults(results); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected:\n%#v\n\ngot:\n%#v", expected, got)
+	}
+}
+
+func TestAccAWSDataSourceIAMPrincipalPolicySimulation_customPolicy(t *testing.T) {
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceIAMPrincipalPolicySimulationConfigCustomPolicy,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPrincipalPolicySimulation_principal(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceIAMPrincipalPolicySimulationConfigPrincipal(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAWSDataSourceIAMPrincipalPolicySimulationConfigCustomPolicy = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::example/*"]
+  }
+}
+
+data "aws_iam_principal_policy_simulation" "test" {
+  action_names  = ["s3:GetObject", "s3:PutObject"]
+  policies_json = ["${data.aws_iam_policy_document.test.json}"]
+  resource_arns = ["arn:aws:s3:::example/object"]
+}
+`
+
+func testAccAWSDataSourceIAMPrincipalPolicySimulationConfigPrincipal(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "s3:GetObject",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+data "aws_iam_principal_policy_simulation" "test" {
+  action_names  = ["s3:GetObject"]
+  principal_arn = "${aws_iam_role.test.arn}"
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
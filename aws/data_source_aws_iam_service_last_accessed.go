@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsIAMServiceLastAccessed() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMServiceLastAccessedRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"job_completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_authenticated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_entity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_authenticated_entities": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMServiceLastAccessedRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	arn := d.Get("arn").(string)
+
+	log.Printf("[DEBUG] Generating IAM service last accessed details for %s", arn)
+	output, err := conn.GenerateServiceLastAccessedDetails(&iam.GenerateServiceLastAccessedDetailsInput{
+		Arn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error generating IAM service last accessed details for %s: %s", arn, err)
+	}
+
+	jobID := aws.StringValue(output.JobId)
+
+	if err := waitForIAMServiceLastAccessedJobCompletion(conn, jobID, 10*time.Minute); err != nil {
+		return fmt.Errorf("error waiting for IAM service last accessed details job (%s) to complete: %s", jobID, err)
+	}
+
+	var services []*iam.ServiceLastAccessed
+	var jobCompletionDate *time.Time
+	input := &iam.GetServiceLastAccessedDetailsInput{
+		JobId: aws.String(jobID),
+	}
+
+	for {
+		output, err := conn.GetServiceLastAccessedDetails(input)
+
+		if err != nil {
+			return fmt.Errorf("error reading IAM service last accessed details job (%s): %s", jobID, err)
+		}
+
+		jobCompletionDate = output.JobCompletionDate
+		services = append(services, output.ServicesLastAccessed...)
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+
+		input.Marker = output.Marker
+	}
+
+	d.SetId(arn)
+	d.Set("job_id", jobID)
+
+	if jobCompletionDate != nil {
+		d.Set("job_completion_date", jobCompletionDate.Format(time.RFC3339))
+	}
+
+	if err := d.Set("services", flattenIamServiceLastAccessed(services)); err != nil {
+		return fmt.Errorf("error setting services: %s", err)
+	}
+
+	return nil
+}
+
+func waitForIAMServiceLastAccessedJobCompletion(conn *iam.IAM, jobID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{iam.JobStatusTypeInProgress},
+		Target:     []string{iam.JobStatusTypeCompleted},
+		Refresh:    iamServiceLastAccessedJobRefreshFunc(conn, jobID),
+		Timeout:    timeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func iamServiceLastAccessedJobRefreshFunc(conn *iam.IAM, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetServiceLastAccessedDetails(&iam.GetServiceLastAccessedDetailsInput{
+			JobId:    aws.String(jobID),
+			MaxItems: aws.Int64(1),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.JobStatus)
+
+		if status == iam.JobStatusTypeFailed && output.Error != nil {
+			return output, status, fmt.Errorf("%s: %s", aws.StringValue(output.Error.Code), aws.StringValue(output.Error.Message))
+		}
+
+		return output, status, nil
+	}
+}
+
+func flattenIamServiceLastAccessed(services []*iam.ServiceLastAccessed) []interface{} {
+	l := make([]interface{}, 0, len(services))
+
+	for _, service := range services {
+		m := map[string]interface{}{
+			"last_authenticated":           "",
+			"last_authenticated_entity":    aws.StringValue(service.LastAuthenticatedEntity),
+			"service_name":                 aws.StringValue(service.ServiceName),
+			"service_namespace":            aws.StringValue(service.ServiceNamespace),
+			"total_authenticated_entities": int(aws.Int64Value(service.TotalAuthenticatedEntities)),
+		}
+
+		if service.LastAuthenticated != nil {
+			m["last_authenticated"] = service.LastAuthenticated.Format(time.RFC3339)
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestFlattenIamServiceLastAccessed(t *testing.T) {
+	services := []*iam.ServiceLastAccessed{
+		{
+			LastAuthenticated:          aws.Time(time.Date(2019, 5, 1, 12, 0, 0, 0, time.UTC)),
+			LastAuthenticatedEntity:    aws.String("arn:aws:iam::123456789012:role/example"),
+			ServiceName:                aws.String("Amazon S3"),
+			ServiceNamespace:           aws.String("s3"),
+			TotalAuthenticatedEntities: aws.Int64(1),
+		},
+		{
+			ServiceName:                aws.String("Amazon EC2"),
+			ServiceNamespace:           aws.String("ec2"),
+			TotalAuthenticatedEntities: aws.Int64(0),
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"last_authenticated":           "2019-05-01T12:00:00Z",
+			"last_authenticated_entity":    "arn:aws:iam::123456789012:role/example",
+			"service_name":                 "Amazon S3",
+			"service_namespace":            "s3",
+			"total_authenticated_entities": 1,
+		},
+		map[string]interface{}{
+			"last_authenticated":           "",
+			"last_authenticated_entity":    "",
+			"service_name":                 "Amazon EC2",
+			"service_namespace":            "ec2",
+			"total_authenticated_entities": 0,
+		},
+	}
+
+	if got := flattenIamServiceLastAccessed(services); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected:\n%#v\n\ngot:\n%#v", expected, got)
+	}
+}
+
+func TestAccAWSDataSourceIAMServiceLastAccessed_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_iam_service_last_accessed.test"
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceIAMServiceLastAccessedConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_completion_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.0.service_namespace", "s3"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.0.last_authenticated", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSourceIAMServiceLastAccessedConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "s3:GetObject",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+data "aws_iam_service_last_accessed" "test" {
+  arn = "${aws_iam_role.test.arn}"
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
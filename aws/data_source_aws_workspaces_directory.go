@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsWorkspacesDirectory() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsWorkspacesDirectoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"directory_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_ip_addresses": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"iam_role_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"registration_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags": tagsSchemaComputed(),
+			"workspace_creation_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_ou": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_internet_access": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_work_docs": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"user_enabled_as_local_administrator": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"workspace_security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsWorkspacesDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+	directoryID := d.Get("directory_id").(string)
+
+	directory, err := describeWorkspacesDirectory(conn, directoryID)
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Directory (%s): %s", directoryID, err)
+	}
+
+	if directory == nil || aws.StringValue(directory.State) == workspaces.WorkspaceDirectoryStateDeregistered {
+		return fmt.Errorf("WorkSpaces Directory (%s) is not registered with WorkSpaces", directoryID)
+	}
+
+	d.SetId(directoryID)
+	d.Set("alias", directory.Alias)
+	d.Set("customer_user_name", directory.CustomerUserName)
+	d.Set("directory_name", directory.DirectoryName)
+	d.Set("directory_type", directory.DirectoryType)
+
+	if err := d.Set("dns_ip_addresses", flattenStringSet(directory.DnsIpAddresses)); err != nil {
+		return fmt.Errorf("error setting dns_ip_addresses: %s", err)
+	}
+
+	d.Set("iam_role_id", directory.IamRoleId)
+
+	if err := d.Set("ip_group_ids", flattenStringSet(directory.IpGroupIds)); err != nil {
+		return fmt.Errorf("error setting ip_group_ids: %s", err)
+	}
+
+	d.Set("registration_code", directory.RegistrationCode)
+	d.Set("state", directory.State)
+
+	if err := d.Set("subnet_ids", flattenStringSet(directory.SubnetIds)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %s", err)
+	}
+
+	tags, err := listWorkspacesTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces Directory (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.WorkspacesKeyValueTags(tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("workspace_creation_properties", flattenWorkspacesDefaultWorkspaceCreationProperties(directory.WorkspaceCreationProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_creation_properties: %s", err)
+	}
+
+	d.Set("workspace_security_group_id", directory.WorkspaceSecurityGroupId)
+
+	return nil
+}
+
+func flattenWorkspacesDefaultWorkspaceCreationProperties(properties *workspaces.DefaultWorkspaceCreationProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"custom_security_group_id":            aws.StringValue(properties.CustomSecurityGroupId),
+			"default_ou":                          aws.StringValue(properties.DefaultOu),
+			"enable_internet_access":              aws.BoolValue(properties.EnableInternetAccess),
+			"enable_work_docs":                    aws.BoolValue(properties.EnableWorkDocs),
+			"user_enabled_as_local_administrator": aws.BoolValue(properties.UserEnabledAsLocalAdministrator),
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccAWSWorkspacesDirectoryID(t *testing.T) string {
+	// Directories cannot be registered with WorkSpaces through the API, so use
+	// an environment variable to limit running these tests
+	directoryID := os.Getenv("AWS_WORKSPACES_DIRECTORY_ID")
+	if directoryID == "" {
+		t.Skip(
+			"Environment variable AWS_WORKSPACES_DIRECTORY_ID is not set. " +
+				"This environment variable must be set to the ID of " +
+				"a directory registered with WorkSpaces in the region where " +
+				"this test is running to enable the test.")
+	}
+
+	return directoryID
+}
+
+func TestAccDataSourceAwsWorkspacesDirectory_basic(t *testing.T) {
+	directoryID := testAccAWSWorkspacesDirectoryID(t)
+	dataSourceName := "data.aws_workspaces_directory.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSWorkspaces(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsWorkspacesDirectoryConfig(directoryID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "directory_id", directoryID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "directory_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "directory_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "registration_code"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "REGISTERED"),
+					resource.TestCheckResourceAttr(dataSourceName, "workspace_creation_properties.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "workspace_security_group_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsWorkspacesDirectoryConfig(directoryID string) string {
+	return fmt.Sprintf(`
+data "aws_workspaces_directory" "test" {
+  directory_id = %q
+}
+`, directoryID)
+}
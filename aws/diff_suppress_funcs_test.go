@@ -320,3 +320,54 @@ func TestSuppressRoute53ZoneNameWithTrailingDot(t *testing.T) {
 		}
 	}
 }
+
+func TestSuppressEquivalentRFC3339Timestamps(t *testing.T) {
+	testCases := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        "2030-01-01T00:00:00Z",
+			new:        "2030-01-01T00:00:00Z",
+			equivalent: true,
+		},
+		{
+			old:        "2030-01-01T00:00:00Z",
+			new:        "2030-01-01T01:00:00+01:00",
+			equivalent: true,
+		},
+		{
+			old:        "2030-01-01T00:00:00Z",
+			new:        "2030-01-01T00:00:00.000Z",
+			equivalent: true,
+		},
+		{
+			old:        "2030-01-01T00:00:00Z",
+			new:        "2030-01-01T00:00:00+01:00",
+			equivalent: false,
+		},
+		{
+			old:        "2030-01-01T00:00:00Z",
+			new:        "",
+			equivalent: false,
+		},
+		{
+			old:        "",
+			new:        "2030-01-01T00:00:00Z",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		value := suppressEquivalentRFC3339Timestamps("test_property", tc.old, tc.new, nil)
+
+		if tc.equivalent && !value {
+			t.Fatalf("expected test case %d to be equivalent", i)
+		}
+
+		if !tc.equivalent && value {
+			t.Fatalf("expected test case %d to not be equivalent", i)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func decodeEc2TransitGatewayRouteID(id string) (string, string, error) {
@@ -402,7 +403,7 @@ func expandEc2TransitGatewayTagSpecifications(m map[string]interface{}) []*ec2.T
 	return []*ec2.TagSpecification{
 		{
 			ResourceType: aws.String("transit-gateway"),
-			Tags:         tagsFromMap(m),
+			Tags:         keyvaluetags.New(m).IgnoreAws().Ec2Tags(),
 		},
 	}
 }
@@ -415,7 +416,7 @@ func expandEc2TransitGatewayAttachmentTagSpecifications(m map[string]interface{}
 	return []*ec2.TagSpecification{
 		{
 			ResourceType: aws.String("transit-gateway-attachment"),
-			Tags:         tagsFromMap(m),
+			Tags:         keyvaluetags.New(m).IgnoreAws().Ec2Tags(),
 		},
 	}
 }
@@ -428,7 +429,7 @@ func expandEc2TransitGatewayRouteTableTagSpecifications(m map[string]interface{}
 	return []*ec2.TagSpecification{
 		{
 			ResourceType: aws.String("transit-gateway-route-table"),
-			Tags:         tagsFromMap(m),
+			Tags:         keyvaluetags.New(m).IgnoreAws().Ec2Tags(),
 		},
 	}
 }
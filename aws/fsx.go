@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func describeFsxFileSystem(conn *fsx.FSx, id string) (*fsx.FileSystem, error) {
+	input := &fsx.DescribeFileSystemsInput{
+		FileSystemIds: []*string{aws.String(id)},
+	}
+	var filesystem *fsx.FileSystem
+
+	err := conn.DescribeFileSystemsPages(input, func(page *fsx.DescribeFileSystemsOutput, lastPage bool) bool {
+		for _, fs := range page.FileSystems {
+			if aws.StringValue(fs.FileSystemId) == id {
+				filesystem = fs
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return filesystem, err
+}
+
+func refreshFsxFileSystemLifecycle(conn *fsx.FSx, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		filesystem, err := describeFsxFileSystem(conn, id)
+
+		if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if filesystem == nil {
+			return nil, "", nil
+		}
+
+		if aws.StringValue(filesystem.Lifecycle) == fsx.FileSystemLifecycleFailed && filesystem.FailureDetails != nil {
+			return filesystem, fsx.FileSystemLifecycleFailed, errors.New(aws.StringValue(filesystem.FailureDetails.Message))
+		}
+
+		return filesystem, aws.StringValue(filesystem.Lifecycle), nil
+	}
+}
+
+func waitForFsxFileSystemCreation(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{fsx.FileSystemLifecycleCreating},
+		Target:  []string{fsx.FileSystemLifecycleAvailable},
+		Refresh: refreshFsxFileSystemLifecycle(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForFsxFileSystemDeletion(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{fsx.FileSystemLifecycleAvailable, fsx.FileSystemLifecycleDeleting},
+		Target:  []string{},
+		Refresh: refreshFsxFileSystemLifecycle(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func describeFsxBackup(conn *fsx.FSx, id string) (*fsx.Backup, error) {
+	input := &fsx.DescribeBackupsInput{
+		BackupIds: []*string{aws.String(id)},
+	}
+	var backup *fsx.Backup
+
+	err := conn.DescribeBackupsPages(input, func(page *fsx.DescribeBackupsOutput, lastPage bool) bool {
+		for _, b := range page.Backups {
+			if aws.StringValue(b.BackupId) == id {
+				backup = b
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return backup, err
+}
+
+func refreshFsxBackupLifecycle(conn *fsx.FSx, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		backup, err := describeFsxBackup(conn, id)
+
+		if isAWSErr(err, fsx.ErrCodeBackupNotFound, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if backup == nil || aws.StringValue(backup.Lifecycle) == fsx.BackupLifecycleDeleted {
+			return nil, "", nil
+		}
+
+		if aws.StringValue(backup.Lifecycle) == fsx.BackupLifecycleFailed && backup.FailureDetails != nil {
+			return backup, fsx.BackupLifecycleFailed, errors.New(aws.StringValue(backup.FailureDetails.Message))
+		}
+
+		return backup, aws.StringValue(backup.Lifecycle), nil
+	}
+}
+
+func waitForFsxBackupCreation(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{fsx.BackupLifecycleCreating},
+		Target:  []string{fsx.BackupLifecycleAvailable},
+		Refresh: refreshFsxBackupLifecycle(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForFsxBackupDeletion(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{fsx.BackupLifecycleAvailable},
+		Target:  []string{},
+		Refresh: refreshFsxBackupLifecycle(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,342 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var (
+	iamPolicyActionRegexp           = regexp.MustCompile(`^([^:]+):(.+)$`)
+	iamPolicyActionServiceRegexp    = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+	iamPolicyActionNameRegexp       = regexp.MustCompile(`^[A-Za-z0-9_.*?-]+$`)
+	iamPolicyAWSPrincipalRegexp     = regexp.MustCompile(`^(\*|\d{12}|arn:aws[a-z-]*:(iam|sts)::[^:]+:.+|A[A-Z0-9]{15,})$`)
+	iamPolicyCanonicalUserRegexp    = regexp.MustCompile(`^[0-9a-f]{64}$`)
+	iamPolicyConditionSetOperators  = []string{"ForAllValues:", "ForAnyValue:"}
+	iamPolicyConditionOperatorNames = []string{
+		"ArnEquals",
+		"ArnLike",
+		"ArnNotEquals",
+		"ArnNotLike",
+		"BinaryEquals",
+		"Bool",
+		"DateEquals",
+		"DateGreaterThan",
+		"DateGreaterThanEquals",
+		"DateLessThan",
+		"DateLessThanEquals",
+		"DateNotEquals",
+		"IpAddress",
+		"NotIpAddress",
+		"Null",
+		"NumericEquals",
+		"NumericGreaterThan",
+		"NumericGreaterThanEquals",
+		"NumericLessThan",
+		"NumericLessThanEquals",
+		"NumericNotEquals",
+		"StringEquals",
+		"StringEqualsIgnoreCase",
+		"StringLike",
+		"StringNotEquals",
+		"StringNotEqualsIgnoreCase",
+		"StringNotLike",
+	}
+)
+
+// lintIAMPolicyDocument statically checks a JSON policy document for the
+// mistakes IAM would otherwise only report during apply. Identity-based
+// policies must not have a principal, resource-based policies require one.
+// maxLength is the maximum number of non-whitespace characters, 0 for no
+// limit. The document must already be valid JSON.
+func lintIAMPolicyDocument(document, k string, resourceBased bool, maxLength int) []error {
+	var errors []error
+
+	if maxLength > 0 {
+		length := 0
+		for _, r := range document {
+			if !unicode.IsSpace(r) {
+				length++
+			}
+		}
+
+		if length > maxLength {
+			errors = append(errors, fmt.Errorf("%q: policy has %d non-whitespace characters, the limit is %d", k, length, maxLength))
+		}
+	}
+
+	var raw struct {
+		Version   string
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		return append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+
+	if raw.Version != "" && raw.Version != "2012-10-17" && raw.Version != "2008-10-17" {
+		errors = append(errors, fmt.Errorf("%q: Version must be 2012-10-17 or 2008-10-17, got %q", k, raw.Version))
+	}
+
+	if len(raw.Statement) == 0 {
+		return append(errors, fmt.Errorf("%q: policy must have a Statement", k))
+	}
+
+	// Statement is either a single statement or a list of statements.
+	var rawStatements []json.RawMessage
+	if strings.HasPrefix(strings.TrimSpace(string(raw.Statement)), "{") {
+		rawStatements = []json.RawMessage{raw.Statement}
+	} else if err := json.Unmarshal(raw.Statement, &rawStatements); err != nil {
+		return append(errors, fmt.Errorf("%q: Statement must be an object or a list of objects", k))
+	}
+
+	for i, rawStatement := range rawStatements {
+		var statement IAMPolicyStatement
+
+		label := fmt.Sprintf("statement %d", i)
+
+		if err := json.Unmarshal(rawStatement, &statement); err != nil {
+			errors = append(errors, fmt.Errorf("%q: %s: %s", k, label, err))
+			continue
+		}
+
+		if statement.Sid != "" {
+			label = fmt.Sprintf("statement %q", statement.Sid)
+		}
+
+		for _, err := range lintIAMPolicyStatement(&statement, rawStatement, resourceBased) {
+			errors = append(errors, fmt.Errorf("%q: %s: %s", k, label, err))
+		}
+	}
+
+	return errors
+}
+
+func lintIAMPolicyStatement(statement *IAMPolicyStatement, rawStatement json.RawMessage, resourceBased bool) []error {
+	var errors []error
+
+	if statement.Effect != "Allow" && statement.Effect != "Deny" {
+		errors = append(errors, fmt.Errorf("Effect must be Allow or Deny, got %q", statement.Effect))
+	}
+
+	switch {
+	case statement.Actions != nil && statement.NotActions != nil:
+		errors = append(errors, fmt.Errorf("only one of Action or NotAction can be set"))
+	case statement.Actions == nil && statement.NotActions == nil:
+		errors = append(errors, fmt.Errorf("one of Action or NotAction must be set"))
+	}
+
+	errors = append(errors, lintIAMPolicyElement("Action", statement.Actions, lintIAMPolicyAction)...)
+	errors = append(errors, lintIAMPolicyElement("NotAction", statement.NotActions, lintIAMPolicyAction)...)
+
+	if statement.Resources != nil && statement.NotResources != nil {
+		errors = append(errors, fmt.Errorf("only one of Resource or NotResource can be set"))
+	}
+
+	errors = append(errors, lintIAMPolicyElement("Resource", statement.Resources, lintIAMPolicyResource)...)
+	errors = append(errors, lintIAMPolicyElement("NotResource", statement.NotResources, lintIAMPolicyResource)...)
+
+	// A string principal is decoded as "*" whatever its value and conditions
+	// with non-string values are dropped, so check the raw statement.
+	var rawElements struct {
+		Principal    json.RawMessage
+		NotPrincipal json.RawMessage
+		Condition    map[string]json.RawMessage
+	}
+
+	if err := json.Unmarshal(rawStatement, &rawElements); err != nil {
+		return append(errors, err)
+	}
+
+	hasPrincipal := len(rawElements.Principal) > 0
+	hasNotPrincipal := len(rawElements.NotPrincipal) > 0
+
+	switch {
+	case hasPrincipal && hasNotPrincipal:
+		errors = append(errors, fmt.Errorf("only one of Principal or NotPrincipal can be set"))
+	case !resourceBased && (hasPrincipal || hasNotPrincipal):
+		errors = append(errors, fmt.Errorf("Principal and NotPrincipal are not supported in identity-based policies"))
+	case resourceBased && !hasPrincipal && !hasNotPrincipal:
+		errors = append(errors, fmt.Errorf("one of Principal or NotPrincipal must be set"))
+	}
+
+	errors = append(errors, lintIAMPolicyPrincipals("Principal", rawElements.Principal, statement.Principals)...)
+	errors = append(errors, lintIAMPolicyPrincipals("NotPrincipal", rawElements.NotPrincipal, statement.NotPrincipals)...)
+
+	operators := make([]string, 0, len(rawElements.Condition))
+	for operator := range rawElements.Condition {
+		operators = append(operators, operator)
+	}
+	sort.Strings(operators)
+
+	for _, operator := range operators {
+		if err := lintIAMPolicyConditionOperator(operator); err != nil {
+			errors = append(errors, err)
+		}
+	}
+
+	return errors
+}
+
+// lintIAMPolicyElement checks each value of a string or list of strings
+// statement element.
+func lintIAMPolicyElement(name string, v interface{}, f func(string) error) []error {
+	var values []interface{}
+
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case string:
+		values = []interface{}{v}
+	case []interface{}:
+		values = v
+	default:
+		return []error{fmt.Errorf("%s must be a string or a list of strings", name)}
+	}
+
+	var errors []error
+
+	for _, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("%s must be a string or a list of strings", name))
+			continue
+		}
+
+		if err := f(s); err != nil {
+			errors = append(errors, fmt.Errorf("%s: %s", name, err))
+		}
+	}
+
+	return errors
+}
+
+func lintIAMPolicyAction(action string) error {
+	if action == "*" {
+		return nil
+	}
+
+	matches := iamPolicyActionRegexp.FindStringSubmatch(action)
+	if matches == nil {
+		return fmt.Errorf("%q must be * or in the form service:action", action)
+	}
+
+	if strings.ContainsAny(matches[1], "*?") {
+		return fmt.Errorf("%q: wildcards are only allowed in the action name, not the service prefix", action)
+	}
+
+	if !iamPolicyActionServiceRegexp.MatchString(matches[1]) || !iamPolicyActionNameRegexp.MatchString(matches[2]) {
+		return fmt.Errorf("%q must be * or in the form service:action", action)
+	}
+
+	return nil
+}
+
+func lintIAMPolicyResource(resource string) error {
+	if resource == "*" || strings.HasPrefix(resource, "arn:") {
+		return nil
+	}
+
+	return fmt.Errorf("%q must be * or an ARN", resource)
+}
+
+func lintIAMPolicyPrincipals(name string, raw json.RawMessage, principals IAMPolicyStatementPrincipalSet) []error {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if s != "*" {
+			return []error{fmt.Errorf("%s must be * or an object, got %q", name, s)}
+		}
+
+		return nil
+	}
+
+	var errors []error
+
+	for _, principal := range principals {
+		var identifiers []string
+
+		switch v := principal.Identifiers.(type) {
+		case string:
+			identifiers = []string{v}
+		case []string:
+			identifiers = v
+		}
+
+		for _, identifier := range identifiers {
+			if err := lintIAMPolicyPrincipal(principal.Type, identifier); err != nil {
+				errors = append(errors, fmt.Errorf("%s: %s", name, err))
+			}
+		}
+	}
+
+	return errors
+}
+
+func lintIAMPolicyPrincipal(principalType, identifier string) error {
+	switch principalType {
+	case "AWS":
+		if identifier != "*" && strings.ContainsAny(identifier, "*?") {
+			return fmt.Errorf("%q: wildcards are not allowed in AWS principals, use * on its own", identifier)
+		}
+
+		if !iamPolicyAWSPrincipalRegexp.MatchString(identifier) {
+			return fmt.Errorf("%q must be *, an account ID or an IAM ARN", identifier)
+		}
+	case "CanonicalUser":
+		if !iamPolicyCanonicalUserRegexp.MatchString(identifier) {
+			return fmt.Errorf("%q must be a canonical user ID", identifier)
+		}
+	case "Federated", "Service":
+		if identifier == "" || strings.ContainsAny(identifier, "*? ") {
+			return fmt.Errorf("%q is not a valid %s principal", identifier, principalType)
+		}
+	case "*":
+		if identifier != "*" {
+			return fmt.Errorf("%q must be * for principal type *", identifier)
+		}
+	default:
+		return fmt.Errorf("unsupported principal type %q, must be AWS, CanonicalUser, Federated or Service", principalType)
+	}
+
+	return nil
+}
+
+// lintIAMPolicyConditionOperator checks a condition operator, which can have
+// a ForAllValues: or ForAnyValue: prefix and an IfExists suffix.
+func lintIAMPolicyConditionOperator(operator string) error {
+	name := operator
+
+	for _, prefix := range iamPolicyConditionSetOperators {
+		if len(name) > len(prefix) && strings.EqualFold(name[:len(prefix)], prefix) {
+			name = name[len(prefix):]
+			break
+		}
+	}
+
+	ifExists := false
+	if len(name) > len("IfExists") && strings.EqualFold(name[len(name)-len("IfExists"):], "IfExists") {
+		name = name[:len(name)-len("IfExists")]
+		ifExists = true
+	}
+
+	for _, valid := range iamPolicyConditionOperatorNames {
+		if !strings.EqualFold(name, valid) {
+			continue
+		}
+
+		if ifExists && valid == "Null" {
+			return fmt.Errorf("condition operator %q: Null cannot be used with IfExists", operator)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("unknown condition operator %q", operator)
+}
@@ -0,0 +1,388 @@
+package fakeaws
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// dynamodbState is the state of the fake DynamoDB API. Tables and indexes
+// become active as soon as they are created, items are not modeled.
+type dynamodbState struct {
+	tables map[string]*dynamodbTable
+}
+
+func newDynamodbState() *dynamodbState {
+	return &dynamodbState{
+		tables: make(map[string]*dynamodbTable),
+	}
+}
+
+// dynamodbTable is a table with its settings not part of its description.
+type dynamodbTable struct {
+	table                      *dynamodb.TableDescription
+	pointInTimeRecoveryEnabled bool
+	tags                       []*dynamodb.Tag
+	timeToLive                 *dynamodb.TimeToLiveDescription
+}
+
+func (s *Server) serveDynamodb(w http.ResponseWriter, r *http.Request) {
+	s.serveJSON(w, r, "dynamodb", "com.amazonaws.dynamodb.v20120810#", operations{
+		"CreateTable":               s.dynamodbCreateTable,
+		"DeleteTable":               s.dynamodbDeleteTable,
+		"DescribeContinuousBackups": s.dynamodbDescribeContinuousBackups,
+		"DescribeTable":             s.dynamodbDescribeTable,
+		"DescribeTimeToLive":        s.dynamodbDescribeTimeToLive,
+		"ListTables":                s.dynamodbListTables,
+		"ListTagsOfResource":        s.dynamodbListTagsOfResource,
+		"TagResource":               s.dynamodbTagResource,
+		"UntagResource":             s.dynamodbUntagResource,
+		"UpdateContinuousBackups":   s.dynamodbUpdateContinuousBackups,
+		"UpdateTable":               s.dynamodbUpdateTable,
+		"UpdateTimeToLive":          s.dynamodbUpdateTimeToLive,
+	})
+}
+
+// dynamodbTable returns a table by name.
+func (s *Server) dynamodbTable(name *string) (*dynamodbTable, error) {
+	table, ok := s.dynamodb.tables[aws.StringValue(name)]
+
+	if !ok {
+		return nil, newError(dynamodb.ErrCodeResourceNotFoundException, "Requested resource not found: Table: %s not found", aws.StringValue(name))
+	}
+
+	return table, nil
+}
+
+// dynamodbTableByArn returns a table by ARN.
+func (s *Server) dynamodbTableByArn(arn *string) (*dynamodbTable, error) {
+	name := aws.StringValue(arn)[strings.LastIndex(aws.StringValue(arn), "/")+1:]
+
+	return s.dynamodbTable(aws.String(name))
+}
+
+func dynamodbProvisionedThroughput(throughput *dynamodb.ProvisionedThroughput) *dynamodb.ProvisionedThroughputDescription {
+	description := &dynamodb.ProvisionedThroughputDescription{
+		NumberOfDecreasesToday: aws.Int64(0),
+		ReadCapacityUnits:      aws.Int64(0),
+		WriteCapacityUnits:     aws.Int64(0),
+	}
+
+	if throughput != nil {
+		description.ReadCapacityUnits = throughput.ReadCapacityUnits
+		description.WriteCapacityUnits = throughput.WriteCapacityUnits
+	}
+
+	return description
+}
+
+func (s *Server) dynamodbGlobalSecondaryIndex(tableArn string, index *dynamodb.GlobalSecondaryIndex) *dynamodb.GlobalSecondaryIndexDescription {
+	return &dynamodb.GlobalSecondaryIndexDescription{
+		IndexArn:              aws.String(tableArn + "/index/" + aws.StringValue(index.IndexName)),
+		IndexName:             index.IndexName,
+		IndexSizeBytes:        aws.Int64(0),
+		IndexStatus:           aws.String(dynamodb.IndexStatusActive),
+		ItemCount:             aws.Int64(0),
+		KeySchema:             index.KeySchema,
+		Projection:            index.Projection,
+		ProvisionedThroughput: dynamodbProvisionedThroughput(index.ProvisionedThroughput),
+	}
+}
+
+func (s *Server) dynamodbSetStreamSpecification(table *dynamodb.TableDescription, specification *dynamodb.StreamSpecification) {
+	if specification == nil {
+		return
+	}
+
+	if !aws.BoolValue(specification.StreamEnabled) {
+		table.StreamSpecification = nil
+		return
+	}
+
+	now := time.Now().UTC().Format("2006-01-02T15:04:05.000")
+	table.StreamSpecification = specification
+	table.LatestStreamArn = aws.String(aws.StringValue(table.TableArn) + "/stream/" + now)
+	table.LatestStreamLabel = aws.String(now)
+}
+
+func dynamodbSSEDescription(specification *dynamodb.SSESpecification) *dynamodb.SSEDescription {
+	if specification == nil || !aws.BoolValue(specification.Enabled) {
+		return nil
+	}
+
+	return &dynamodb.SSEDescription{
+		KMSMasterKeyArn: specification.KMSMasterKeyId,
+		SSEType:         aws.String(dynamodb.SSETypeKms),
+		Status:          aws.String(dynamodb.SSEStatusEnabled),
+	}
+}
+
+func (s *Server) dynamodbCreateTable(in *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error) {
+	name := aws.StringValue(in.TableName)
+
+	if _, ok := s.dynamodb.tables[name]; ok {
+		return nil, newError(dynamodb.ErrCodeResourceInUseException, "Table already exists: %s", name)
+	}
+
+	billingMode := aws.StringValue(in.BillingMode)
+	if billingMode == "" {
+		billingMode = dynamodb.BillingModeProvisioned
+	}
+
+	now := time.Now().UTC()
+	table := &dynamodb.TableDescription{
+		AttributeDefinitions: in.AttributeDefinitions,
+		BillingModeSummary: &dynamodb.BillingModeSummary{
+			BillingMode:                       aws.String(billingMode),
+			LastUpdateToPayPerRequestDateTime: aws.Time(now),
+		},
+		CreationDateTime:      aws.Time(now),
+		ItemCount:             aws.Int64(0),
+		KeySchema:             in.KeySchema,
+		ProvisionedThroughput: dynamodbProvisionedThroughput(in.ProvisionedThroughput),
+		SSEDescription:        dynamodbSSEDescription(in.SSESpecification),
+		TableArn:              aws.String(s.arn("dynamodb", s.region, "table/"+name)),
+		TableId:               aws.String(s.requestID()),
+		TableName:             in.TableName,
+		TableSizeBytes:        aws.Int64(0),
+		TableStatus:           aws.String(dynamodb.TableStatusActive),
+	}
+
+	for _, index := range in.GlobalSecondaryIndexes {
+		table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes, s.dynamodbGlobalSecondaryIndex(aws.StringValue(table.TableArn), index))
+	}
+
+	for _, index := range in.LocalSecondaryIndexes {
+		table.LocalSecondaryIndexes = append(table.LocalSecondaryIndexes, &dynamodb.LocalSecondaryIndexDescription{
+			IndexArn:       aws.String(aws.StringValue(table.TableArn) + "/index/" + aws.StringValue(index.IndexName)),
+			IndexName:      index.IndexName,
+			IndexSizeBytes: aws.Int64(0),
+			ItemCount:      aws.Int64(0),
+			KeySchema:      index.KeySchema,
+			Projection:     index.Projection,
+		})
+	}
+
+	s.dynamodbSetStreamSpecification(table, in.StreamSpecification)
+
+	s.dynamodb.tables[name] = &dynamodbTable{
+		table: table,
+		tags:  in.Tags,
+		timeToLive: &dynamodb.TimeToLiveDescription{
+			TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusDisabled),
+		},
+	}
+
+	return &dynamodb.CreateTableOutput{TableDescription: table}, nil
+}
+
+func (s *Server) dynamodbDescribeTable(in *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	table, err := s.dynamodbTable(in.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamodb.DescribeTableOutput{Table: table.table}, nil
+}
+
+func (s *Server) dynamodbListTables(in *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error) {
+	var names []string
+	for name := range s.dynamodb.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return &dynamodb.ListTablesOutput{TableNames: aws.StringSlice(names)}, nil
+}
+
+func (s *Server) dynamodbUpdateTable(in *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
+	t, err := s.dynamodbTable(in.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	table := t.table
+
+	if in.AttributeDefinitions != nil {
+		table.AttributeDefinitions = in.AttributeDefinitions
+	}
+
+	if in.BillingMode != nil {
+		table.BillingModeSummary.BillingMode = in.BillingMode
+	}
+
+	if in.ProvisionedThroughput != nil {
+		table.ProvisionedThroughput = dynamodbProvisionedThroughput(in.ProvisionedThroughput)
+	}
+
+	if in.SSESpecification != nil {
+		table.SSEDescription = dynamodbSSEDescription(in.SSESpecification)
+	}
+
+	s.dynamodbSetStreamSpecification(table, in.StreamSpecification)
+
+	for _, update := range in.GlobalSecondaryIndexUpdates {
+		switch {
+		case update.Create != nil:
+			table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes, s.dynamodbGlobalSecondaryIndex(aws.StringValue(table.TableArn), &dynamodb.GlobalSecondaryIndex{
+				IndexName:             update.Create.IndexName,
+				KeySchema:             update.Create.KeySchema,
+				Projection:            update.Create.Projection,
+				ProvisionedThroughput: update.Create.ProvisionedThroughput,
+			}))
+		case update.Update != nil:
+			for _, index := range table.GlobalSecondaryIndexes {
+				if aws.StringValue(index.IndexName) == aws.StringValue(update.Update.IndexName) {
+					index.ProvisionedThroughput = dynamodbProvisionedThroughput(update.Update.ProvisionedThroughput)
+				}
+			}
+		case update.Delete != nil:
+			for i, index := range table.GlobalSecondaryIndexes {
+				if aws.StringValue(index.IndexName) == aws.StringValue(update.Delete.IndexName) {
+					table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes[:i], table.GlobalSecondaryIndexes[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+
+	return &dynamodb.UpdateTableOutput{TableDescription: table}, nil
+}
+
+func (s *Server) dynamodbDeleteTable(in *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+	table, err := s.dynamodbTable(in.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.dynamodb.tables, aws.StringValue(in.TableName))
+
+	description := *table.table
+	description.TableStatus = aws.String(dynamodb.TableStatusDeleting)
+
+	return &dynamodb.DeleteTableOutput{TableDescription: &description}, nil
+}
+
+func (s *Server) dynamodbDescribeTimeToLive(in *dynamodb.DescribeTimeToLiveInput) (*dynamodb.DescribeTimeToLiveOutput, error) {
+	table, err := s.dynamodbTable(in.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamodb.DescribeTimeToLiveOutput{TimeToLiveDescription: table.timeToLive}, nil
+}
+
+func (s *Server) dynamodbUpdateTimeToLive(in *dynamodb.UpdateTimeToLiveInput) (*dynamodb.UpdateTimeToLiveOutput, error) {
+	table, err := s.dynamodbTable(in.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	table.timeToLive = &dynamodb.TimeToLiveDescription{
+		TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusDisabled),
+	}
+
+	if aws.BoolValue(in.TimeToLiveSpecification.Enabled) {
+		table.timeToLive = &dynamodb.TimeToLiveDescription{
+			AttributeName:    in.TimeToLiveSpecification.AttributeName,
+			TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusEnabled),
+		}
+	}
+
+	return &dynamodb.UpdateTimeToLiveOutput{TimeToLiveSpecification: in.TimeToLiveSpecification}, nil
+}
+
+func (s *Server) dynamodbContinuousBackups(table *dynamodbTable) *dynamodb.ContinuousBackupsDescription {
+	status := dynamodb.PointInTimeRecoveryStatusDisabled
+	if table.pointInTimeRecoveryEnabled {
+		status = dynamodb.PointInTimeRecoveryStatusEnabled
+	}
+
+	return &dynamodb.ContinuousBackupsDescription{
+		ContinuousBackupsStatus: aws.String(dynamodb.ContinuousBackupsStatusEnabled),
+		PointInTimeRecoveryDescription: &dynamodb.PointInTimeRecoveryDescription{
+			PointInTimeRecoveryStatus: aws.String(status),
+		},
+	}
+}
+
+func (s *Server) dynamodbDescribeContinuousBackups(in *dynamodb.DescribeContinuousBackupsInput) (*dynamodb.DescribeContinuousBackupsOutput, error) {
+	table, err := s.dynamodbTable(in.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamodb.DescribeContinuousBackupsOutput{ContinuousBackupsDescription: s.dynamodbContinuousBackups(table)}, nil
+}
+
+func (s *Server) dynamodbUpdateContinuousBackups(in *dynamodb.UpdateContinuousBackupsInput) (*dynamodb.UpdateContinuousBackupsOutput, error) {
+	table, err := s.dynamodbTable(in.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	table.pointInTimeRecoveryEnabled = aws.BoolValue(in.PointInTimeRecoverySpecification.PointInTimeRecoveryEnabled)
+
+	return &dynamodb.UpdateContinuousBackupsOutput{ContinuousBackupsDescription: s.dynamodbContinuousBackups(table)}, nil
+}
+
+func (s *Server) dynamodbListTagsOfResource(in *dynamodb.ListTagsOfResourceInput) (*dynamodb.ListTagsOfResourceOutput, error) {
+	table, err := s.dynamodbTableByArn(in.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamodb.ListTagsOfResourceOutput{Tags: table.tags}, nil
+}
+
+func (s *Server) dynamodbTagResource(in *dynamodb.TagResourceInput) (*dynamodb.TagResourceOutput, error) {
+	table, err := s.dynamodbTableByArn(in.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range in.Tags {
+		table.tags = append(dynamodbRemoveTag(table.tags, aws.StringValue(tag.Key)), tag)
+	}
+
+	return &dynamodb.TagResourceOutput{}, nil
+}
+
+func (s *Server) dynamodbUntagResource(in *dynamodb.UntagResourceInput) (*dynamodb.UntagResourceOutput, error) {
+	table, err := s.dynamodbTableByArn(in.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range in.TagKeys {
+		table.tags = dynamodbRemoveTag(table.tags, aws.StringValue(key))
+	}
+
+	return &dynamodb.UntagResourceOutput{}, nil
+}
+
+func dynamodbRemoveTag(tags []*dynamodb.Tag, key string) []*dynamodb.Tag {
+	var result []*dynamodb.Tag
+
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) != key {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
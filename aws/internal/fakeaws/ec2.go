@@ -0,0 +1,1450 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// ec2State is the state of the fake EC2 API. VPCs are created with a main
+// route table, a default network ACL and a default security group, as in
+// AWS.
+type ec2State struct {
+	networkAcls    map[string]*ec2.NetworkAcl
+	routeTables    map[string]*ec2.RouteTable
+	securityGroups map[string]*ec2SecurityGroup
+	subnets        map[string]*ec2.Subnet
+	vpcAttributes  map[string]*ec2VpcAttributes
+	vpcs           map[string]*ec2.Vpc
+}
+
+func newEc2State() *ec2State {
+	return &ec2State{
+		networkAcls:    make(map[string]*ec2.NetworkAcl),
+		routeTables:    make(map[string]*ec2.RouteTable),
+		securityGroups: make(map[string]*ec2SecurityGroup),
+		subnets:        make(map[string]*ec2.Subnet),
+		vpcAttributes:  make(map[string]*ec2VpcAttributes),
+		vpcs:           make(map[string]*ec2.Vpc),
+	}
+}
+
+// ec2VpcAttributes are the attributes of a VPC not part of ec2.Vpc.
+type ec2VpcAttributes struct {
+	classicLinkDnsSupported bool
+	classicLinkEnabled      bool
+	enableDnsHostnames      bool
+	enableDnsSupport        bool
+}
+
+// ec2SecurityGroup is a security group with its rules kept as one rule per
+// protocol, port range and source, which is how AWS matches rules when they
+// are authorized or revoked.
+type ec2SecurityGroup struct {
+	group   *ec2.SecurityGroup
+	egress  []*ec2SecurityGroupRule
+	ingress []*ec2SecurityGroupRule
+}
+
+// ec2SecurityGroupRule is a security group rule with a single source.
+type ec2SecurityGroupRule struct {
+	protocol     string
+	fromPort     *int64
+	toPort       *int64
+	ipRange      *ec2.IpRange
+	ipv6Range    *ec2.Ipv6Range
+	prefixListID *ec2.PrefixListId
+	group        *ec2.UserIdGroupPair
+}
+
+// key identifies a rule regardless of its description.
+func (r *ec2SecurityGroupRule) key() string {
+	key := fmt.Sprintf("%s/%d/%d/", r.protocol, aws.Int64Value(r.fromPort), aws.Int64Value(r.toPort))
+
+	switch {
+	case r.ipRange != nil:
+		return key + aws.StringValue(r.ipRange.CidrIp)
+	case r.ipv6Range != nil:
+		return key + aws.StringValue(r.ipv6Range.CidrIpv6)
+	case r.prefixListID != nil:
+		return key + aws.StringValue(r.prefixListID.PrefixListId)
+	default:
+		return key + aws.StringValue(r.group.GroupId)
+	}
+}
+
+func (s *Server) serveEc2(w http.ResponseWriter, r *http.Request) {
+	s.serveQuery(w, r, "ec2", true, operations{
+		"AssociateVpcCidrBlock":                      s.ec2AssociateVpcCidrBlock,
+		"AuthorizeSecurityGroupEgress":               s.ec2AuthorizeSecurityGroupEgress,
+		"AuthorizeSecurityGroupIngress":              s.ec2AuthorizeSecurityGroupIngress,
+		"CreateSecurityGroup":                        s.ec2CreateSecurityGroup,
+		"CreateSubnet":                               s.ec2CreateSubnet,
+		"CreateTags":                                 s.ec2CreateTags,
+		"CreateVpc":                                  s.ec2CreateVpc,
+		"DeleteSecurityGroup":                        s.ec2DeleteSecurityGroup,
+		"DeleteSubnet":                               s.ec2DeleteSubnet,
+		"DeleteTags":                                 s.ec2DeleteTags,
+		"DeleteVpc":                                  s.ec2DeleteVpc,
+		"DescribeAccountAttributes":                  s.ec2DescribeAccountAttributes,
+		"DescribeAvailabilityZones":                  s.ec2DescribeAvailabilityZones,
+		"DescribeNetworkAcls":                        s.ec2DescribeNetworkAcls,
+		"DescribeNetworkInterfaces":                  s.ec2DescribeNetworkInterfaces,
+		"DescribeRouteTables":                        s.ec2DescribeRouteTables,
+		"DescribeSecurityGroups":                     s.ec2DescribeSecurityGroups,
+		"DescribeSubnets":                            s.ec2DescribeSubnets,
+		"DescribeVpcAttribute":                       s.ec2DescribeVpcAttribute,
+		"DescribeVpcClassicLink":                     s.ec2DescribeVpcClassicLink,
+		"DescribeVpcClassicLinkDnsSupport":           s.ec2DescribeVpcClassicLinkDnsSupport,
+		"DescribeVpcs":                               s.ec2DescribeVpcs,
+		"DisableVpcClassicLink":                      s.ec2DisableVpcClassicLink,
+		"DisableVpcClassicLinkDnsSupport":            s.ec2DisableVpcClassicLinkDnsSupport,
+		"DisassociateVpcCidrBlock":                   s.ec2DisassociateVpcCidrBlock,
+		"EnableVpcClassicLink":                       s.ec2EnableVpcClassicLink,
+		"EnableVpcClassicLinkDnsSupport":             s.ec2EnableVpcClassicLinkDnsSupport,
+		"ModifySubnetAttribute":                      s.ec2ModifySubnetAttribute,
+		"ModifyVpcAttribute":                         s.ec2ModifyVpcAttribute,
+		"ModifyVpcTenancy":                           s.ec2ModifyVpcTenancy,
+		"RevokeSecurityGroupEgress":                  s.ec2RevokeSecurityGroupEgress,
+		"RevokeSecurityGroupIngress":                 s.ec2RevokeSecurityGroupIngress,
+		"UpdateSecurityGroupRuleDescriptionsEgress":  s.ec2UpdateSecurityGroupRuleDescriptionsEgress,
+		"UpdateSecurityGroupRuleDescriptionsIngress": s.ec2UpdateSecurityGroupRuleDescriptionsIngress,
+	})
+}
+
+// ec2Filter returns whether a resource with the given filter attributes and
+// tags matches all filters. Filter values may contain * and ? wildcards.
+func ec2Filter(filters []*ec2.Filter, attributes map[string][]string, tags []*ec2.Tag) (bool, error) {
+	for _, filter := range filters {
+		name := aws.StringValue(filter.Name)
+
+		var values []string
+
+		switch {
+		case strings.HasPrefix(name, "tag:"):
+			for _, tag := range tags {
+				if aws.StringValue(tag.Key) == strings.TrimPrefix(name, "tag:") {
+					values = append(values, aws.StringValue(tag.Value))
+				}
+			}
+		case name == "tag-key":
+			for _, tag := range tags {
+				values = append(values, aws.StringValue(tag.Key))
+			}
+		default:
+			var ok bool
+
+			if values, ok = attributes[name]; !ok {
+				return false, newError("InvalidParameterValue", "The filter '%s' is invalid", name)
+			}
+		}
+
+		if !ec2FilterValuesMatch(aws.StringValueSlice(filter.Values), values) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func ec2FilterValuesMatch(patterns, values []string) bool {
+	for _, pattern := range patterns {
+		for _, value := range values {
+			if ok, _ := path.Match(pattern, value); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ec2IDsFilter returns whether id is in ids, or ids is empty.
+func ec2IDsFilter(ids []*string, id string) bool {
+	if len(ids) == 0 {
+		return true
+	}
+
+	for _, v := range ids {
+		if aws.StringValue(v) == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ec2CheckIDs returns a not found error for the first ID without resource.
+func ec2CheckIDs(ids []*string, exists func(string) bool, code, kind string) error {
+	for _, id := range ids {
+		if !exists(aws.StringValue(id)) {
+			return newError(code, "The %s '%s' does not exist", kind, aws.StringValue(id))
+		}
+	}
+
+	return nil
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+
+	switch m := m.(type) {
+	case map[string]*ec2.Vpc:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*ec2.Subnet:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*ec2SecurityGroup:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*ec2.RouteTable:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*ec2.NetworkAcl:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (s *Server) ec2DescribeAccountAttributes(in *ec2.DescribeAccountAttributesInput) (*ec2.DescribeAccountAttributesOutput, error) {
+	attributes := map[string]string{
+		"default-vpc":         "none",
+		"max-instances":       "20",
+		"supported-platforms": "VPC",
+	}
+
+	out := &ec2.DescribeAccountAttributesOutput{}
+
+	for _, name := range []string{"default-vpc", "max-instances", "supported-platforms"} {
+		if !ec2IDsFilter(in.AttributeNames, name) {
+			continue
+		}
+
+		out.AccountAttributes = append(out.AccountAttributes, &ec2.AccountAttribute{
+			AttributeName: aws.String(name),
+			AttributeValues: []*ec2.AccountAttributeValue{
+				{AttributeValue: aws.String(attributes[name])},
+			},
+		})
+	}
+
+	return out, nil
+}
+
+func (s *Server) ec2DescribeAvailabilityZones(in *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
+	out := &ec2.DescribeAvailabilityZonesOutput{}
+
+	for i, suffix := range []string{"a", "b", "c"} {
+		zone := &ec2.AvailabilityZone{
+			RegionName: aws.String(s.region),
+			State:      aws.String(ec2.AvailabilityZoneStateAvailable),
+			ZoneId:     aws.String(ec2ZoneID(s.region, i)),
+			ZoneName:   aws.String(s.region + suffix),
+		}
+
+		if !ec2IDsFilter(in.ZoneNames, aws.StringValue(zone.ZoneName)) || !ec2IDsFilter(in.ZoneIds, aws.StringValue(zone.ZoneId)) {
+			continue
+		}
+
+		ok, err := ec2Filter(in.Filters, map[string][]string{
+			"region-name": {s.region},
+			"state":       {ec2.AvailabilityZoneStateAvailable},
+			"zone-id":     {aws.StringValue(zone.ZoneId)},
+			"zone-name":   {aws.StringValue(zone.ZoneName)},
+		}, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			out.AvailabilityZones = append(out.AvailabilityZones, zone)
+		}
+	}
+
+	return out, nil
+}
+
+// ec2ZoneID returns the ID of the i-th availability zone of a region, e.g.
+// usw2-az1 for the first zone of us-west-2.
+func ec2ZoneID(region string, i int) string {
+	var abbreviation string
+
+	for j, part := range strings.Split(region, "-") {
+		if j == 0 {
+			abbreviation += part
+		} else {
+			abbreviation += part[:1]
+		}
+	}
+
+	return fmt.Sprintf("%s-az%d", abbreviation, i+1)
+}
+
+// ec2Tags returns the tags of a taggable resource.
+func (s *Server) ec2Tags(id string) (*[]*ec2.Tag, error) {
+	switch {
+	case s.ec2.vpcs[id] != nil:
+		return &s.ec2.vpcs[id].Tags, nil
+	case s.ec2.subnets[id] != nil:
+		return &s.ec2.subnets[id].Tags, nil
+	case s.ec2.securityGroups[id] != nil:
+		return &s.ec2.securityGroups[id].group.Tags, nil
+	case s.ec2.routeTables[id] != nil:
+		return &s.ec2.routeTables[id].Tags, nil
+	case s.ec2.networkAcls[id] != nil:
+		return &s.ec2.networkAcls[id].Tags, nil
+	}
+
+	prefix := id
+	if i := strings.Index(id, "-"); i > 0 {
+		prefix = id[:i]
+	}
+
+	return nil, newError(fmt.Sprintf("Invalid%s.NotFound", ec2ResourceCodeName(prefix)), "The ID '%s' does not exist", id)
+}
+
+// ec2ResourceCodeName returns the name of a resource type in error codes.
+func ec2ResourceCodeName(prefix string) string {
+	switch prefix {
+	case "vpc":
+		return "VpcID"
+	case "subnet":
+		return "SubnetID"
+	case "sg":
+		return "GroupId"
+	case "rtb":
+		return "RouteTableID"
+	case "acl":
+		return "NetworkAclID"
+	default:
+		return "ID"
+	}
+}
+
+func (s *Server) ec2CreateTags(in *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
+	for _, id := range in.Resources {
+		tags, err := s.ec2Tags(aws.StringValue(id))
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range in.Tags {
+			*tags = ec2RemoveTag(*tags, aws.StringValue(tag.Key), nil)
+			*tags = append(*tags, &ec2.Tag{Key: tag.Key, Value: aws.String(aws.StringValue(tag.Value))})
+		}
+	}
+
+	return &ec2.CreateTagsOutput{}, nil
+}
+
+func (s *Server) ec2DeleteTags(in *ec2.DeleteTagsInput) (*ec2.DeleteTagsOutput, error) {
+	for _, id := range in.Resources {
+		tags, err := s.ec2Tags(aws.StringValue(id))
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range in.Tags {
+			*tags = ec2RemoveTag(*tags, aws.StringValue(tag.Key), tag.Value)
+		}
+	}
+
+	return &ec2.DeleteTagsOutput{}, nil
+}
+
+// ec2RemoveTag removes a tag by key and, when not nil, value.
+func ec2RemoveTag(tags []*ec2.Tag, key string, value *string) []*ec2.Tag {
+	var result []*ec2.Tag
+
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == key && (value == nil || aws.StringValue(tag.Value) == aws.StringValue(value)) {
+			continue
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+func (s *Server) ec2CreateVpc(in *ec2.CreateVpcInput) (*ec2.CreateVpcOutput, error) {
+	if aws.StringValue(in.CidrBlock) == "" {
+		return nil, newError("MissingParameter", "The request must contain the parameter cidrBlock")
+	}
+
+	tenancy := aws.StringValue(in.InstanceTenancy)
+	if tenancy == "" {
+		tenancy = ec2.TenancyDefault
+	}
+
+	vpc := &ec2.Vpc{
+		CidrBlock: in.CidrBlock,
+		CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+			{
+				AssociationId:  aws.String(s.newID("vpc-cidr-assoc")),
+				CidrBlock:      in.CidrBlock,
+				CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String(ec2.VpcCidrBlockStateCodeAssociated)},
+			},
+		},
+		DhcpOptionsId:   aws.String("dopt-00000000"),
+		InstanceTenancy: aws.String(tenancy),
+		IsDefault:       aws.Bool(false),
+		OwnerId:         aws.String(s.AccountID),
+		State:           aws.String(ec2.VpcStateAvailable),
+		VpcId:           aws.String(s.newID("vpc")),
+	}
+
+	id := aws.StringValue(vpc.VpcId)
+	s.ec2.vpcs[id] = vpc
+	s.ec2.vpcAttributes[id] = &ec2VpcAttributes{enableDnsSupport: true}
+
+	if aws.BoolValue(in.AmazonProvidedIpv6CidrBlock) {
+		s.ec2AssociateVpcIpv6CidrBlock(vpc)
+	}
+
+	mainRouteTable := &ec2.RouteTable{
+		Associations: []*ec2.RouteTableAssociation{
+			{
+				Main:                    aws.Bool(true),
+				RouteTableAssociationId: aws.String(s.newID("rtbassoc")),
+			},
+		},
+		OwnerId: aws.String(s.AccountID),
+		Routes: []*ec2.Route{
+			{
+				DestinationCidrBlock: vpc.CidrBlock,
+				GatewayId:            aws.String("local"),
+				Origin:               aws.String(ec2.RouteOriginCreateRouteTable),
+				State:                aws.String(ec2.RouteStateActive),
+			},
+		},
+		RouteTableId: aws.String(s.newID("rtb")),
+		VpcId:        vpc.VpcId,
+	}
+	mainRouteTable.Associations[0].RouteTableId = mainRouteTable.RouteTableId
+	s.ec2.routeTables[aws.StringValue(mainRouteTable.RouteTableId)] = mainRouteTable
+
+	defaultNetworkAcl := &ec2.NetworkAcl{
+		IsDefault:    aws.Bool(true),
+		NetworkAclId: aws.String(s.newID("acl")),
+		OwnerId:      aws.String(s.AccountID),
+		VpcId:        vpc.VpcId,
+	}
+
+	for _, egress := range []bool{false, true} {
+		defaultNetworkAcl.Entries = append(defaultNetworkAcl.Entries,
+			&ec2.NetworkAclEntry{
+				CidrBlock:  aws.String("0.0.0.0/0"),
+				Egress:     aws.Bool(egress),
+				Protocol:   aws.String("-1"),
+				RuleAction: aws.String(ec2.RuleActionAllow),
+				RuleNumber: aws.Int64(100),
+			},
+			&ec2.NetworkAclEntry{
+				CidrBlock:  aws.String("0.0.0.0/0"),
+				Egress:     aws.Bool(egress),
+				Protocol:   aws.String("-1"),
+				RuleAction: aws.String(ec2.RuleActionDeny),
+				RuleNumber: aws.Int64(32767),
+			},
+		)
+	}
+	s.ec2.networkAcls[aws.StringValue(defaultNetworkAcl.NetworkAclId)] = defaultNetworkAcl
+
+	s.ec2NewSecurityGroup("default", "default VPC security group", vpc.VpcId)
+
+	return &ec2.CreateVpcOutput{Vpc: vpc}, nil
+}
+
+func (s *Server) ec2AssociateVpcIpv6CidrBlock(vpc *ec2.Vpc) *ec2.VpcIpv6CidrBlockAssociation {
+	association := &ec2.VpcIpv6CidrBlockAssociation{
+		AssociationId:      aws.String(s.newID("vpc-cidr-assoc")),
+		Ipv6CidrBlock:      aws.String(fmt.Sprintf("2600:1f14:%x:%x00::/56", len(s.ec2.vpcs), len(vpc.Ipv6CidrBlockAssociationSet))),
+		Ipv6CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String(ec2.VpcCidrBlockStateCodeAssociated)},
+	}
+
+	vpc.Ipv6CidrBlockAssociationSet = append(vpc.Ipv6CidrBlockAssociationSet, association)
+
+	return association
+}
+
+// ec2Vpc returns a VPC by ID.
+func (s *Server) ec2Vpc(id *string) (*ec2.Vpc, error) {
+	vpc, ok := s.ec2.vpcs[aws.StringValue(id)]
+
+	if !ok {
+		return nil, newError("InvalidVpcID.NotFound", "The vpc ID '%s' does not exist", aws.StringValue(id))
+	}
+
+	return vpc, nil
+}
+
+func (s *Server) ec2DescribeVpcs(in *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
+	if err := ec2CheckIDs(in.VpcIds, func(id string) bool { return s.ec2.vpcs[id] != nil }, "InvalidVpcID.NotFound", "vpc ID"); err != nil {
+		return nil, err
+	}
+
+	out := &ec2.DescribeVpcsOutput{}
+
+	for _, id := range sortedKeys(s.ec2.vpcs) {
+		vpc := s.ec2.vpcs[id]
+
+		if !ec2IDsFilter(in.VpcIds, id) {
+			continue
+		}
+
+		attributes := map[string][]string{
+			"cidr":            {aws.StringValue(vpc.CidrBlock)},
+			"cidr-block":      {aws.StringValue(vpc.CidrBlock)},
+			"dhcp-options-id": {aws.StringValue(vpc.DhcpOptionsId)},
+			"isDefault":       {strconv.FormatBool(aws.BoolValue(vpc.IsDefault))},
+			"is-default":      {strconv.FormatBool(aws.BoolValue(vpc.IsDefault))},
+			"owner-id":        {aws.StringValue(vpc.OwnerId)},
+			"state":           {aws.StringValue(vpc.State)},
+			"vpc-id":          {id},
+		}
+
+		for _, association := range vpc.CidrBlockAssociationSet {
+			attributes["cidr-block-association.cidr-block"] = append(attributes["cidr-block-association.cidr-block"], aws.StringValue(association.CidrBlock))
+			attributes["cidr-block-association.association-id"] = append(attributes["cidr-block-association.association-id"], aws.StringValue(association.AssociationId))
+		}
+
+		for _, association := range vpc.Ipv6CidrBlockAssociationSet {
+			attributes["ipv6-cidr-block-association.ipv6-cidr-block"] = append(attributes["ipv6-cidr-block-association.ipv6-cidr-block"], aws.StringValue(association.Ipv6CidrBlock))
+			attributes["ipv6-cidr-block-association.association-id"] = append(attributes["ipv6-cidr-block-association.association-id"], aws.StringValue(association.AssociationId))
+		}
+
+		ok, err := ec2Filter(in.Filters, attributes, vpc.Tags)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			out.Vpcs = append(out.Vpcs, vpc)
+		}
+	}
+
+	return out, nil
+}
+
+func (s *Server) ec2DeleteVpc(in *ec2.DeleteVpcInput) (*ec2.DeleteVpcOutput, error) {
+	vpc, err := s.ec2Vpc(in.VpcId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	id := aws.StringValue(vpc.VpcId)
+
+	for _, subnet := range s.ec2.subnets {
+		if aws.StringValue(subnet.VpcId) == id {
+			return nil, newError("DependencyViolation", "The vpc '%s' has dependencies and cannot be deleted.", id)
+		}
+	}
+
+	for _, group := range s.ec2.securityGroups {
+		if aws.StringValue(group.group.VpcId) == id && aws.StringValue(group.group.GroupName) != "default" {
+			return nil, newError("DependencyViolation", "The vpc '%s' has dependencies and cannot be deleted.", id)
+		}
+	}
+
+	for groupID, group := range s.ec2.securityGroups {
+		if aws.StringValue(group.group.VpcId) == id {
+			delete(s.ec2.securityGroups, groupID)
+		}
+	}
+
+	for routeTableID, routeTable := range s.ec2.routeTables {
+		if aws.StringValue(routeTable.VpcId) == id {
+			delete(s.ec2.routeTables, routeTableID)
+		}
+	}
+
+	for networkAclID, networkAcl := range s.ec2.networkAcls {
+		if aws.StringValue(networkAcl.VpcId) == id {
+			delete(s.ec2.networkAcls, networkAclID)
+		}
+	}
+
+	delete(s.ec2.vpcs, id)
+	delete(s.ec2.vpcAttributes, id)
+
+	return &ec2.DeleteVpcOutput{}, nil
+}
+
+func (s *Server) ec2DescribeVpcAttribute(in *ec2.DescribeVpcAttributeInput) (*ec2.DescribeVpcAttributeOutput, error) {
+	vpc, err := s.ec2Vpc(in.VpcId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := s.ec2.vpcAttributes[aws.StringValue(vpc.VpcId)]
+	out := &ec2.DescribeVpcAttributeOutput{VpcId: vpc.VpcId}
+
+	switch aws.StringValue(in.Attribute) {
+	case ec2.VpcAttributeNameEnableDnsHostnames:
+		out.EnableDnsHostnames = &ec2.AttributeBooleanValue{Value: aws.Bool(attributes.enableDnsHostnames)}
+	case ec2.VpcAttributeNameEnableDnsSupport:
+		out.EnableDnsSupport = &ec2.AttributeBooleanValue{Value: aws.Bool(attributes.enableDnsSupport)}
+	default:
+		return nil, newError("InvalidParameterValue", "Value (%s) for parameter attribute is invalid", aws.StringValue(in.Attribute))
+	}
+
+	return out, nil
+}
+
+func (s *Server) ec2ModifyVpcAttribute(in *ec2.ModifyVpcAttributeInput) (*ec2.ModifyVpcAttributeOutput, error) {
+	vpc, err := s.ec2Vpc(in.VpcId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := s.ec2.vpcAttributes[aws.StringValue(vpc.VpcId)]
+
+	if in.EnableDnsHostnames != nil {
+		attributes.enableDnsHostnames = aws.BoolValue(in.EnableDnsHostnames.Value)
+	}
+
+	if in.EnableDnsSupport != nil {
+		attributes.enableDnsSupport = aws.BoolValue(in.EnableDnsSupport.Value)
+	}
+
+	return &ec2.ModifyVpcAttributeOutput{}, nil
+}
+
+func (s *Server) ec2ModifyVpcTenancy(in *ec2.ModifyVpcTenancyInput) (*ec2.ModifyVpcTenancyOutput, error) {
+	vpc, err := s.ec2Vpc(in.VpcId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(in.InstanceTenancy) != ec2.VpcTenancyDefault {
+		return nil, newError("InvalidParameterValue", "The tenancy value %s is not supported.", aws.StringValue(in.InstanceTenancy))
+	}
+
+	vpc.InstanceTenancy = in.InstanceTenancy
+
+	return &ec2.ModifyVpcTenancyOutput{ReturnValue: aws.Bool(true)}, nil
+}
+
+// The fake has no EC2-Classic instances, ClassicLink is only a VPC attribute.
+
+func (s *Server) ec2DescribeVpcClassicLink(in *ec2.DescribeVpcClassicLinkInput) (*ec2.DescribeVpcClassicLinkOutput, error) {
+	if err := ec2CheckIDs(in.VpcIds, func(id string) bool { return s.ec2.vpcs[id] != nil }, "InvalidVpcID.NotFound", "vpc ID"); err != nil {
+		return nil, err
+	}
+
+	out := &ec2.DescribeVpcClassicLinkOutput{}
+
+	for _, id := range sortedKeys(s.ec2.vpcs) {
+		if !ec2IDsFilter(in.VpcIds, id) {
+			continue
+		}
+
+		out.Vpcs = append(out.Vpcs, &ec2.VpcClassicLink{
+			ClassicLinkEnabled: aws.Bool(s.ec2.vpcAttributes[id].classicLinkEnabled),
+			Tags:               s.ec2.vpcs[id].Tags,
+			VpcId:              aws.String(id),
+		})
+	}
+
+	return out, nil
+}
+
+func (s *Server) ec2EnableVpcClassicLink(in *ec2.EnableVpcClassicLinkInput) (*ec2.EnableVpcClassicLinkOutput, error) {
+	vpc, err := s.ec2Vpc(in.VpcId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.ec2.vpcAttributes[aws.StringValue(vpc.VpcId)].classicLinkEnabled = true
+
+	return &ec2.EnableVpcClassicLinkOutput{Return: aws.Bool(true)}, nil
+}
+
+func (s *Server) ec2DisableVpcClassicLink(in *ec2.DisableVpcClassicLinkInput) (*ec2.DisableVpcClassicLinkOutput, error) {
+	vpc, err := s.ec2Vpc(in.VpcId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.ec2.vpcAttributes[aws.StringValue(vpc.VpcId)].classicLinkEnabled = false
+
+	return &ec2.DisableVpcClassicLinkOutput{Return: aws.Bool(true)}, nil
+}
+
+func (s *Server) ec2DescribeVpcClassicLinkDnsSupport(in *ec2.DescribeVpcClassicLinkDnsSupportInput) (*ec2.DescribeVpcClassicLinkDnsSupportOutput, error) {
+	if err := ec2CheckIDs(in.VpcIds, func(id string) bool { return s.ec2.vpcs[id] != nil }, "InvalidVpcID.NotFound", "vpc ID"); err != nil {
+		return nil, err
+	}
+
+	out := &ec2.DescribeVpcClassicLinkDnsSupportOutput{}
+
+	for _, id := range sortedKeys(s.ec2.vpcs) {
+		if !ec2IDsFilter(in.VpcIds, id) {
+			continue
+		}
+
+		out.Vpcs = append(out.Vpcs, &ec2.ClassicLinkDnsSupport{
+			ClassicLinkDnsSupported: aws.Bool(s.ec2.vpcAttributes[id].classicLinkDnsSupported),
+			VpcId:                   aws.String(id),
+		})
+	}
+
+	return out, nil
+}
+
+func (s *Server) ec2EnableVpcClassicLinkDnsSupport(in *ec2.EnableVpcClassicLinkDnsSupportInput) (*ec2.EnableVpcClassicLinkDnsSupportOutput, error) {
+	vpc, err := s.ec2Vpc(in.VpcId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.ec2.vpcAttributes[aws.StringValue(vpc.VpcId)].classicLinkDnsSupported = true
+
+	return &ec2.EnableVpcClassicLinkDnsSupportOutput{Return: aws.Bool(true)}, nil
+}
+
+func (s *Server) ec2DisableVpcClassicLinkDnsSupport(in *ec2.DisableVpcClassicLinkDnsSupportInput) (*ec2.DisableVpcClassicLinkDnsSupportOutput, error) {
+	vpc, err := s.ec2Vpc(in.VpcId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.ec2.vpcAttributes[aws.StringValue(vpc.VpcId)].classicLinkDnsSupported = false
+
+	return &ec2.DisableVpcClassicLinkDnsSupportOutput{Return: aws.Bool(true)}, nil
+}
+
+func (s *Server) ec2AssociateVpcCidrBlock(in *ec2.AssociateVpcCidrBlockInput) (*ec2.AssociateVpcCidrBlockOutput, error) {
+	vpc, err := s.ec2Vpc(in.VpcId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := &ec2.AssociateVpcCidrBlockOutput{VpcId: vpc.VpcId}
+
+	if aws.BoolValue(in.AmazonProvidedIpv6CidrBlock) {
+		for _, association := range vpc.Ipv6CidrBlockAssociationSet {
+			if aws.StringValue(association.Ipv6CidrBlockState.State) == ec2.VpcCidrBlockStateCodeAssociated {
+				return nil, newError("CidrLimitExceeded", "This network '%s' has met its maximum number of allowed CIDRs: 1", aws.StringValue(vpc.VpcId))
+			}
+		}
+
+		out.Ipv6CidrBlockAssociation = s.ec2AssociateVpcIpv6CidrBlock(vpc)
+
+		return out, nil
+	}
+
+	if aws.StringValue(in.CidrBlock) == "" {
+		return nil, newError("MissingParameter", "Either 'cidrBlock' or 'amazonProvidedIpv6CidrBlock' must be specified")
+	}
+
+	association := &ec2.VpcCidrBlockAssociation{
+		AssociationId:  aws.String(s.newID("vpc-cidr-assoc")),
+		CidrBlock:      in.CidrBlock,
+		CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String(ec2.VpcCidrBlockStateCodeAssociated)},
+	}
+	vpc.CidrBlockAssociationSet = append(vpc.CidrBlockAssociationSet, association)
+	out.CidrBlockAssociation = association
+
+	return out, nil
+}
+
+func (s *Server) ec2DisassociateVpcCidrBlock(in *ec2.DisassociateVpcCidrBlockInput) (*ec2.DisassociateVpcCidrBlockOutput, error) {
+	id := aws.StringValue(in.AssociationId)
+
+	for _, vpc := range s.ec2.vpcs {
+		// As in AWS, disassociated IPv6 CIDR blocks remain listed for a while.
+		for _, association := range vpc.Ipv6CidrBlockAssociationSet {
+			if aws.StringValue(association.AssociationId) == id && aws.StringValue(association.Ipv6CidrBlockState.State) == ec2.VpcCidrBlockStateCodeAssociated {
+				association.Ipv6CidrBlockState = &ec2.VpcCidrBlockState{State: aws.String(ec2.VpcCidrBlockStateCodeDisassociated)}
+
+				return &ec2.DisassociateVpcCidrBlockOutput{
+					Ipv6CidrBlockAssociation: &ec2.VpcIpv6CidrBlockAssociation{
+						AssociationId:      association.AssociationId,
+						Ipv6CidrBlock:      association.Ipv6CidrBlock,
+						Ipv6CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String(ec2.VpcCidrBlockStateCodeDisassociated)},
+					},
+					VpcId: vpc.VpcId,
+				}, nil
+			}
+		}
+
+		for i, association := range vpc.CidrBlockAssociationSet {
+			if aws.StringValue(association.AssociationId) == id {
+				if i == 0 {
+					return nil, newError("OperationNotPermitted", "The vpc CIDR block with association ID %s may not be disassociated. It is the primary IPv4 CIDR block of the VPC", id)
+				}
+
+				vpc.CidrBlockAssociationSet = append(vpc.CidrBlockAssociationSet[:i], vpc.CidrBlockAssociationSet[i+1:]...)
+
+				return &ec2.DisassociateVpcCidrBlockOutput{
+					CidrBlockAssociation: &ec2.VpcCidrBlockAssociation{
+						AssociationId:  association.AssociationId,
+						CidrBlock:      association.CidrBlock,
+						CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String(ec2.VpcCidrBlockStateCodeDisassociated)},
+					},
+					VpcId: vpc.VpcId,
+				}, nil
+			}
+		}
+	}
+
+	return nil, newError("InvalidVpcCidrBlockAssociationID.NotFound", "The vpc CIDR block association ID '%s' does not exist", id)
+}
+
+func (s *Server) ec2DescribeRouteTables(in *ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error) {
+	if err := ec2CheckIDs(in.RouteTableIds, func(id string) bool { return s.ec2.routeTables[id] != nil }, "InvalidRouteTableID.NotFound", "routeTable ID"); err != nil {
+		return nil, err
+	}
+
+	out := &ec2.DescribeRouteTablesOutput{}
+
+	for _, id := range sortedKeys(s.ec2.routeTables) {
+		routeTable := s.ec2.routeTables[id]
+
+		if !ec2IDsFilter(in.RouteTableIds, id) {
+			continue
+		}
+
+		attributes := map[string][]string{
+			"owner-id":       {aws.StringValue(routeTable.OwnerId)},
+			"route-table-id": {id},
+			"vpc-id":         {aws.StringValue(routeTable.VpcId)},
+		}
+
+		for _, association := range routeTable.Associations {
+			attributes["association.main"] = append(attributes["association.main"], strconv.FormatBool(aws.BoolValue(association.Main)))
+			attributes["association.route-table-association-id"] = append(attributes["association.route-table-association-id"], aws.StringValue(association.RouteTableAssociationId))
+
+			if association.SubnetId != nil {
+				attributes["association.subnet-id"] = append(attributes["association.subnet-id"], aws.StringValue(association.SubnetId))
+			}
+		}
+
+		ok, err := ec2Filter(in.Filters, attributes, routeTable.Tags)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			out.RouteTables = append(out.RouteTables, routeTable)
+		}
+	}
+
+	return out, nil
+}
+
+func (s *Server) ec2DescribeNetworkAcls(in *ec2.DescribeNetworkAclsInput) (*ec2.DescribeNetworkAclsOutput, error) {
+	if err := ec2CheckIDs(in.NetworkAclIds, func(id string) bool { return s.ec2.networkAcls[id] != nil }, "InvalidNetworkAclID.NotFound", "networkAcl ID"); err != nil {
+		return nil, err
+	}
+
+	out := &ec2.DescribeNetworkAclsOutput{}
+
+	for _, id := range sortedKeys(s.ec2.networkAcls) {
+		networkAcl := s.ec2.networkAcls[id]
+
+		if !ec2IDsFilter(in.NetworkAclIds, id) {
+			continue
+		}
+
+		attributes := map[string][]string{
+			"default":        {strconv.FormatBool(aws.BoolValue(networkAcl.IsDefault))},
+			"network-acl-id": {id},
+			"owner-id":       {aws.StringValue(networkAcl.OwnerId)},
+			"vpc-id":         {aws.StringValue(networkAcl.VpcId)},
+		}
+
+		for _, association := range networkAcl.Associations {
+			attributes["association.subnet-id"] = append(attributes["association.subnet-id"], aws.StringValue(association.SubnetId))
+		}
+
+		ok, err := ec2Filter(in.Filters, attributes, networkAcl.Tags)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			out.NetworkAcls = append(out.NetworkAcls, networkAcl)
+		}
+	}
+
+	return out, nil
+}
+
+// Network interfaces are not modeled, resources check them for dependencies
+// when they are deleted.
+
+func (s *Server) ec2DescribeNetworkInterfaces(in *ec2.DescribeNetworkInterfacesInput) (*ec2.DescribeNetworkInterfacesOutput, error) {
+	if len(in.NetworkInterfaceIds) > 0 {
+		return nil, newError("InvalidNetworkInterfaceID.NotFound", "The networkInterface ID '%s' does not exist", aws.StringValue(in.NetworkInterfaceIds[0]))
+	}
+
+	return &ec2.DescribeNetworkInterfacesOutput{}, nil
+}
+
+func (s *Server) ec2CreateSubnet(in *ec2.CreateSubnetInput) (*ec2.CreateSubnetOutput, error) {
+	vpc, err := s.ec2Vpc(in.VpcId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	availabilityZone := aws.StringValue(in.AvailabilityZone)
+	if availabilityZone == "" {
+		availabilityZone = s.region + "a"
+	}
+
+	subnet := &ec2.Subnet{
+		AssignIpv6AddressOnCreation: aws.Bool(false),
+		AvailabilityZone:            aws.String(availabilityZone),
+		AvailabilityZoneId:          aws.String(ec2ZoneID(s.region, int(availabilityZone[len(availabilityZone)-1]-'a'))),
+		AvailableIpAddressCount:     aws.Int64(251),
+		CidrBlock:                   in.CidrBlock,
+		DefaultForAz:                aws.Bool(false),
+		MapPublicIpOnLaunch:         aws.Bool(false),
+		OwnerId:                     aws.String(s.AccountID),
+		State:                       aws.String(ec2.SubnetStateAvailable),
+		SubnetId:                    aws.String(s.newID("subnet")),
+		VpcId:                       vpc.VpcId,
+	}
+	subnet.SubnetArn = aws.String(s.arn("ec2", s.region, "subnet/"+aws.StringValue(subnet.SubnetId)))
+
+	if in.Ipv6CidrBlock != nil {
+		subnet.Ipv6CidrBlockAssociationSet = []*ec2.SubnetIpv6CidrBlockAssociation{
+			{
+				AssociationId:      aws.String(s.newID("subnet-cidr-assoc")),
+				Ipv6CidrBlock:      in.Ipv6CidrBlock,
+				Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated)},
+			},
+		}
+	}
+
+	s.ec2.subnets[aws.StringValue(subnet.SubnetId)] = subnet
+
+	return &ec2.CreateSubnetOutput{Subnet: subnet}, nil
+}
+
+func (s *Server) ec2DescribeSubnets(in *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+	if err := ec2CheckIDs(in.SubnetIds, func(id string) bool { return s.ec2.subnets[id] != nil }, "InvalidSubnetID.NotFound", "subnet ID"); err != nil {
+		return nil, err
+	}
+
+	out := &ec2.DescribeSubnetsOutput{}
+
+	for _, id := range sortedKeys(s.ec2.subnets) {
+		subnet := s.ec2.subnets[id]
+
+		if !ec2IDsFilter(in.SubnetIds, id) {
+			continue
+		}
+
+		attributes := map[string][]string{
+			"availability-zone":    {aws.StringValue(subnet.AvailabilityZone)},
+			"availability-zone-id": {aws.StringValue(subnet.AvailabilityZoneId)},
+			"cidr":                 {aws.StringValue(subnet.CidrBlock)},
+			"cidr-block":           {aws.StringValue(subnet.CidrBlock)},
+			"cidrBlock":            {aws.StringValue(subnet.CidrBlock)},
+			"default-for-az":       {strconv.FormatBool(aws.BoolValue(subnet.DefaultForAz))},
+			"defaultForAz":         {strconv.FormatBool(aws.BoolValue(subnet.DefaultForAz))},
+			"owner-id":             {aws.StringValue(subnet.OwnerId)},
+			"state":                {aws.StringValue(subnet.State)},
+			"subnet-arn":           {aws.StringValue(subnet.SubnetArn)},
+			"subnet-id":            {id},
+			"vpc-id":               {aws.StringValue(subnet.VpcId)},
+		}
+
+		ok, err := ec2Filter(in.Filters, attributes, subnet.Tags)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			out.Subnets = append(out.Subnets, subnet)
+		}
+	}
+
+	return out, nil
+}
+
+func (s *Server) ec2ModifySubnetAttribute(in *ec2.ModifySubnetAttributeInput) (*ec2.ModifySubnetAttributeOutput, error) {
+	subnet, ok := s.ec2.subnets[aws.StringValue(in.SubnetId)]
+
+	if !ok {
+		return nil, newError("InvalidSubnetID.NotFound", "The subnet ID '%s' does not exist", aws.StringValue(in.SubnetId))
+	}
+
+	if in.AssignIpv6AddressOnCreation != nil {
+		subnet.AssignIpv6AddressOnCreation = in.AssignIpv6AddressOnCreation.Value
+	}
+
+	if in.MapPublicIpOnLaunch != nil {
+		subnet.MapPublicIpOnLaunch = in.MapPublicIpOnLaunch.Value
+	}
+
+	return &ec2.ModifySubnetAttributeOutput{}, nil
+}
+
+func (s *Server) ec2DeleteSubnet(in *ec2.DeleteSubnetInput) (*ec2.DeleteSubnetOutput, error) {
+	id := aws.StringValue(in.SubnetId)
+
+	if _, ok := s.ec2.subnets[id]; !ok {
+		return nil, newError("InvalidSubnetID.NotFound", "The subnet ID '%s' does not exist", id)
+	}
+
+	delete(s.ec2.subnets, id)
+
+	return &ec2.DeleteSubnetOutput{}, nil
+}
+
+// ec2NewSecurityGroup adds a security group allowing all egress traffic.
+func (s *Server) ec2NewSecurityGroup(name, description string, vpcID *string) *ec2SecurityGroup {
+	group := &ec2SecurityGroup{
+		group: &ec2.SecurityGroup{
+			Description: aws.String(description),
+			GroupId:     aws.String(s.newID("sg")),
+			GroupName:   aws.String(name),
+			OwnerId:     aws.String(s.AccountID),
+			VpcId:       vpcID,
+		},
+		egress: []*ec2SecurityGroupRule{
+			{
+				protocol: "-1",
+				ipRange:  &ec2.IpRange{CidrIp: aws.String("0.0.0.0/0")},
+			},
+		},
+	}
+
+	group.update()
+	s.ec2.securityGroups[aws.StringValue(group.group.GroupId)] = group
+
+	return group
+}
+
+// update sets the permissions of the security group from its rules.
+func (g *ec2SecurityGroup) update() {
+	g.group.IpPermissions = ec2IpPermissions(g.ingress)
+	g.group.IpPermissionsEgress = ec2IpPermissions(g.egress)
+}
+
+// ec2IpPermissions groups rules by protocol and port range.
+func ec2IpPermissions(rules []*ec2SecurityGroupRule) []*ec2.IpPermission {
+	var permissions []*ec2.IpPermission
+
+	index := make(map[string]*ec2.IpPermission)
+
+	for _, rule := range rules {
+		key := fmt.Sprintf("%s/%d/%d", rule.protocol, aws.Int64Value(rule.fromPort), aws.Int64Value(rule.toPort))
+		permission, ok := index[key]
+
+		if !ok {
+			permission = &ec2.IpPermission{
+				FromPort:   rule.fromPort,
+				IpProtocol: aws.String(rule.protocol),
+				ToPort:     rule.toPort,
+			}
+			index[key] = permission
+			permissions = append(permissions, permission)
+		}
+
+		switch {
+		case rule.ipRange != nil:
+			permission.IpRanges = append(permission.IpRanges, rule.ipRange)
+		case rule.ipv6Range != nil:
+			permission.Ipv6Ranges = append(permission.Ipv6Ranges, rule.ipv6Range)
+		case rule.prefixListID != nil:
+			permission.PrefixListIds = append(permission.PrefixListIds, rule.prefixListID)
+		case rule.group != nil:
+			permission.UserIdGroupPairs = append(permission.UserIdGroupPairs, rule.group)
+		}
+	}
+
+	return permissions
+}
+
+// ec2SecurityGroupRules splits permissions into rules with a single source.
+func (s *Server) ec2SecurityGroupRules(permissions []*ec2.IpPermission) ([]*ec2SecurityGroupRule, error) {
+	var rules []*ec2SecurityGroupRule
+
+	for _, permission := range permissions {
+		protocol := strings.ToLower(aws.StringValue(permission.IpProtocol))
+
+		switch protocol {
+		case "tcp":
+			protocol = "tcp"
+		case "6":
+			protocol = "tcp"
+		case "17":
+			protocol = "udp"
+		case "1":
+			protocol = "icmp"
+		case "all":
+			protocol = "-1"
+		}
+
+		newRule := func() *ec2SecurityGroupRule {
+			rule := &ec2SecurityGroupRule{protocol: protocol}
+
+			if protocol != "-1" {
+				rule.fromPort = permission.FromPort
+				rule.toPort = permission.ToPort
+			}
+
+			return rule
+		}
+
+		for _, ipRange := range permission.IpRanges {
+			rule := newRule()
+			rule.ipRange = ipRange
+			rules = append(rules, rule)
+		}
+
+		for _, ipv6Range := range permission.Ipv6Ranges {
+			rule := newRule()
+			rule.ipv6Range = ipv6Range
+			rules = append(rules, rule)
+		}
+
+		for _, prefixListID := range permission.PrefixListIds {
+			rule := newRule()
+			rule.prefixListID = prefixListID
+			rules = append(rules, rule)
+		}
+
+		for _, pair := range permission.UserIdGroupPairs {
+			if pair.GroupId == nil {
+				for _, group := range s.ec2.securityGroups {
+					if aws.StringValue(group.group.GroupName) == aws.StringValue(pair.GroupName) {
+						pair.GroupId = group.group.GroupId
+					}
+				}
+			}
+
+			if _, ok := s.ec2.securityGroups[aws.StringValue(pair.GroupId)]; !ok {
+				return nil, newError("InvalidGroup.NotFound", "The security group '%s' does not exist", aws.StringValue(pair.GroupId))
+			}
+
+			if pair.UserId == nil {
+				pair.UserId = aws.String(s.AccountID)
+			}
+
+			pair.GroupName = nil
+
+			rule := newRule()
+			rule.group = pair
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules, nil
+}
+
+// ec2SecurityGroup returns a security group by ID or, for EC2-Classic
+// compatibility, name.
+func (s *Server) ec2SecurityGroup(id, name *string) (*ec2SecurityGroup, error) {
+	if id != nil {
+		group, ok := s.ec2.securityGroups[aws.StringValue(id)]
+
+		if !ok {
+			return nil, newError("InvalidGroup.NotFound", "The security group '%s' does not exist", aws.StringValue(id))
+		}
+
+		return group, nil
+	}
+
+	for _, group := range s.ec2.securityGroups {
+		if aws.StringValue(group.group.GroupName) == aws.StringValue(name) {
+			return group, nil
+		}
+	}
+
+	return nil, newError("InvalidGroup.NotFound", "The security group '%s' does not exist", aws.StringValue(name))
+}
+
+func (s *Server) ec2CreateSecurityGroup(in *ec2.CreateSecurityGroupInput) (*ec2.CreateSecurityGroupOutput, error) {
+	if in.VpcId != nil {
+		if _, err := s.ec2Vpc(in.VpcId); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, group := range s.ec2.securityGroups {
+		if aws.StringValue(group.group.GroupName) == aws.StringValue(in.GroupName) && aws.StringValue(group.group.VpcId) == aws.StringValue(in.VpcId) {
+			return nil, newError("InvalidGroup.Duplicate", "The security group '%s' already exists for VPC '%s'", aws.StringValue(in.GroupName), aws.StringValue(in.VpcId))
+		}
+	}
+
+	group := s.ec2NewSecurityGroup(aws.StringValue(in.GroupName), aws.StringValue(in.Description), in.VpcId)
+
+	return &ec2.CreateSecurityGroupOutput{GroupId: group.group.GroupId}, nil
+}
+
+func (s *Server) ec2DescribeSecurityGroups(in *ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error) {
+	if err := ec2CheckIDs(in.GroupIds, func(id string) bool { return s.ec2.securityGroups[id] != nil }, "InvalidGroup.NotFound", "security group"); err != nil {
+		return nil, err
+	}
+
+	out := &ec2.DescribeSecurityGroupsOutput{}
+
+	for _, id := range sortedKeys(s.ec2.securityGroups) {
+		group := s.ec2.securityGroups[id].group
+
+		if !ec2IDsFilter(in.GroupIds, id) || !ec2IDsFilter(in.GroupNames, aws.StringValue(group.GroupName)) {
+			continue
+		}
+
+		attributes := map[string][]string{
+			"description": {aws.StringValue(group.Description)},
+			"group-id":    {id},
+			"group-name":  {aws.StringValue(group.GroupName)},
+			"owner-id":    {aws.StringValue(group.OwnerId)},
+			"vpc-id":      {aws.StringValue(group.VpcId)},
+		}
+
+		ok, err := ec2Filter(in.Filters, attributes, group.Tags)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			out.SecurityGroups = append(out.SecurityGroups, group)
+		}
+	}
+
+	return out, nil
+}
+
+func (s *Server) ec2DeleteSecurityGroup(in *ec2.DeleteSecurityGroupInput) (*ec2.DeleteSecurityGroupOutput, error) {
+	group, err := s.ec2SecurityGroup(in.GroupId, in.GroupName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	id := aws.StringValue(group.group.GroupId)
+
+	if aws.StringValue(group.group.GroupName) == "default" {
+		return nil, newError("CannotDelete", "the specified group: \"%s\" name: \"default\" cannot be deleted by a user", id)
+	}
+
+	for otherID, other := range s.ec2.securityGroups {
+		if otherID == id {
+			continue
+		}
+
+		for _, rule := range append(other.ingress, other.egress...) {
+			if rule.group != nil && aws.StringValue(rule.group.GroupId) == id {
+				return nil, newError("DependencyViolation", "resource %s has a dependent object", id)
+			}
+		}
+	}
+
+	delete(s.ec2.securityGroups, id)
+
+	return &ec2.DeleteSecurityGroupOutput{}, nil
+}
+
+// ec2AuthorizeRules adds rules to a set of rules.
+func (s *Server) ec2AuthorizeRules(rules *[]*ec2SecurityGroupRule, permissions []*ec2.IpPermission) error {
+	newRules, err := s.ec2SecurityGroupRules(permissions)
+
+	if err != nil {
+		return err
+	}
+
+	for _, newRule := range newRules {
+		for _, rule := range *rules {
+			if rule.key() == newRule.key() {
+				return newError("InvalidPermission.Duplicate", "the specified rule \"peer: %s, %s\" already exists", newRule.key(), newRule.protocol)
+			}
+		}
+
+		*rules = append(*rules, newRule)
+	}
+
+	return nil
+}
+
+// ec2RevokeRules removes rules from a set of rules.
+func (s *Server) ec2RevokeRules(rules *[]*ec2SecurityGroupRule, permissions []*ec2.IpPermission) error {
+	oldRules, err := s.ec2SecurityGroupRules(permissions)
+
+	if err != nil {
+		return err
+	}
+
+	for _, oldRule := range oldRules {
+		found := false
+
+		for i, rule := range *rules {
+			if rule.key() == oldRule.key() {
+				*rules = append((*rules)[:i], (*rules)[i+1:]...)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return newError("InvalidPermission.NotFound", "The specified rule does not exist in this security group.")
+		}
+	}
+
+	return nil
+}
+
+// ec2UpdateRuleDescriptions replaces the descriptions of existing rules.
+func (s *Server) ec2UpdateRuleDescriptions(rules []*ec2SecurityGroupRule, permissions []*ec2.IpPermission) error {
+	newRules, err := s.ec2SecurityGroupRules(permissions)
+
+	if err != nil {
+		return err
+	}
+
+	for _, newRule := range newRules {
+		found := false
+
+		for i, rule := range rules {
+			if rule.key() == newRule.key() {
+				rules[i] = newRule
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return newError("InvalidPermission.NotFound", "The specified rule does not exist in this security group.")
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) ec2AuthorizeSecurityGroupIngress(in *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	group, err := s.ec2SecurityGroup(in.GroupId, in.GroupName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ec2AuthorizeRules(&group.ingress, in.IpPermissions); err != nil {
+		return nil, err
+	}
+
+	group.update()
+
+	return &ec2.AuthorizeSecurityGroupIngressOutput{}, nil
+}
+
+func (s *Server) ec2AuthorizeSecurityGroupEgress(in *ec2.AuthorizeSecurityGroupEgressInput) (*ec2.AuthorizeSecurityGroupEgressOutput, error) {
+	group, err := s.ec2SecurityGroup(in.GroupId, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ec2AuthorizeRules(&group.egress, in.IpPermissions); err != nil {
+		return nil, err
+	}
+
+	group.update()
+
+	return &ec2.AuthorizeSecurityGroupEgressOutput{}, nil
+}
+
+func (s *Server) ec2RevokeSecurityGroupIngress(in *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+	group, err := s.ec2SecurityGroup(in.GroupId, in.GroupName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ec2RevokeRules(&group.ingress, in.IpPermissions); err != nil {
+		return nil, err
+	}
+
+	group.update()
+
+	return &ec2.RevokeSecurityGroupIngressOutput{}, nil
+}
+
+func (s *Server) ec2RevokeSecurityGroupEgress(in *ec2.RevokeSecurityGroupEgressInput) (*ec2.RevokeSecurityGroupEgressOutput, error) {
+	group, err := s.ec2SecurityGroup(in.GroupId, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ec2RevokeRules(&group.egress, in.IpPermissions); err != nil {
+		return nil, err
+	}
+
+	group.update()
+
+	return &ec2.RevokeSecurityGroupEgressOutput{}, nil
+}
+
+func (s *Server) ec2UpdateSecurityGroupRuleDescriptionsIngress(in *ec2.UpdateSecurityGroupRuleDescriptionsIngressInput) (*ec2.UpdateSecurityGroupRuleDescriptionsIngressOutput, error) {
+	group, err := s.ec2SecurityGroup(in.GroupId, in.GroupName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ec2UpdateRuleDescriptions(group.ingress, in.IpPermissions); err != nil {
+		return nil, err
+	}
+
+	group.update()
+
+	return &ec2.UpdateSecurityGroupRuleDescriptionsIngressOutput{Return: aws.Bool(true)}, nil
+}
+
+func (s *Server) ec2UpdateSecurityGroupRuleDescriptionsEgress(in *ec2.UpdateSecurityGroupRuleDescriptionsEgressInput) (*ec2.UpdateSecurityGroupRuleDescriptionsEgressOutput, error) {
+	group, err := s.ec2SecurityGroup(in.GroupId, in.GroupName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ec2UpdateRuleDescriptions(group.egress, in.IpPermissions); err != nil {
+		return nil, err
+	}
+
+	group.update()
+
+	return &ec2.UpdateSecurityGroupRuleDescriptionsEgressOutput{Return: aws.Bool(true)}, nil
+}
@@ -0,0 +1,842 @@
+package fakeaws
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+// iamState is the state of the fake IAM API. Policy documents are stored URL
+// encoded, as they are returned by IAM.
+type iamState struct {
+	instanceProfiles map[string]*iam.InstanceProfile
+	policies         map[string]*iamPolicy
+	roles            map[string]*iamRole
+}
+
+func newIamState() *iamState {
+	return &iamState{
+		instanceProfiles: make(map[string]*iam.InstanceProfile),
+		policies:         make(map[string]*iamPolicy),
+		roles:            make(map[string]*iamRole),
+	}
+}
+
+// iamRole is a role with its inline and attached policies.
+type iamRole struct {
+	role             *iam.Role
+	attachedPolicies []string
+	policies         map[string]string
+}
+
+// iamPolicy is a managed policy with its versions.
+type iamPolicy struct {
+	policy      *iam.Policy
+	versions    []*iam.PolicyVersion
+	nextVersion int
+}
+
+func (s *Server) serveIam(w http.ResponseWriter, r *http.Request) {
+	s.serveQuery(w, r, "iam", false, operations{
+		"AddRoleToInstanceProfile":      s.iamAddRoleToInstanceProfile,
+		"AttachRolePolicy":              s.iamAttachRolePolicy,
+		"CreateInstanceProfile":         s.iamCreateInstanceProfile,
+		"CreatePolicy":                  s.iamCreatePolicy,
+		"CreatePolicyVersion":           s.iamCreatePolicyVersion,
+		"CreateRole":                    s.iamCreateRole,
+		"DeleteInstanceProfile":         s.iamDeleteInstanceProfile,
+		"DeletePolicy":                  s.iamDeletePolicy,
+		"DeletePolicyVersion":           s.iamDeletePolicyVersion,
+		"DeleteRole":                    s.iamDeleteRole,
+		"DeleteRolePermissionsBoundary": s.iamDeleteRolePermissionsBoundary,
+		"DeleteRolePolicy":              s.iamDeleteRolePolicy,
+		"DetachRolePolicy":              s.iamDetachRolePolicy,
+		"GetInstanceProfile":            s.iamGetInstanceProfile,
+		"GetPolicy":                     s.iamGetPolicy,
+		"GetPolicyVersion":              s.iamGetPolicyVersion,
+		"GetRole":                       s.iamGetRole,
+		"GetRolePolicy":                 s.iamGetRolePolicy,
+		"GetUser":                       s.iamGetUser,
+		"ListAttachedRolePolicies":      s.iamListAttachedRolePolicies,
+		"ListEntitiesForPolicy":         s.iamListEntitiesForPolicy,
+		"ListInstanceProfilesForRole":   s.iamListInstanceProfilesForRole,
+		"ListPolicyVersions":            s.iamListPolicyVersions,
+		"ListRolePolicies":              s.iamListRolePolicies,
+		"ListRoleTags":                  s.iamListRoleTags,
+		"ListRoles":                     s.iamListRoles,
+		"PutRolePermissionsBoundary":    s.iamPutRolePermissionsBoundary,
+		"PutRolePolicy":                 s.iamPutRolePolicy,
+		"RemoveRoleFromInstanceProfile": s.iamRemoveRoleFromInstanceProfile,
+		"TagRole":                       s.iamTagRole,
+		"UntagRole":                     s.iamUntagRole,
+		"UpdateAssumeRolePolicy":        s.iamUpdateAssumeRolePolicy,
+		"UpdateRole":                    s.iamUpdateRole,
+		"UpdateRoleDescription":         s.iamUpdateRoleDescription,
+	})
+}
+
+func newIamNoSuchEntityError(kind, name string) *Error {
+	return newNotFoundError(iam.ErrCodeNoSuchEntityException, "The %s with name %s cannot be found.", kind, name)
+}
+
+func newIamConflictError(code, format string, a ...interface{}) *Error {
+	e := newError(code, format, a...)
+	e.StatusCode = http.StatusConflict
+
+	return e
+}
+
+// iamPolicyDocument validates a policy document and returns it URL encoded.
+func iamPolicyDocument(document *string) (*string, error) {
+	var v map[string]interface{}
+
+	if err := json.Unmarshal([]byte(aws.StringValue(document)), &v); err != nil {
+		return nil, newError(iam.ErrCodeMalformedPolicyDocumentException, "This policy contains invalid Json")
+	}
+
+	return aws.String(url.QueryEscape(aws.StringValue(document))), nil
+}
+
+// iamPath returns a path, defaulting to /.
+func iamPath(path *string) string {
+	if aws.StringValue(path) == "" {
+		return "/"
+	}
+
+	return aws.StringValue(path)
+}
+
+func (s *Server) iamGetUser(in *iam.GetUserInput) (*iam.GetUserOutput, error) {
+	name := aws.StringValue(in.UserName)
+	if name == "" {
+		name = "fakeaws"
+	}
+
+	return &iam.GetUserOutput{
+		User: &iam.User{
+			Arn:        aws.String(s.arn("iam", "", "user/"+name)),
+			CreateDate: aws.Time(time.Unix(0, 0).UTC()),
+			Path:       aws.String("/"),
+			UserId:     aws.String("AIDAFAKEAWS0000000000"),
+			UserName:   aws.String(name),
+		},
+	}, nil
+}
+
+// iamRole returns a role by name.
+func (s *Server) iamRole(name *string) (*iamRole, error) {
+	role, ok := s.iam.roles[aws.StringValue(name)]
+
+	if !ok {
+		return nil, newIamNoSuchEntityError("role", aws.StringValue(name))
+	}
+
+	return role, nil
+}
+
+func (s *Server) iamCreateRole(in *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
+	name := aws.StringValue(in.RoleName)
+
+	if _, ok := s.iam.roles[name]; ok {
+		return nil, newIamConflictError(iam.ErrCodeEntityAlreadyExistsException, "Role with name %s already exists.", name)
+	}
+
+	document, err := iamPolicyDocument(in.AssumeRolePolicyDocument)
+
+	if err != nil {
+		return nil, err
+	}
+
+	maxSessionDuration := aws.Int64Value(in.MaxSessionDuration)
+	if maxSessionDuration == 0 {
+		maxSessionDuration = 3600
+	}
+
+	path := iamPath(in.Path)
+	role := &iam.Role{
+		Arn:                      aws.String(s.arn("iam", "", "role"+path+name)),
+		AssumeRolePolicyDocument: document,
+		CreateDate:               aws.Time(time.Now().UTC().Truncate(time.Second)),
+		Description:              in.Description,
+		MaxSessionDuration:       aws.Int64(maxSessionDuration),
+		Path:                     aws.String(path),
+		RoleId:                   aws.String(strings.ToUpper(strings.Replace(s.newID("aroa"), "-", "", 1))),
+		RoleName:                 aws.String(name),
+		Tags:                     in.Tags,
+	}
+
+	if in.PermissionsBoundary != nil {
+		role.PermissionsBoundary = &iam.AttachedPermissionsBoundary{
+			PermissionsBoundaryArn:  in.PermissionsBoundary,
+			PermissionsBoundaryType: aws.String(iam.PermissionsBoundaryAttachmentTypePermissionsBoundaryPolicy),
+		}
+	}
+
+	s.iam.roles[name] = &iamRole{
+		role:     role,
+		policies: make(map[string]string),
+	}
+
+	return &iam.CreateRoleOutput{Role: role}, nil
+}
+
+func (s *Server) iamGetRole(in *iam.GetRoleInput) (*iam.GetRoleOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.GetRoleOutput{Role: role.role}, nil
+}
+
+func (s *Server) iamListRoles(in *iam.ListRolesInput) (*iam.ListRolesOutput, error) {
+	out := &iam.ListRolesOutput{IsTruncated: aws.Bool(false)}
+
+	var names []string
+	for name := range s.iam.roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if role := s.iam.roles[name].role; strings.HasPrefix(aws.StringValue(role.Path), iamPath(in.PathPrefix)) {
+			out.Roles = append(out.Roles, role)
+		}
+	}
+
+	return out, nil
+}
+
+func (s *Server) iamDeleteRole(in *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := aws.StringValue(in.RoleName)
+
+	if len(role.policies) > 0 || len(role.attachedPolicies) > 0 {
+		return nil, newIamConflictError(iam.ErrCodeDeleteConflictException, "Cannot delete entity, must delete policies first.")
+	}
+
+	for _, profile := range s.iam.instanceProfiles {
+		for _, profileRole := range profile.Roles {
+			if aws.StringValue(profileRole.RoleName) == name {
+				return nil, newIamConflictError(iam.ErrCodeDeleteConflictException, "Cannot delete entity, must remove roles from instance profile first.")
+			}
+		}
+	}
+
+	delete(s.iam.roles, name)
+
+	return &iam.DeleteRoleOutput{}, nil
+}
+
+func (s *Server) iamUpdateRole(in *iam.UpdateRoleInput) (*iam.UpdateRoleOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Description != nil {
+		role.role.Description = in.Description
+	}
+
+	if in.MaxSessionDuration != nil {
+		role.role.MaxSessionDuration = in.MaxSessionDuration
+	}
+
+	return &iam.UpdateRoleOutput{}, nil
+}
+
+func (s *Server) iamUpdateRoleDescription(in *iam.UpdateRoleDescriptionInput) (*iam.UpdateRoleDescriptionOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.role.Description = in.Description
+
+	return &iam.UpdateRoleDescriptionOutput{Role: role.role}, nil
+}
+
+func (s *Server) iamUpdateAssumeRolePolicy(in *iam.UpdateAssumeRolePolicyInput) (*iam.UpdateAssumeRolePolicyOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := iamPolicyDocument(in.PolicyDocument)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.role.AssumeRolePolicyDocument = document
+
+	return &iam.UpdateAssumeRolePolicyOutput{}, nil
+}
+
+func (s *Server) iamPutRolePermissionsBoundary(in *iam.PutRolePermissionsBoundaryInput) (*iam.PutRolePermissionsBoundaryOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.role.PermissionsBoundary = &iam.AttachedPermissionsBoundary{
+		PermissionsBoundaryArn:  in.PermissionsBoundary,
+		PermissionsBoundaryType: aws.String(iam.PermissionsBoundaryAttachmentTypePermissionsBoundaryPolicy),
+	}
+
+	return &iam.PutRolePermissionsBoundaryOutput{}, nil
+}
+
+func (s *Server) iamDeleteRolePermissionsBoundary(in *iam.DeleteRolePermissionsBoundaryInput) (*iam.DeleteRolePermissionsBoundaryOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.role.PermissionsBoundary = nil
+
+	return &iam.DeleteRolePermissionsBoundaryOutput{}, nil
+}
+
+func (s *Server) iamTagRole(in *iam.TagRoleInput) (*iam.TagRoleOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range in.Tags {
+		role.role.Tags = append(iamRemoveTag(role.role.Tags, aws.StringValue(tag.Key)), tag)
+	}
+
+	return &iam.TagRoleOutput{}, nil
+}
+
+func (s *Server) iamUntagRole(in *iam.UntagRoleInput) (*iam.UntagRoleOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range in.TagKeys {
+		role.role.Tags = iamRemoveTag(role.role.Tags, aws.StringValue(key))
+	}
+
+	return &iam.UntagRoleOutput{}, nil
+}
+
+func (s *Server) iamListRoleTags(in *iam.ListRoleTagsInput) (*iam.ListRoleTagsOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.ListRoleTagsOutput{IsTruncated: aws.Bool(false), Tags: role.role.Tags}, nil
+}
+
+func iamRemoveTag(tags []*iam.Tag, key string) []*iam.Tag {
+	var result []*iam.Tag
+
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) != key {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
+
+func (s *Server) iamPutRolePolicy(in *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := iamPolicyDocument(in.PolicyDocument)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.policies[aws.StringValue(in.PolicyName)] = aws.StringValue(document)
+
+	return &iam.PutRolePolicyOutput{}, nil
+}
+
+func (s *Server) iamGetRolePolicy(in *iam.GetRolePolicyInput) (*iam.GetRolePolicyOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	document, ok := role.policies[aws.StringValue(in.PolicyName)]
+
+	if !ok {
+		return nil, newIamNoSuchEntityError("role policy", aws.StringValue(in.PolicyName))
+	}
+
+	return &iam.GetRolePolicyOutput{
+		PolicyDocument: aws.String(document),
+		PolicyName:     in.PolicyName,
+		RoleName:       in.RoleName,
+	}, nil
+}
+
+func (s *Server) iamDeleteRolePolicy(in *iam.DeleteRolePolicyInput) (*iam.DeleteRolePolicyOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := role.policies[aws.StringValue(in.PolicyName)]; !ok {
+		return nil, newIamNoSuchEntityError("role policy", aws.StringValue(in.PolicyName))
+	}
+
+	delete(role.policies, aws.StringValue(in.PolicyName))
+
+	return &iam.DeleteRolePolicyOutput{}, nil
+}
+
+func (s *Server) iamListRolePolicies(in *iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := &iam.ListRolePoliciesOutput{IsTruncated: aws.Bool(false), PolicyNames: []*string{}}
+
+	var names []string
+	for name := range role.policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out.PolicyNames = append(out.PolicyNames, aws.StringSlice(names)...)
+
+	return out, nil
+}
+
+// iamPolicy returns a managed policy by ARN. AWS managed policies are
+// created when first referenced.
+func (s *Server) iamPolicy(arn *string) (*iamPolicy, error) {
+	if policy, ok := s.iam.policies[aws.StringValue(arn)]; ok {
+		return policy, nil
+	}
+
+	if strings.HasPrefix(aws.StringValue(arn), "arn:aws:iam::aws:policy/") {
+		name := aws.StringValue(arn)[strings.LastIndex(aws.StringValue(arn), "/")+1:]
+		policy := s.iamNewPolicy(aws.StringValue(arn), name, "/", aws.String(`{"Version":"2012-10-17","Statement":[]}`))
+		s.iam.policies[aws.StringValue(arn)] = policy
+
+		return policy, nil
+	}
+
+	return nil, newNotFoundError(iam.ErrCodeNoSuchEntityException, "Policy %s does not exist or is not attachable.", aws.StringValue(arn))
+}
+
+func (s *Server) iamNewPolicy(arn, name, path string, document *string) *iamPolicy {
+	now := aws.Time(time.Now().UTC().Truncate(time.Second))
+
+	return &iamPolicy{
+		policy: &iam.Policy{
+			Arn:              aws.String(arn),
+			AttachmentCount:  aws.Int64(0),
+			CreateDate:       now,
+			DefaultVersionId: aws.String("v1"),
+			IsAttachable:     aws.Bool(true),
+			Path:             aws.String(path),
+			PolicyId:         aws.String(strings.ToUpper(strings.Replace(s.newID("anpa"), "-", "", 1))),
+			PolicyName:       aws.String(name),
+			UpdateDate:       now,
+		},
+		versions: []*iam.PolicyVersion{
+			{
+				CreateDate:       now,
+				Document:         aws.String(url.QueryEscape(aws.StringValue(document))),
+				IsDefaultVersion: aws.Bool(true),
+				VersionId:        aws.String("v1"),
+			},
+		},
+		nextVersion: 2,
+	}
+}
+
+func (s *Server) iamCreatePolicy(in *iam.CreatePolicyInput) (*iam.CreatePolicyOutput, error) {
+	path := iamPath(in.Path)
+	arn := s.arn("iam", "", "policy"+path+aws.StringValue(in.PolicyName))
+
+	if _, ok := s.iam.policies[arn]; ok {
+		return nil, newIamConflictError(iam.ErrCodeEntityAlreadyExistsException, "A policy called %s already exists. Duplicate names are not allowed.", aws.StringValue(in.PolicyName))
+	}
+
+	if _, err := iamPolicyDocument(in.PolicyDocument); err != nil {
+		return nil, err
+	}
+
+	policy := s.iamNewPolicy(arn, aws.StringValue(in.PolicyName), path, in.PolicyDocument)
+	policy.policy.Description = in.Description
+	s.iam.policies[arn] = policy
+
+	return &iam.CreatePolicyOutput{Policy: policy.policy}, nil
+}
+
+func (s *Server) iamGetPolicy(in *iam.GetPolicyInput) (*iam.GetPolicyOutput, error) {
+	policy, err := s.iamPolicy(in.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.GetPolicyOutput{Policy: policy.policy}, nil
+}
+
+func (s *Server) iamDeletePolicy(in *iam.DeletePolicyInput) (*iam.DeletePolicyOutput, error) {
+	policy, err := s.iamPolicy(in.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.Int64Value(policy.policy.AttachmentCount) > 0 {
+		return nil, newIamConflictError(iam.ErrCodeDeleteConflictException, "Cannot delete a policy attached to entities.")
+	}
+
+	if len(policy.versions) > 1 {
+		return nil, newIamConflictError(iam.ErrCodeDeleteConflictException, "This policy has more than one version. Before you delete a policy, you must delete the policy's versions. The default version is deleted with the policy.")
+	}
+
+	delete(s.iam.policies, aws.StringValue(in.PolicyArn))
+
+	return &iam.DeletePolicyOutput{}, nil
+}
+
+func (s *Server) iamCreatePolicyVersion(in *iam.CreatePolicyVersionInput) (*iam.CreatePolicyVersionOutput, error) {
+	policy, err := s.iamPolicy(in.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := iamPolicyDocument(in.PolicyDocument)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policy.versions) >= 5 {
+		return nil, newIamConflictError(iam.ErrCodeLimitExceededException, "A managed policy can have up to 5 versions.")
+	}
+
+	version := &iam.PolicyVersion{
+		CreateDate:       aws.Time(time.Now().UTC().Truncate(time.Second)),
+		Document:         document,
+		IsDefaultVersion: aws.Bool(aws.BoolValue(in.SetAsDefault)),
+		VersionId:        aws.String(fmt.Sprintf("v%d", policy.nextVersion)),
+	}
+	policy.nextVersion++
+
+	if aws.BoolValue(in.SetAsDefault) {
+		for _, v := range policy.versions {
+			v.IsDefaultVersion = aws.Bool(false)
+		}
+
+		policy.policy.DefaultVersionId = version.VersionId
+	}
+
+	policy.versions = append(policy.versions, version)
+
+	return &iam.CreatePolicyVersionOutput{PolicyVersion: version}, nil
+}
+
+func (s *Server) iamGetPolicyVersion(in *iam.GetPolicyVersionInput) (*iam.GetPolicyVersionOutput, error) {
+	policy, err := s.iamPolicy(in.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, version := range policy.versions {
+		if aws.StringValue(version.VersionId) == aws.StringValue(in.VersionId) {
+			return &iam.GetPolicyVersionOutput{PolicyVersion: version}, nil
+		}
+	}
+
+	return nil, newIamNoSuchEntityError("policy version", aws.StringValue(in.VersionId))
+}
+
+func (s *Server) iamListPolicyVersions(in *iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error) {
+	policy, err := s.iamPolicy(in.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.ListPolicyVersionsOutput{IsTruncated: aws.Bool(false), Versions: policy.versions}, nil
+}
+
+func (s *Server) iamDeletePolicyVersion(in *iam.DeletePolicyVersionInput) (*iam.DeletePolicyVersionOutput, error) {
+	policy, err := s.iamPolicy(in.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, version := range policy.versions {
+		if aws.StringValue(version.VersionId) != aws.StringValue(in.VersionId) {
+			continue
+		}
+
+		if aws.BoolValue(version.IsDefaultVersion) {
+			return nil, newIamConflictError(iam.ErrCodeDeleteConflictException, "Cannot delete the default version of a policy.")
+		}
+
+		policy.versions = append(policy.versions[:i], policy.versions[i+1:]...)
+
+		return &iam.DeletePolicyVersionOutput{}, nil
+	}
+
+	return nil, newIamNoSuchEntityError("policy version", aws.StringValue(in.VersionId))
+}
+
+func (s *Server) iamListEntitiesForPolicy(in *iam.ListEntitiesForPolicyInput) (*iam.ListEntitiesForPolicyOutput, error) {
+	if _, err := s.iamPolicy(in.PolicyArn); err != nil {
+		return nil, err
+	}
+
+	out := &iam.ListEntitiesForPolicyOutput{
+		IsTruncated:  aws.Bool(false),
+		PolicyGroups: []*iam.PolicyGroup{},
+		PolicyRoles:  []*iam.PolicyRole{},
+		PolicyUsers:  []*iam.PolicyUser{},
+	}
+
+	for _, role := range s.iam.roles {
+		for _, arn := range role.attachedPolicies {
+			if arn == aws.StringValue(in.PolicyArn) {
+				out.PolicyRoles = append(out.PolicyRoles, &iam.PolicyRole{RoleId: role.role.RoleId, RoleName: role.role.RoleName})
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func (s *Server) iamAttachRolePolicy(in *iam.AttachRolePolicyInput) (*iam.AttachRolePolicyOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.iamPolicy(in.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, arn := range role.attachedPolicies {
+		if arn == aws.StringValue(in.PolicyArn) {
+			return &iam.AttachRolePolicyOutput{}, nil
+		}
+	}
+
+	role.attachedPolicies = append(role.attachedPolicies, aws.StringValue(in.PolicyArn))
+	policy.policy.AttachmentCount = aws.Int64(aws.Int64Value(policy.policy.AttachmentCount) + 1)
+
+	return &iam.AttachRolePolicyOutput{}, nil
+}
+
+func (s *Server) iamDetachRolePolicy(in *iam.DetachRolePolicyInput) (*iam.DetachRolePolicyOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, arn := range role.attachedPolicies {
+		if arn != aws.StringValue(in.PolicyArn) {
+			continue
+		}
+
+		role.attachedPolicies = append(role.attachedPolicies[:i], role.attachedPolicies[i+1:]...)
+
+		if policy, ok := s.iam.policies[arn]; ok {
+			policy.policy.AttachmentCount = aws.Int64(aws.Int64Value(policy.policy.AttachmentCount) - 1)
+		}
+
+		return &iam.DetachRolePolicyOutput{}, nil
+	}
+
+	return nil, newNotFoundError(iam.ErrCodeNoSuchEntityException, "Policy %s was not found.", aws.StringValue(in.PolicyArn))
+}
+
+func (s *Server) iamListAttachedRolePolicies(in *iam.ListAttachedRolePoliciesInput) (*iam.ListAttachedRolePoliciesOutput, error) {
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := &iam.ListAttachedRolePoliciesOutput{
+		AttachedPolicies: []*iam.AttachedPolicy{},
+		IsTruncated:      aws.Bool(false),
+	}
+
+	for _, arn := range role.attachedPolicies {
+		name := arn[strings.LastIndex(arn, "/")+1:]
+		out.AttachedPolicies = append(out.AttachedPolicies, &iam.AttachedPolicy{PolicyArn: aws.String(arn), PolicyName: aws.String(name)})
+	}
+
+	return out, nil
+}
+
+func (s *Server) iamCreateInstanceProfile(in *iam.CreateInstanceProfileInput) (*iam.CreateInstanceProfileOutput, error) {
+	name := aws.StringValue(in.InstanceProfileName)
+
+	if _, ok := s.iam.instanceProfiles[name]; ok {
+		return nil, newIamConflictError(iam.ErrCodeEntityAlreadyExistsException, "Instance Profile %s already exists.", name)
+	}
+
+	path := iamPath(in.Path)
+	profile := &iam.InstanceProfile{
+		Arn:                 aws.String(s.arn("iam", "", "instance-profile"+path+name)),
+		CreateDate:          aws.Time(time.Now().UTC().Truncate(time.Second)),
+		InstanceProfileId:   aws.String(strings.ToUpper(strings.Replace(s.newID("aipa"), "-", "", 1))),
+		InstanceProfileName: aws.String(name),
+		Path:                aws.String(path),
+		Roles:               []*iam.Role{},
+	}
+	s.iam.instanceProfiles[name] = profile
+
+	return &iam.CreateInstanceProfileOutput{InstanceProfile: profile}, nil
+}
+
+// iamInstanceProfile returns an instance profile by name.
+func (s *Server) iamInstanceProfile(name *string) (*iam.InstanceProfile, error) {
+	profile, ok := s.iam.instanceProfiles[aws.StringValue(name)]
+
+	if !ok {
+		return nil, newIamNoSuchEntityError("instance profile", aws.StringValue(name))
+	}
+
+	return profile, nil
+}
+
+func (s *Server) iamGetInstanceProfile(in *iam.GetInstanceProfileInput) (*iam.GetInstanceProfileOutput, error) {
+	profile, err := s.iamInstanceProfile(in.InstanceProfileName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.GetInstanceProfileOutput{InstanceProfile: profile}, nil
+}
+
+func (s *Server) iamDeleteInstanceProfile(in *iam.DeleteInstanceProfileInput) (*iam.DeleteInstanceProfileOutput, error) {
+	profile, err := s.iamInstanceProfile(in.InstanceProfileName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(profile.Roles) > 0 {
+		return nil, newIamConflictError(iam.ErrCodeDeleteConflictException, "Cannot delete entity, must remove roles from instance profile first.")
+	}
+
+	delete(s.iam.instanceProfiles, aws.StringValue(in.InstanceProfileName))
+
+	return &iam.DeleteInstanceProfileOutput{}, nil
+}
+
+func (s *Server) iamAddRoleToInstanceProfile(in *iam.AddRoleToInstanceProfileInput) (*iam.AddRoleToInstanceProfileOutput, error) {
+	profile, err := s.iamInstanceProfile(in.InstanceProfileName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := s.iamRole(in.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(profile.Roles) > 0 {
+		return nil, newIamConflictError(iam.ErrCodeLimitExceededException, "Cannot exceed quota for InstanceSessionsPerInstanceProfile: 1")
+	}
+
+	profile.Roles = append(profile.Roles, role.role)
+
+	return &iam.AddRoleToInstanceProfileOutput{}, nil
+}
+
+func (s *Server) iamRemoveRoleFromInstanceProfile(in *iam.RemoveRoleFromInstanceProfileInput) (*iam.RemoveRoleFromInstanceProfileOutput, error) {
+	profile, err := s.iamInstanceProfile(in.InstanceProfileName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, role := range profile.Roles {
+		if aws.StringValue(role.RoleName) == aws.StringValue(in.RoleName) {
+			profile.Roles = append(profile.Roles[:i], profile.Roles[i+1:]...)
+
+			return &iam.RemoveRoleFromInstanceProfileOutput{}, nil
+		}
+	}
+
+	return nil, newIamNoSuchEntityError("role", aws.StringValue(in.RoleName))
+}
+
+func (s *Server) iamListInstanceProfilesForRole(in *iam.ListInstanceProfilesForRoleInput) (*iam.ListInstanceProfilesForRoleOutput, error) {
+	if _, err := s.iamRole(in.RoleName); err != nil {
+		return nil, err
+	}
+
+	out := &iam.ListInstanceProfilesForRoleOutput{
+		InstanceProfiles: []*iam.InstanceProfile{},
+		IsTruncated:      aws.Bool(false),
+	}
+
+	var names []string
+	for name := range s.iam.instanceProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		profile := s.iam.instanceProfiles[name]
+
+		for _, role := range profile.Roles {
+			if aws.StringValue(role.RoleName) == aws.StringValue(in.RoleName) {
+				out.InstanceProfiles = append(out.InstanceProfiles, profile)
+			}
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,288 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
+// kmsState is the state of the fake KMS API, keyed by key ID. Keys are only
+// modeled to be referenced by the resources of the other services.
+type kmsState struct {
+	keys map[string]*kmsKey
+}
+
+func newKmsState() *kmsState {
+	return &kmsState{
+		keys: make(map[string]*kmsKey),
+	}
+}
+
+// kmsKey is a key with its policy, rotation status and tags.
+type kmsKey struct {
+	metadata        *kms.KeyMetadata
+	policy          *string
+	rotationEnabled bool
+	tags            []*kms.Tag
+}
+
+func (s *Server) serveKms(w http.ResponseWriter, r *http.Request) {
+	s.serveJSON(w, r, "kms", "", operations{
+		"CreateKey":            s.kmsCreateKey,
+		"DescribeKey":          s.kmsDescribeKey,
+		"DisableKey":           s.kmsDisableKey,
+		"DisableKeyRotation":   s.kmsDisableKeyRotation,
+		"EnableKey":            s.kmsEnableKey,
+		"EnableKeyRotation":    s.kmsEnableKeyRotation,
+		"GetKeyPolicy":         s.kmsGetKeyPolicy,
+		"GetKeyRotationStatus": s.kmsGetKeyRotationStatus,
+		"ListResourceTags":     s.kmsListResourceTags,
+		"PutKeyPolicy":         s.kmsPutKeyPolicy,
+		"ScheduleKeyDeletion":  s.kmsScheduleKeyDeletion,
+		"TagResource":          s.kmsTagResource,
+		"UntagResource":        s.kmsUntagResource,
+		"UpdateKeyDescription": s.kmsUpdateKeyDescription,
+	})
+}
+
+// kmsKey returns a key by ID or ARN.
+func (s *Server) kmsKey(id *string) (*kmsKey, error) {
+	keyID := aws.StringValue(id)
+	keyID = keyID[strings.LastIndex(keyID, "/")+1:]
+
+	key, ok := s.kms.keys[keyID]
+
+	if !ok {
+		return nil, newError(kms.ErrCodeNotFoundException, "Key '%s' does not exist", aws.StringValue(id))
+	}
+
+	return key, nil
+}
+
+// kmsEnabledKey returns a key that is not pending deletion by ID or ARN.
+func (s *Server) kmsEnabledKey(id *string) (*kmsKey, error) {
+	key, err := s.kmsKey(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(key.metadata.KeyState) == kms.KeyStatePendingDeletion {
+		return nil, newError(kms.ErrCodeInvalidStateException, "%s is pending deletion.", aws.StringValue(key.metadata.Arn))
+	}
+
+	return key, nil
+}
+
+func (s *Server) kmsCreateKey(in *kms.CreateKeyInput) (*kms.CreateKeyOutput, error) {
+	id := s.requestID()
+	arn := s.arn("kms", s.region, "key/"+id)
+
+	policy := in.Policy
+	if policy == nil {
+		policy = aws.String(fmt.Sprintf(`{"Version":"2012-10-17","Id":"key-default-1","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::%s:root"},"Action":"kms:*","Resource":"*"}]}`, s.AccountID))
+	}
+
+	keyUsage := in.KeyUsage
+	if keyUsage == nil {
+		keyUsage = aws.String(kms.KeyUsageTypeEncryptDecrypt)
+	}
+
+	key := &kmsKey{
+		metadata: &kms.KeyMetadata{
+			AWSAccountId: aws.String(s.AccountID),
+			Arn:          aws.String(arn),
+			CreationDate: aws.Time(time.Now().UTC()),
+			Description:  aws.String(aws.StringValue(in.Description)),
+			Enabled:      aws.Bool(true),
+			KeyId:        aws.String(id),
+			KeyManager:   aws.String(kms.KeyManagerTypeCustomer),
+			KeyState:     aws.String(kms.KeyStateEnabled),
+			KeyUsage:     keyUsage,
+			Origin:       aws.String(kms.OriginTypeAwsKms),
+		},
+		policy: policy,
+		tags:   in.Tags,
+	}
+
+	s.kms.keys[id] = key
+
+	return &kms.CreateKeyOutput{KeyMetadata: key.metadata}, nil
+}
+
+func (s *Server) kmsDescribeKey(in *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error) {
+	key, err := s.kmsKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &kms.DescribeKeyOutput{KeyMetadata: key.metadata}, nil
+}
+
+func (s *Server) kmsUpdateKeyDescription(in *kms.UpdateKeyDescriptionInput) (*kms.UpdateKeyDescriptionOutput, error) {
+	key, err := s.kmsEnabledKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key.metadata.Description = in.Description
+
+	return &kms.UpdateKeyDescriptionOutput{}, nil
+}
+
+func (s *Server) kmsEnableKey(in *kms.EnableKeyInput) (*kms.EnableKeyOutput, error) {
+	key, err := s.kmsEnabledKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key.metadata.Enabled = aws.Bool(true)
+	key.metadata.KeyState = aws.String(kms.KeyStateEnabled)
+
+	return &kms.EnableKeyOutput{}, nil
+}
+
+func (s *Server) kmsDisableKey(in *kms.DisableKeyInput) (*kms.DisableKeyOutput, error) {
+	key, err := s.kmsEnabledKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key.metadata.Enabled = aws.Bool(false)
+	key.metadata.KeyState = aws.String(kms.KeyStateDisabled)
+
+	return &kms.DisableKeyOutput{}, nil
+}
+
+func (s *Server) kmsGetKeyRotationStatus(in *kms.GetKeyRotationStatusInput) (*kms.GetKeyRotationStatusOutput, error) {
+	key, err := s.kmsKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &kms.GetKeyRotationStatusOutput{KeyRotationEnabled: aws.Bool(key.rotationEnabled)}, nil
+}
+
+func (s *Server) kmsEnableKeyRotation(in *kms.EnableKeyRotationInput) (*kms.EnableKeyRotationOutput, error) {
+	key, err := s.kmsEnabledKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key.rotationEnabled = true
+
+	return &kms.EnableKeyRotationOutput{}, nil
+}
+
+func (s *Server) kmsDisableKeyRotation(in *kms.DisableKeyRotationInput) (*kms.DisableKeyRotationOutput, error) {
+	key, err := s.kmsEnabledKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key.rotationEnabled = false
+
+	return &kms.DisableKeyRotationOutput{}, nil
+}
+
+func (s *Server) kmsGetKeyPolicy(in *kms.GetKeyPolicyInput) (*kms.GetKeyPolicyOutput, error) {
+	key, err := s.kmsKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &kms.GetKeyPolicyOutput{Policy: key.policy}, nil
+}
+
+func (s *Server) kmsPutKeyPolicy(in *kms.PutKeyPolicyInput) (*kms.PutKeyPolicyOutput, error) {
+	key, err := s.kmsEnabledKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key.policy = in.Policy
+
+	return &kms.PutKeyPolicyOutput{}, nil
+}
+
+func (s *Server) kmsScheduleKeyDeletion(in *kms.ScheduleKeyDeletionInput) (*kms.ScheduleKeyDeletionOutput, error) {
+	key, err := s.kmsEnabledKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	days := aws.Int64Value(in.PendingWindowInDays)
+	if days == 0 {
+		days = 30
+	}
+
+	key.metadata.DeletionDate = aws.Time(time.Now().UTC().AddDate(0, 0, int(days)))
+	key.metadata.Enabled = aws.Bool(false)
+	key.metadata.KeyState = aws.String(kms.KeyStatePendingDeletion)
+
+	return &kms.ScheduleKeyDeletionOutput{DeletionDate: key.metadata.DeletionDate, KeyId: key.metadata.Arn}, nil
+}
+
+func (s *Server) kmsListResourceTags(in *kms.ListResourceTagsInput) (*kms.ListResourceTagsOutput, error) {
+	key, err := s.kmsKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &kms.ListResourceTagsOutput{Tags: key.tags, Truncated: aws.Bool(false)}, nil
+}
+
+func (s *Server) kmsTagResource(in *kms.TagResourceInput) (*kms.TagResourceOutput, error) {
+	key, err := s.kmsEnabledKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range in.Tags {
+		key.tags = append(kmsRemoveTag(key.tags, aws.StringValue(tag.TagKey)), tag)
+	}
+
+	return &kms.TagResourceOutput{}, nil
+}
+
+func (s *Server) kmsUntagResource(in *kms.UntagResourceInput) (*kms.UntagResourceOutput, error) {
+	key, err := s.kmsEnabledKey(in.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range in.TagKeys {
+		key.tags = kmsRemoveTag(key.tags, aws.StringValue(k))
+	}
+
+	return &kms.UntagResourceOutput{}, nil
+}
+
+func kmsRemoveTag(tags []*kms.Tag, key string) []*kms.Tag {
+	var result []*kms.Tag
+
+	for _, tag := range tags {
+		if aws.StringValue(tag.TagKey) != key {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
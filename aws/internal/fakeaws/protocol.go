@@ -0,0 +1,701 @@
+package fakeaws
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/private/protocol/xml/xmlutil"
+)
+
+// operations maps API operation names to handler functions. Handlers have the
+// signature func(*<Operation>Input) (*<Operation>Output, error), using the
+// AWS Go SDK shapes of the service, so requests and responses are encoded
+// and decoded generically.
+type operations map[string]interface{}
+
+// call decodes the input of an operation handler and calls it.
+func (ops operations) call(service, operation string, decode func(interface{}) error) (interface{}, *Error) {
+	handler, ok := ops[operation]
+
+	if !ok {
+		return nil, notImplemented(service, operation)
+	}
+
+	fn := reflect.ValueOf(handler)
+	in := reflect.New(fn.Type().In(0).Elem())
+
+	if err := decode(in.Interface()); err != nil {
+		return nil, newError("ValidationError", "error decoding %s %s request: %s", service, operation, err)
+	}
+
+	out := fn.Call([]reflect.Value{in})
+
+	if err, ok := out[1].Interface().(error); ok && err != nil {
+		return nil, errorOf(err)
+	}
+
+	return out[0].Interface(), nil
+}
+
+// serveQuery serves an API using the AWS Query protocol, or its EC2 variant.
+func (s *Server) serveQuery(w http.ResponseWriter, r *http.Request, service string, isEC2 bool, ops operations) {
+	requestID := s.requestID()
+
+	if err := r.ParseForm(); err != nil {
+		writeQueryError(w, isEC2, requestID, newError("MalformedQueryString", "%s", err))
+		return
+	}
+
+	operation := r.Form.Get("Action")
+
+	out, e := ops.call(service, operation, func(in interface{}) error {
+		return decodeQuery(r.Form, in, isEC2)
+	})
+
+	if e != nil {
+		writeQueryError(w, isEC2, requestID, e)
+		return
+	}
+
+	var b bytes.Buffer
+
+	if isEC2 {
+		fmt.Fprintf(&b, `<%sResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><requestId>%s</requestId>`, operation, requestID)
+		writeXMLFields(&b, reflect.ValueOf(out))
+		fmt.Fprintf(&b, `</%sResponse>`, operation)
+	} else {
+		fmt.Fprintf(&b, `<%sResponse><%sResult>`, operation, operation)
+		writeXMLFields(&b, reflect.ValueOf(out))
+		fmt.Fprintf(&b, `</%sResult><ResponseMetadata><RequestId>%s</RequestId></ResponseMetadata></%sResponse>`, operation, requestID, operation)
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.Write(b.Bytes())
+}
+
+// writeQueryError writes an AWS Query or EC2 protocol error response.
+func writeQueryError(w http.ResponseWriter, isEC2 bool, requestID string, e *Error) {
+	var b bytes.Buffer
+
+	if isEC2 {
+		fmt.Fprintf(&b, `<Response><Errors><Error><Code>%s</Code><Message>%s</Message></Error></Errors><RequestID>%s</RequestID></Response>`, xmlEscape(e.Code), xmlEscape(e.Message), requestID)
+	} else {
+		fmt.Fprintf(&b, `<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>`, xmlEscape(e.Code), xmlEscape(e.Message), requestID)
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.WriteHeader(e.StatusCode)
+	w.Write(b.Bytes())
+}
+
+// serveJSON serves an API using the AWS JSON protocol, with operations
+// named by the X-Amz-Target header.
+func (s *Server) serveJSON(w http.ResponseWriter, r *http.Request, service, errorPrefix string, ops operations) {
+	requestID := s.requestID()
+	target := r.Header.Get("X-Amz-Target")
+	operation := target[strings.LastIndex(target, ".")+1:]
+
+	out, e := ops.call(service, operation, func(in interface{}) error {
+		body, err := ioutil.ReadAll(r.Body)
+
+		if err != nil || len(body) == 0 {
+			return err
+		}
+
+		return jsonutil.UnmarshalJSON(in, bytes.NewReader(body))
+	})
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	w.Header().Set("X-Amzn-Requestid", requestID)
+
+	if e != nil {
+		w.WriteHeader(e.StatusCode)
+		fmt.Fprintf(w, `{"__type":%q,"message":%q}`, errorPrefix+e.Code, e.Message)
+		return
+	}
+
+	body, err := jsonutil.BuildJSON(out)
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"__type":"InternalFailure","message":%q}`, err.Error())
+		return
+	}
+
+	w.Write(body)
+}
+
+// decodeQuery decodes AWS Query protocol request parameters into an AWS Go
+// SDK input shape, reversing the encoding of the SDK queryutil package.
+func decodeQuery(values url.Values, v interface{}, isEC2 bool) error {
+	d := &queryDecoder{isEC2: isEC2, values: values}
+
+	return d.decodeStruct(reflect.ValueOf(v).Elem(), "")
+}
+
+type queryDecoder struct {
+	isEC2  bool
+	values url.Values
+}
+
+// has returns whether any parameter is named prefix or nested below it.
+func (d *queryDecoder) has(prefix string) bool {
+	for key := range d.values {
+		if key == prefix || strings.HasPrefix(key, prefix+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *queryDecoder) decodeValue(v reflect.Value, prefix string, tag reflect.StructTag) error {
+	t := v.Type()
+
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch {
+	case t.Kind() == reflect.Struct && t != reflect.TypeOf(time.Time{}):
+		if !d.has(prefix) {
+			return nil
+		}
+
+		if v.Kind() == reflect.Ptr {
+			v.Set(reflect.New(t))
+			v = v.Elem()
+		}
+
+		return d.decodeStruct(v, prefix)
+	case t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8:
+		return d.decodeList(v, prefix, tag)
+	case t.Kind() == reflect.Map:
+		return d.decodeMap(v, prefix, tag)
+	default:
+		value, ok := d.values[prefix]
+
+		if !ok {
+			return nil
+		}
+
+		return setScalar(v, value[0], tag)
+	}
+}
+
+func (d *queryDecoder) decodeStruct(v reflect.Value, prefix string) error {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.PkgPath != "" || field.Tag.Get("ignore") != "" {
+			continue
+		}
+
+		var name string
+		if d.isEC2 {
+			name = field.Tag.Get("queryName")
+		}
+		if name == "" {
+			if field.Tag.Get("flattened") != "" && field.Tag.Get("locationNameList") != "" {
+				name = field.Tag.Get("locationNameList")
+			} else if locName := field.Tag.Get("locationName"); locName != "" {
+				name = locName
+			}
+			if name != "" && d.isEC2 {
+				name = strings.ToUpper(name[0:1]) + name[1:]
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		if err := d.decodeValue(v.Field(i), name, field.Tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *queryDecoder) decodeList(v reflect.Value, prefix string, tag reflect.StructTag) error {
+	if value, ok := d.values[prefix]; ok && len(value) > 0 && value[0] == "" {
+		v.Set(reflect.MakeSlice(v.Type(), 0, 0))
+		return nil
+	}
+
+	if !d.isEC2 && tag.Get("flattened") == "" {
+		if listName := tag.Get("locationNameList"); listName == "" {
+			prefix += ".member"
+		} else {
+			prefix += "." + listName
+		}
+	}
+
+	for i := 1; d.has(prefix + "." + strconv.Itoa(i)); i++ {
+		elem := reflect.New(v.Type().Elem()).Elem()
+
+		if err := d.decodeValue(elem, prefix+"."+strconv.Itoa(i), ""); err != nil {
+			return err
+		}
+
+		v.Set(reflect.Append(v, elem))
+	}
+
+	return nil
+}
+
+func (d *queryDecoder) decodeMap(v reflect.Value, prefix string, tag reflect.StructTag) error {
+	if !d.isEC2 && tag.Get("flattened") == "" {
+		prefix += ".entry"
+	}
+
+	kname := tag.Get("locationNameKey")
+	if kname == "" {
+		kname = "key"
+	}
+	vname := tag.Get("locationNameValue")
+	if vname == "" {
+		vname = "value"
+	}
+
+	for i := 1; d.has(prefix + "." + strconv.Itoa(i)); i++ {
+		entryPrefix := prefix + "." + strconv.Itoa(i)
+
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+
+		value := reflect.New(v.Type().Elem()).Elem()
+
+		if err := d.decodeValue(value, entryPrefix+"."+vname, ""); err != nil {
+			return err
+		}
+
+		v.SetMapIndex(reflect.ValueOf(d.values.Get(entryPrefix+"."+kname)), value)
+	}
+
+	return nil
+}
+
+// setScalar sets a scalar AWS Go SDK shape member from its string encoding.
+func setScalar(v reflect.Value, s string, tag reflect.StructTag) error {
+	var value interface{}
+
+	switch v.Interface().(type) {
+	case *string, string:
+		value = s
+	case *bool, bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		value = b
+	case *int64, int64:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		value = i
+	case *float64, float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		value = f
+	case *time.Time, time.Time:
+		format := tag.Get("timestampFormat")
+		if format == "" {
+			format = protocol.ISO8601TimeFormatName
+		}
+		t, err := protocol.ParseTime(format, s)
+		if err != nil {
+			return err
+		}
+		value = t
+	case []byte:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(b))
+		return nil
+	default:
+		return fmt.Errorf("unsupported value type: %s", v.Type())
+	}
+
+	rv := reflect.ValueOf(value)
+
+	if v.Kind() == reflect.Ptr {
+		p := reflect.New(rv.Type())
+		p.Elem().Set(rv)
+		rv = p
+	}
+
+	v.Set(rv)
+
+	return nil
+}
+
+// decodeREST decodes the URI, header, query string and payload members of an
+// AWS Go SDK input shape from a REST protocol request.
+func decodeREST(r *http.Request, uri map[string]string, v interface{}) error {
+	rv := reflect.ValueOf(v).Elem()
+	t := rv.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := field.Tag.Get("locationName")
+		tag := field.Tag
+
+		switch field.Tag.Get("location") {
+		case "uri":
+			if value, ok := uri[name]; ok {
+				if err := setScalar(rv.Field(i), value, tag); err != nil {
+					return err
+				}
+			}
+		case "querystring":
+			if value, ok := r.URL.Query()[name]; ok {
+				if err := setScalar(rv.Field(i), value[0], tag); err != nil {
+					return err
+				}
+			}
+		case "header":
+			value := r.Header.Get(name)
+
+			if value == "" {
+				continue
+			}
+
+			if tag.Get("timestampFormat") == "" {
+				tag = reflect.StructTag(string(tag) + ` timestampFormat:"rfc822"`)
+			}
+
+			if err := setScalar(rv.Field(i), value, tag); err != nil {
+				return err
+			}
+		case "headers":
+			m := make(map[string]*string)
+
+			for key, values := range r.Header {
+				if strings.HasPrefix(strings.ToLower(key), strings.ToLower(name)) {
+					m[strings.ToLower(key[len(name):])] = &values[0]
+				}
+			}
+
+			if len(m) > 0 {
+				rv.Field(i).Set(reflect.ValueOf(m))
+			}
+		}
+	}
+
+	payload, ok := t.FieldByName("_")
+
+	if !ok || payload.Tag.Get("payload") == "" {
+		return nil
+	}
+
+	field, _ := t.FieldByName(payload.Tag.Get("payload"))
+	fv := rv.FieldByIndex(field.Index)
+
+	body, err := ioutil.ReadAll(r.Body)
+
+	if err != nil || len(body) == 0 {
+		return err
+	}
+
+	switch {
+	case field.Type.Kind() == reflect.Ptr && field.Type.Elem().Kind() == reflect.String:
+		fv.Set(reflect.ValueOf(aws.String(string(body))))
+	case field.Type.Kind() == reflect.Slice:
+		fv.Set(reflect.ValueOf(body))
+	case field.Type.Kind() == reflect.Interface:
+		fv.Set(reflect.ValueOf(bytes.NewReader(body)))
+	default:
+		fv.Set(reflect.New(field.Type.Elem()))
+
+		return decodeXML(bytes.NewReader(body), fv.Interface())
+	}
+
+	return nil
+}
+
+// writeREST writes an AWS Go SDK output shape as a REST-XML protocol
+// response. Members without location are written as the children of an XML
+// element named root, unless the shape has a payload member.
+func writeREST(w http.ResponseWriter, status int, root, xmlns string, v interface{}) {
+	rv := reflect.ValueOf(v).Elem()
+	t := rv.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := rv.Field(i)
+
+		if field.PkgPath != "" || (fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Map) && fv.IsNil() {
+			continue
+		}
+
+		name := field.Tag.Get("locationName")
+
+		switch field.Tag.Get("location") {
+		case "header":
+			w.Header().Set(name, formatHeader(fv.Elem(), field.Tag))
+		case "headers":
+			for _, key := range fv.MapKeys() {
+				w.Header().Set(name+key.String(), fv.MapIndex(key).Elem().String())
+			}
+		}
+	}
+
+	var b bytes.Buffer
+
+	if payload, ok := t.FieldByName("_"); ok && payload.Tag.Get("payload") != "" {
+		field, _ := t.FieldByName(payload.Tag.Get("payload"))
+		fv := rv.FieldByIndex(field.Index)
+
+		switch value := fv.Interface().(type) {
+		case *string:
+			b.WriteString(aws.StringValue(value))
+		case []byte:
+			b.Write(value)
+		case io.Reader:
+			if value != nil {
+				io.Copy(&b, value)
+			}
+		default:
+			if !fv.IsNil() {
+				name := field.Tag.Get("locationName")
+				if name == "" {
+					name = field.Name
+				}
+
+				fmt.Fprintf(&b, `<%s xmlns="%s">`, name, xmlns)
+				writeXMLFields(&b, fv)
+				fmt.Fprintf(&b, `</%s>`, name)
+			}
+		}
+	} else if root != "" {
+		fmt.Fprintf(&b, `<%s xmlns="%s">`, root, xmlns)
+		writeXMLFields(&b, rv)
+		fmt.Fprintf(&b, `</%s>`, root)
+	}
+
+	if b.Len() > 0 && w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/xml")
+	}
+
+	w.WriteHeader(status)
+	w.Write(b.Bytes())
+}
+
+// formatHeader formats a scalar AWS Go SDK shape member as a header value.
+func formatHeader(v reflect.Value, tag reflect.StructTag) string {
+	switch value := v.Interface().(type) {
+	case time.Time:
+		format := tag.Get("timestampFormat")
+		if format == "" {
+			format = protocol.RFC822TimeFormatName
+		}
+		return protocol.FormatTime(format, value)
+	case bool:
+		return strconv.FormatBool(value)
+	case int64:
+		return strconv.FormatInt(value, 10)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// decodeXML decodes an XML request body into an AWS Go SDK shape.
+func decodeXML(r io.Reader, v interface{}) error {
+	return xmlutil.UnmarshalXML(v, xml.NewDecoder(r), "")
+}
+
+// writeXMLFields writes the members of an AWS Go SDK shape as XML elements,
+// mirroring the decoding of the SDK xmlutil package.
+func writeXMLFields(b *bytes.Buffer, v reflect.Value) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.PkgPath != "" || field.Tag.Get("location") != "" || field.Tag.Get("xmlAttribute") != "" {
+			continue
+		}
+
+		name := field.Name
+		if field.Tag.Get("flattened") != "" && field.Tag.Get("locationNameList") != "" {
+			name = field.Tag.Get("locationNameList")
+		} else if locName := field.Tag.Get("locationName"); locName != "" {
+			name = locName
+		}
+
+		writeXMLValue(b, name, v.Field(i), field.Tag)
+	}
+}
+
+// writeXMLValue writes a value of an AWS Go SDK shape as XML element(s).
+func writeXMLValue(b *bytes.Buffer, name string, v reflect.Value, tag reflect.StructTag) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			format := tag.Get("timestampFormat")
+			if format == "" {
+				format = protocol.ISO8601TimeFormatName
+			}
+			writeXMLElement(b, name, protocol.FormatTime(format, t))
+			return
+		}
+
+		fmt.Fprintf(b, "<%s%s>", name, xmlAttributes(v))
+		writeXMLFields(b, v)
+		fmt.Fprintf(b, "</%s>", name)
+	case reflect.Slice:
+		if data, ok := v.Interface().([]byte); ok {
+			writeXMLElement(b, name, base64.StdEncoding.EncodeToString(data))
+			return
+		}
+
+		if v.IsNil() {
+			return
+		}
+
+		if tag.Get("flattened") != "" {
+			for i := 0; i < v.Len(); i++ {
+				writeXMLValue(b, name, v.Index(i), "")
+			}
+			return
+		}
+
+		member := tag.Get("locationNameList")
+		if member == "" {
+			member = "member"
+		}
+
+		fmt.Fprintf(b, "<%s>", name)
+		for i := 0; i < v.Len(); i++ {
+			writeXMLValue(b, member, v.Index(i), "")
+		}
+		fmt.Fprintf(b, "</%s>", name)
+	case reflect.Map:
+		if v.IsNil() {
+			return
+		}
+
+		kname := tag.Get("locationNameKey")
+		if kname == "" {
+			kname = "key"
+		}
+		vname := tag.Get("locationNameValue")
+		if vname == "" {
+			vname = "value"
+		}
+
+		entry := "entry"
+		if tag.Get("flattened") != "" {
+			entry = name
+		} else {
+			fmt.Fprintf(b, "<%s>", name)
+		}
+
+		for _, key := range v.MapKeys() {
+			fmt.Fprintf(b, "<%s>", entry)
+			writeXMLElement(b, kname, key.String())
+			writeXMLValue(b, vname, v.MapIndex(key), "")
+			fmt.Fprintf(b, "</%s>", entry)
+		}
+
+		if tag.Get("flattened") == "" {
+			fmt.Fprintf(b, "</%s>", name)
+		}
+	case reflect.Bool:
+		writeXMLElement(b, name, strconv.FormatBool(v.Bool()))
+	case reflect.Int64:
+		writeXMLElement(b, name, strconv.FormatInt(v.Int(), 10))
+	case reflect.Float64:
+		writeXMLElement(b, name, strconv.FormatFloat(v.Float(), 'f', -1, 64))
+	case reflect.String:
+		writeXMLElement(b, name, v.String())
+	}
+}
+
+// xmlAttributes returns the members of an AWS Go SDK shape serialized as XML
+// attributes, e.g. the xsi:type of an S3 grantee.
+func xmlAttributes(v reflect.Value) string {
+	var b bytes.Buffer
+
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+
+		if field.Tag.Get("xmlAttribute") == "" || fv.IsNil() {
+			continue
+		}
+
+		name := field.Tag.Get("locationName")
+
+		if strings.HasPrefix(name, "xsi:") {
+			b.WriteString(` xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"`)
+		}
+
+		fmt.Fprintf(&b, ` %s="%s"`, name, xmlEscape(fmt.Sprint(fv.Elem().Interface())))
+	}
+
+	return b.String()
+}
+
+func writeXMLElement(b *bytes.Buffer, name, text string) {
+	fmt.Fprintf(b, "<%s>%s</%s>", name, xmlEscape(text), name)
+}
+
+func xmlEscape(s string) string {
+	var b bytes.Buffer
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
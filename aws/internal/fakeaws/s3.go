@@ -0,0 +1,1399 @@
+package fakeaws
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// s3Xmlns is the XML namespace of S3 API responses.
+const s3Xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+// s3State is the state of the fake S3 API. Buckets are addressed path style.
+type s3State struct {
+	buckets map[string]*s3Bucket
+}
+
+func newS3State() *s3State {
+	return &s3State{
+		buckets: make(map[string]*s3Bucket),
+	}
+}
+
+// s3Bucket is a bucket with its configurations and objects. A nil
+// configuration has not been set or was deleted.
+type s3Bucket struct {
+	acl          string
+	accelerate   *string
+	cors         []*s3.CORSRule
+	created      time.Time
+	encryption   *s3.ServerSideEncryptionConfiguration
+	lifecycle    []*s3.LifecycleRule
+	logging      *s3.LoggingEnabled
+	name         string
+	objectLock   *s3.ObjectLockConfiguration
+	objects      map[string][]*s3Object
+	policy       *string
+	region       string
+	replication  *s3.ReplicationConfiguration
+	requestPayer string
+	tags         []*s3.Tag
+	versioning   *s3.VersioningConfiguration
+	website      *s3.WebsiteConfiguration
+}
+
+// s3Object is a version of an object, or a delete marker.
+type s3Object struct {
+	body         []byte
+	deleteMarker bool
+	etag         string
+	input        *s3.PutObjectInput
+	lastModified time.Time
+	tags         []*s3.Tag
+	versionID    string
+}
+
+// s3Route is the operation of a request and the root element of its
+// response.
+type s3Route struct {
+	operation string
+	root      string
+}
+
+// s3BucketRoutes are the bucket operations by subresource and method.
+var s3BucketRoutes = map[string]map[string]s3Route{
+	"": {
+		http.MethodDelete: {operation: "DeleteBucket"},
+		http.MethodGet:    {operation: "ListObjects", root: "ListBucketResult"},
+		http.MethodHead:   {operation: "HeadBucket"},
+		http.MethodPut:    {operation: "CreateBucket"},
+	},
+	"accelerate": {
+		http.MethodGet: {operation: "GetBucketAccelerateConfiguration", root: "AccelerateConfiguration"},
+		http.MethodPut: {operation: "PutBucketAccelerateConfiguration"},
+	},
+	"acl": {
+		http.MethodGet: {operation: "GetBucketAcl", root: "AccessControlPolicy"},
+		http.MethodPut: {operation: "PutBucketAcl"},
+	},
+	"cors": {
+		http.MethodDelete: {operation: "DeleteBucketCors"},
+		http.MethodGet:    {operation: "GetBucketCors", root: "CORSConfiguration"},
+		http.MethodPut:    {operation: "PutBucketCors"},
+	},
+	"delete": {
+		http.MethodPost: {operation: "DeleteObjects", root: "DeleteResult"},
+	},
+	"encryption": {
+		http.MethodDelete: {operation: "DeleteBucketEncryption"},
+		http.MethodGet:    {operation: "GetBucketEncryption"},
+		http.MethodPut:    {operation: "PutBucketEncryption"},
+	},
+	"lifecycle": {
+		http.MethodDelete: {operation: "DeleteBucketLifecycle"},
+		http.MethodGet:    {operation: "GetBucketLifecycleConfiguration", root: "LifecycleConfiguration"},
+		http.MethodPut:    {operation: "PutBucketLifecycleConfiguration"},
+	},
+	"location": {
+		http.MethodGet: {operation: "GetBucketLocation", root: "LocationConstraint"},
+	},
+	"logging": {
+		http.MethodGet: {operation: "GetBucketLogging", root: "BucketLoggingStatus"},
+		http.MethodPut: {operation: "PutBucketLogging"},
+	},
+	"object-lock": {
+		http.MethodGet: {operation: "GetObjectLockConfiguration"},
+		http.MethodPut: {operation: "PutObjectLockConfiguration"},
+	},
+	"policy": {
+		http.MethodDelete: {operation: "DeleteBucketPolicy"},
+		http.MethodGet:    {operation: "GetBucketPolicy"},
+		http.MethodPut:    {operation: "PutBucketPolicy"},
+	},
+	"replication": {
+		http.MethodDelete: {operation: "DeleteBucketReplication"},
+		http.MethodGet:    {operation: "GetBucketReplication"},
+		http.MethodPut:    {operation: "PutBucketReplication"},
+	},
+	"requestPayment": {
+		http.MethodGet: {operation: "GetBucketRequestPayment", root: "RequestPaymentConfiguration"},
+		http.MethodPut: {operation: "PutBucketRequestPayment"},
+	},
+	"tagging": {
+		http.MethodDelete: {operation: "DeleteBucketTagging"},
+		http.MethodGet:    {operation: "GetBucketTagging", root: "Tagging"},
+		http.MethodPut:    {operation: "PutBucketTagging"},
+	},
+	"versioning": {
+		http.MethodGet: {operation: "GetBucketVersioning", root: "VersioningConfiguration"},
+		http.MethodPut: {operation: "PutBucketVersioning"},
+	},
+	"versions": {
+		http.MethodGet: {operation: "ListObjectVersions", root: "ListVersionsResult"},
+	},
+	"website": {
+		http.MethodDelete: {operation: "DeleteBucketWebsite"},
+		http.MethodGet:    {operation: "GetBucketWebsite", root: "WebsiteConfiguration"},
+		http.MethodPut:    {operation: "PutBucketWebsite"},
+	},
+}
+
+// s3ObjectRoutes are the object operations by subresource and method.
+var s3ObjectRoutes = map[string]map[string]s3Route{
+	"": {
+		http.MethodDelete: {operation: "DeleteObject"},
+		http.MethodGet:    {operation: "GetObject"},
+		http.MethodHead:   {operation: "HeadObject"},
+		http.MethodPut:    {operation: "PutObject"},
+	},
+	"legal-hold": {
+		http.MethodPut: {operation: "PutObjectLegalHold"},
+	},
+	"retention": {
+		http.MethodPut: {operation: "PutObjectRetention"},
+	},
+	"tagging": {
+		http.MethodDelete: {operation: "DeleteObjectTagging"},
+		http.MethodGet:    {operation: "GetObjectTagging", root: "Tagging"},
+		http.MethodPut:    {operation: "PutObjectTagging"},
+	},
+}
+
+func (s *Server) serveS3(w http.ResponseWriter, r *http.Request) {
+	ops := operations{
+		"CreateBucket":                     s.s3CreateBucket,
+		"DeleteBucket":                     s.s3DeleteBucket,
+		"DeleteBucketCors":                 s.s3DeleteBucketCors,
+		"DeleteBucketEncryption":           s.s3DeleteBucketEncryption,
+		"DeleteBucketLifecycle":            s.s3DeleteBucketLifecycle,
+		"DeleteBucketPolicy":               s.s3DeleteBucketPolicy,
+		"DeleteBucketReplication":          s.s3DeleteBucketReplication,
+		"DeleteBucketTagging":              s.s3DeleteBucketTagging,
+		"DeleteBucketWebsite":              s.s3DeleteBucketWebsite,
+		"DeleteObject":                     s.s3DeleteObject,
+		"DeleteObjectTagging":              s.s3DeleteObjectTagging,
+		"DeleteObjects":                    s.s3DeleteObjects,
+		"GetBucketAccelerateConfiguration": s.s3GetBucketAccelerateConfiguration,
+		"GetBucketAcl":                     s.s3GetBucketAcl,
+		"GetBucketCors":                    s.s3GetBucketCors,
+		"GetBucketEncryption":              s.s3GetBucketEncryption,
+		"GetBucketLifecycleConfiguration":  s.s3GetBucketLifecycleConfiguration,
+		"GetBucketLocation":                s.s3GetBucketLocation,
+		"GetBucketLogging":                 s.s3GetBucketLogging,
+		"GetBucketPolicy":                  s.s3GetBucketPolicy,
+		"GetBucketReplication":             s.s3GetBucketReplication,
+		"GetBucketRequestPayment":          s.s3GetBucketRequestPayment,
+		"GetBucketTagging":                 s.s3GetBucketTagging,
+		"GetBucketVersioning":              s.s3GetBucketVersioning,
+		"GetBucketWebsite":                 s.s3GetBucketWebsite,
+		"GetObject":                        s.s3GetObject,
+		"GetObjectLockConfiguration":       s.s3GetObjectLockConfiguration,
+		"GetObjectTagging":                 s.s3GetObjectTagging,
+		"HeadBucket":                       s.s3HeadBucket,
+		"HeadObject":                       s.s3HeadObject,
+		"ListBuckets":                      s.s3ListBuckets,
+		"ListObjects":                      s.s3ListObjects,
+		"ListObjectVersions":               s.s3ListObjectVersions,
+		"PutBucketAccelerateConfiguration": s.s3PutBucketAccelerateConfiguration,
+		"PutBucketAcl":                     s.s3PutBucketAcl,
+		"PutBucketCors":                    s.s3PutBucketCors,
+		"PutBucketEncryption":              s.s3PutBucketEncryption,
+		"PutBucketLifecycleConfiguration":  s.s3PutBucketLifecycleConfiguration,
+		"PutBucketLogging":                 s.s3PutBucketLogging,
+		"PutBucketPolicy":                  s.s3PutBucketPolicy,
+		"PutBucketReplication":             s.s3PutBucketReplication,
+		"PutBucketRequestPayment":          s.s3PutBucketRequestPayment,
+		"PutBucketTagging":                 s.s3PutBucketTagging,
+		"PutBucketVersioning":              s.s3PutBucketVersioning,
+		"PutBucketWebsite":                 s.s3PutBucketWebsite,
+		"PutObject":                        s.s3PutObject,
+		"PutObjectLegalHold":               s.s3PutObjectLegalHold,
+		"PutObjectLockConfiguration":       s.s3PutObjectLockConfiguration,
+		"PutObjectRetention":               s.s3PutObjectRetention,
+		"PutObjectTagging":                 s.s3PutObjectTagging,
+	}
+
+	requestID := s.requestID()
+	w.Header().Set("X-Amz-Request-Id", requestID)
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	bucket, key := path, ""
+	if i := strings.Index(path, "/"); i >= 0 {
+		bucket, key = path[:i], path[i+1:]
+	}
+
+	routes := s3BucketRoutes
+	if key != "" {
+		routes = s3ObjectRoutes
+	}
+
+	var route s3Route
+
+	if bucket == "" {
+		route = s3Route{operation: "ListBuckets", root: "ListAllMyBucketsResult"}
+	} else {
+		var subresource string
+		for name := range r.URL.Query() {
+			if _, ok := routes[name]; ok {
+				subresource = name
+			}
+		}
+
+		route = routes[subresource][r.Method]
+
+		if route.operation == "" {
+			route.operation = fmt.Sprintf("%s %s?%s", r.Method, r.URL.Path, r.URL.RawQuery)
+		}
+	}
+
+	out, e := ops.call("s3", route.operation, func(in interface{}) error {
+		return decodeREST(r, map[string]string{"Bucket": bucket, "Key": key}, in)
+	})
+
+	if e != nil {
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(e.StatusCode)
+
+		if r.Method != http.MethodHead {
+			fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Message>%s</Message><RequestId>%s</RequestId></Error>`, xmlEscape(e.Code), xmlEscape(e.Message), requestID)
+		}
+
+		return
+	}
+
+	status := http.StatusOK
+	if r.Method == http.MethodDelete {
+		status = http.StatusNoContent
+	}
+
+	writeREST(w, status, route.root, s3Xmlns, out)
+}
+
+// s3Bucket returns a bucket by name.
+func (s *Server) s3Bucket(name *string) (*s3Bucket, error) {
+	bucket, ok := s.s3.buckets[aws.StringValue(name)]
+
+	if !ok {
+		return nil, newNotFoundError(s3.ErrCodeNoSuchBucket, "The specified bucket does not exist")
+	}
+
+	return bucket, nil
+}
+
+func (s *Server) s3Owner() *s3.Owner {
+	return &s3.Owner{
+		DisplayName: aws.String("fakeaws"),
+		ID:          aws.String(fmt.Sprintf("%064x", 0)),
+	}
+}
+
+func (s *Server) s3CreateBucket(in *s3.CreateBucketInput) (*s3.CreateBucketOutput, error) {
+	name := aws.StringValue(in.Bucket)
+
+	if _, ok := s.s3.buckets[name]; ok {
+		e := newError(s3.ErrCodeBucketAlreadyOwnedByYou, "Your previous request to create the named bucket succeeded and you already own it.")
+		e.StatusCode = http.StatusConflict
+
+		return nil, e
+	}
+
+	region := "us-east-1"
+	if in.CreateBucketConfiguration != nil && aws.StringValue(in.CreateBucketConfiguration.LocationConstraint) != "" {
+		region = aws.StringValue(in.CreateBucketConfiguration.LocationConstraint)
+	}
+
+	acl := aws.StringValue(in.ACL)
+	if acl == "" {
+		acl = s3.BucketCannedACLPrivate
+	}
+
+	bucket := &s3Bucket{
+		acl:          acl,
+		created:      time.Now().UTC(),
+		name:         name,
+		objects:      make(map[string][]*s3Object),
+		region:       region,
+		requestPayer: s3.PayerBucketOwner,
+	}
+
+	if aws.BoolValue(in.ObjectLockEnabledForBucket) {
+		bucket.objectLock = &s3.ObjectLockConfiguration{ObjectLockEnabled: aws.String(s3.ObjectLockEnabledEnabled)}
+		bucket.versioning = &s3.VersioningConfiguration{Status: aws.String(s3.BucketVersioningStatusEnabled)}
+	}
+
+	s.s3.buckets[name] = bucket
+
+	return &s3.CreateBucketOutput{Location: aws.String("/" + name)}, nil
+}
+
+func (s *Server) s3HeadBucket(in *s3.HeadBucketInput) (*s3.HeadBucketOutput, error) {
+	if _, err := s.s3Bucket(in.Bucket); err != nil {
+		return nil, err
+	}
+
+	return &s3.HeadBucketOutput{}, nil
+}
+
+func (s *Server) s3ListBuckets(in *s3.ListBucketsInput) (*s3.ListBucketsOutput, error) {
+	var names []string
+	for name := range s.s3.buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := &s3.ListBucketsOutput{Buckets: []*s3.Bucket{}, Owner: s.s3Owner()}
+
+	for _, name := range names {
+		out.Buckets = append(out.Buckets, &s3.Bucket{
+			CreationDate: aws.Time(s.s3.buckets[name].created),
+			Name:         aws.String(name),
+		})
+	}
+
+	return out, nil
+}
+
+func (s *Server) s3DeleteBucket(in *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bucket.objects) > 0 {
+		e := newError("BucketNotEmpty", "The bucket you tried to delete is not empty")
+		e.StatusCode = http.StatusConflict
+
+		return nil, e
+	}
+
+	delete(s.s3.buckets, bucket.name)
+
+	return &s3.DeleteBucketOutput{}, nil
+}
+
+func (s *Server) s3GetBucketLocation(in *s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := &s3.GetBucketLocationOutput{}
+
+	// The location of buckets in us-east-1 is empty.
+	if bucket.region != "us-east-1" {
+		out.LocationConstraint = aws.String(bucket.region)
+	}
+
+	return out, nil
+}
+
+func (s *Server) s3GetBucketAcl(in *s3.GetBucketAclInput) (*s3.GetBucketAclOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	owner := s.s3Owner()
+	out := &s3.GetBucketAclOutput{
+		Grants: []*s3.Grant{
+			{
+				Grantee: &s3.Grantee{
+					DisplayName: owner.DisplayName,
+					ID:          owner.ID,
+					Type:        aws.String(s3.TypeCanonicalUser),
+				},
+				Permission: aws.String(s3.PermissionFullControl),
+			},
+		},
+		Owner: owner,
+	}
+
+	allUsers := &s3.Grantee{Type: aws.String(s3.TypeGroup), URI: aws.String("http://acs.amazonaws.com/groups/global/AllUsers")}
+
+	switch bucket.acl {
+	case s3.BucketCannedACLPublicRead:
+		out.Grants = append(out.Grants, &s3.Grant{Grantee: allUsers, Permission: aws.String(s3.PermissionRead)})
+	case s3.BucketCannedACLPublicReadWrite:
+		out.Grants = append(out.Grants,
+			&s3.Grant{Grantee: allUsers, Permission: aws.String(s3.PermissionRead)},
+			&s3.Grant{Grantee: allUsers, Permission: aws.String(s3.PermissionWrite)},
+		)
+	}
+
+	return out, nil
+}
+
+func (s *Server) s3PutBucketAcl(in *s3.PutBucketAclInput) (*s3.PutBucketAclOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if in.ACL != nil {
+		bucket.acl = aws.StringValue(in.ACL)
+	}
+
+	return &s3.PutBucketAclOutput{}, nil
+}
+
+func (s *Server) s3GetBucketPolicy(in *s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.policy == nil {
+		return nil, newNotFoundError("NoSuchBucketPolicy", "The bucket policy does not exist")
+	}
+
+	return &s3.GetBucketPolicyOutput{Policy: bucket.policy}, nil
+}
+
+func (s *Server) s3PutBucketPolicy(in *s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.policy = in.Policy
+
+	return &s3.PutBucketPolicyOutput{}, nil
+}
+
+func (s *Server) s3DeleteBucketPolicy(in *s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.policy = nil
+
+	return &s3.DeleteBucketPolicyOutput{}, nil
+}
+
+func (s *Server) s3GetBucketCors(in *s3.GetBucketCorsInput) (*s3.GetBucketCorsOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.cors == nil {
+		return nil, newNotFoundError("NoSuchCORSConfiguration", "The CORS configuration does not exist")
+	}
+
+	return &s3.GetBucketCorsOutput{CORSRules: bucket.cors}, nil
+}
+
+func (s *Server) s3PutBucketCors(in *s3.PutBucketCorsInput) (*s3.PutBucketCorsOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if in.CORSConfiguration == nil || len(in.CORSConfiguration.CORSRules) == 0 {
+		return nil, newError("MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+	}
+
+	bucket.cors = in.CORSConfiguration.CORSRules
+
+	return &s3.PutBucketCorsOutput{}, nil
+}
+
+func (s *Server) s3DeleteBucketCors(in *s3.DeleteBucketCorsInput) (*s3.DeleteBucketCorsOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.cors = nil
+
+	return &s3.DeleteBucketCorsOutput{}, nil
+}
+
+func (s *Server) s3GetBucketWebsite(in *s3.GetBucketWebsiteInput) (*s3.GetBucketWebsiteOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.website == nil {
+		return nil, newNotFoundError("NoSuchWebsiteConfiguration", "The specified bucket does not have a website configuration")
+	}
+
+	return &s3.GetBucketWebsiteOutput{
+		ErrorDocument:         bucket.website.ErrorDocument,
+		IndexDocument:         bucket.website.IndexDocument,
+		RedirectAllRequestsTo: bucket.website.RedirectAllRequestsTo,
+		RoutingRules:          bucket.website.RoutingRules,
+	}, nil
+}
+
+func (s *Server) s3PutBucketWebsite(in *s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if in.WebsiteConfiguration == nil {
+		return nil, newError("MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+	}
+
+	bucket.website = in.WebsiteConfiguration
+
+	return &s3.PutBucketWebsiteOutput{}, nil
+}
+
+func (s *Server) s3DeleteBucketWebsite(in *s3.DeleteBucketWebsiteInput) (*s3.DeleteBucketWebsiteOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.website = nil
+
+	return &s3.DeleteBucketWebsiteOutput{}, nil
+}
+
+func (s *Server) s3GetBucketVersioning(in *s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := &s3.GetBucketVersioningOutput{}
+
+	if bucket.versioning != nil {
+		out.MFADelete = bucket.versioning.MFADelete
+		out.Status = bucket.versioning.Status
+	}
+
+	return out, nil
+}
+
+func (s *Server) s3PutBucketVersioning(in *s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.objectLock != nil && aws.StringValue(in.VersioningConfiguration.Status) != s3.BucketVersioningStatusEnabled {
+		return nil, newError("InvalidBucketState", "An Object Lock configuration is present on this bucket, so the versioning state cannot be changed.")
+	}
+
+	bucket.versioning = in.VersioningConfiguration
+
+	return &s3.PutBucketVersioningOutput{}, nil
+}
+
+// versioningEnabled returns whether versioning is enabled on a bucket.
+func (b *s3Bucket) versioningEnabled() bool {
+	return b.versioning != nil && aws.StringValue(b.versioning.Status) == s3.BucketVersioningStatusEnabled
+}
+
+func (s *Server) s3GetBucketAccelerateConfiguration(in *s3.GetBucketAccelerateConfigurationInput) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetBucketAccelerateConfigurationOutput{Status: bucket.accelerate}, nil
+}
+
+func (s *Server) s3PutBucketAccelerateConfiguration(in *s3.PutBucketAccelerateConfigurationInput) (*s3.PutBucketAccelerateConfigurationOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.Contains(bucket.name, ".") {
+		return nil, newError("InvalidRequest", "S3 Transfer Acceleration is not supported for buckets with periods (.) in their names")
+	}
+
+	bucket.accelerate = in.AccelerateConfiguration.Status
+
+	return &s3.PutBucketAccelerateConfigurationOutput{}, nil
+}
+
+func (s *Server) s3GetBucketRequestPayment(in *s3.GetBucketRequestPaymentInput) (*s3.GetBucketRequestPaymentOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetBucketRequestPaymentOutput{Payer: aws.String(bucket.requestPayer)}, nil
+}
+
+func (s *Server) s3PutBucketRequestPayment(in *s3.PutBucketRequestPaymentInput) (*s3.PutBucketRequestPaymentOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.requestPayer = aws.StringValue(in.RequestPaymentConfiguration.Payer)
+
+	return &s3.PutBucketRequestPaymentOutput{}, nil
+}
+
+func (s *Server) s3GetBucketLogging(in *s3.GetBucketLoggingInput) (*s3.GetBucketLoggingOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetBucketLoggingOutput{LoggingEnabled: bucket.logging}, nil
+}
+
+func (s *Server) s3PutBucketLogging(in *s3.PutBucketLoggingInput) (*s3.PutBucketLoggingOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if logging := in.BucketLoggingStatus.LoggingEnabled; logging != nil {
+		if _, err := s.s3Bucket(logging.TargetBucket); err != nil {
+			return nil, newError("InvalidTargetBucketForLogging", "The target bucket for logging does not exist")
+		}
+	}
+
+	bucket.logging = in.BucketLoggingStatus.LoggingEnabled
+
+	return &s3.PutBucketLoggingOutput{}, nil
+}
+
+func (s *Server) s3GetBucketLifecycleConfiguration(in *s3.GetBucketLifecycleConfigurationInput) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.lifecycle == nil {
+		return nil, newNotFoundError("NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist")
+	}
+
+	return &s3.GetBucketLifecycleConfigurationOutput{Rules: bucket.lifecycle}, nil
+}
+
+func (s *Server) s3PutBucketLifecycleConfiguration(in *s3.PutBucketLifecycleConfigurationInput) (*s3.PutBucketLifecycleConfigurationOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if in.LifecycleConfiguration == nil || len(in.LifecycleConfiguration.Rules) == 0 {
+		return nil, newError("MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+	}
+
+	bucket.lifecycle = in.LifecycleConfiguration.Rules
+
+	return &s3.PutBucketLifecycleConfigurationOutput{}, nil
+}
+
+func (s *Server) s3DeleteBucketLifecycle(in *s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.lifecycle = nil
+
+	return &s3.DeleteBucketLifecycleOutput{}, nil
+}
+
+func (s *Server) s3GetBucketReplication(in *s3.GetBucketReplicationInput) (*s3.GetBucketReplicationOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.replication == nil {
+		return nil, newNotFoundError("ReplicationConfigurationNotFoundError", "The replication configuration was not found")
+	}
+
+	return &s3.GetBucketReplicationOutput{ReplicationConfiguration: bucket.replication}, nil
+}
+
+func (s *Server) s3PutBucketReplication(in *s3.PutBucketReplicationInput) (*s3.PutBucketReplicationOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !bucket.versioningEnabled() {
+		return nil, newError("InvalidRequest", "Versioning must be 'Enabled' on the bucket to apply a replication configuration")
+	}
+
+	for _, rule := range in.ReplicationConfiguration.Rules {
+		destination, err := s.s3Bucket(aws.String(strings.TrimPrefix(aws.StringValue(rule.Destination.Bucket), "arn:aws:s3:::")))
+
+		if err != nil {
+			return nil, newError("InvalidRequest", "Destination bucket must exist.")
+		}
+
+		if !destination.versioningEnabled() {
+			return nil, newError("InvalidRequest", "Destination bucket must have versioning enabled.")
+		}
+	}
+
+	bucket.replication = in.ReplicationConfiguration
+
+	return &s3.PutBucketReplicationOutput{}, nil
+}
+
+func (s *Server) s3DeleteBucketReplication(in *s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.replication = nil
+
+	return &s3.DeleteBucketReplicationOutput{}, nil
+}
+
+func (s *Server) s3GetBucketEncryption(in *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.encryption == nil {
+		return nil, newNotFoundError("ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found")
+	}
+
+	return &s3.GetBucketEncryptionOutput{ServerSideEncryptionConfiguration: bucket.encryption}, nil
+}
+
+func (s *Server) s3PutBucketEncryption(in *s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.encryption = in.ServerSideEncryptionConfiguration
+
+	return &s3.PutBucketEncryptionOutput{}, nil
+}
+
+func (s *Server) s3DeleteBucketEncryption(in *s3.DeleteBucketEncryptionInput) (*s3.DeleteBucketEncryptionOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.encryption = nil
+
+	return &s3.DeleteBucketEncryptionOutput{}, nil
+}
+
+func (s *Server) s3GetObjectLockConfiguration(in *s3.GetObjectLockConfigurationInput) (*s3.GetObjectLockConfigurationOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.objectLock == nil {
+		return nil, newNotFoundError("ObjectLockConfigurationNotFoundError", "Object Lock configuration does not exist for this bucket")
+	}
+
+	return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: bucket.objectLock}, nil
+}
+
+func (s *Server) s3PutObjectLockConfiguration(in *s3.PutObjectLockConfigurationInput) (*s3.PutObjectLockConfigurationOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.objectLock == nil {
+		return nil, newError("InvalidBucketState", "Object Lock configuration cannot be enabled on existing buckets")
+	}
+
+	bucket.objectLock = in.ObjectLockConfiguration
+
+	return &s3.PutObjectLockConfigurationOutput{}, nil
+}
+
+func (s *Server) s3GetBucketTagging(in *s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bucket.tags) == 0 {
+		return nil, newNotFoundError("NoSuchTagSet", "The TagSet does not exist")
+	}
+
+	return &s3.GetBucketTaggingOutput{TagSet: bucket.tags}, nil
+}
+
+func (s *Server) s3PutBucketTagging(in *s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.tags = in.Tagging.TagSet
+
+	return &s3.PutBucketTaggingOutput{}, nil
+}
+
+func (s *Server) s3DeleteBucketTagging(in *s3.DeleteBucketTaggingInput) (*s3.DeleteBucketTaggingOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.tags = nil
+
+	return &s3.DeleteBucketTaggingOutput{}, nil
+}
+
+// object returns the latest version of an object, or a version by ID.
+func (b *s3Bucket) object(key, versionID *string) (*s3Object, error) {
+	versions := b.objects[aws.StringValue(key)]
+
+	if len(versions) == 0 {
+		return nil, newNotFoundError(s3.ErrCodeNoSuchKey, "The specified key does not exist.")
+	}
+
+	if versionID == nil {
+		if object := versions[len(versions)-1]; !object.deleteMarker {
+			return object, nil
+		}
+
+		return nil, newNotFoundError(s3.ErrCodeNoSuchKey, "The specified key does not exist.")
+	}
+
+	for _, object := range versions {
+		if object.versionID == aws.StringValue(versionID) {
+			return object, nil
+		}
+	}
+
+	return nil, newNotFoundError("NoSuchVersion", "The specified version does not exist.")
+}
+
+// s3PutObjectVersion adds a version of an object. Unless versioning is enabled, the
+// null version of the object is replaced.
+func (s *Server) s3PutObjectVersion(bucket *s3Bucket, key string, object *s3Object) {
+	object.lastModified = time.Now().UTC().Truncate(time.Second)
+	object.versionID = "null"
+
+	if bucket.versioningEnabled() {
+		object.versionID = strings.Replace(s.requestID(), "-", "", -1)
+	} else {
+		s3RemoveObjectVersion(bucket, key, "null")
+	}
+
+	bucket.objects[key] = append(bucket.objects[key], object)
+}
+
+// s3RemoveObjectVersion removes a version of an object.
+func s3RemoveObjectVersion(bucket *s3Bucket, key, versionID string) bool {
+	versions := bucket.objects[key]
+
+	for i, version := range versions {
+		if version.versionID == versionID {
+			versions = append(versions[:i], versions[i+1:]...)
+
+			if len(versions) == 0 {
+				delete(bucket.objects, key)
+			} else {
+				bucket.objects[key] = versions
+			}
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// s3DeleteObjectVersion deletes an object, or a version of it, returning
+// the version ID and whether it is a delete marker.
+func (s *Server) s3DeleteObjectVersion(bucket *s3Bucket, key string, versionID *string) (string, bool) {
+	if versionID != nil {
+		for _, version := range bucket.objects[key] {
+			if version.versionID == aws.StringValue(versionID) {
+				s3RemoveObjectVersion(bucket, key, version.versionID)
+
+				return version.versionID, version.deleteMarker
+			}
+		}
+
+		return aws.StringValue(versionID), false
+	}
+
+	if bucket.versioning == nil {
+		s3RemoveObjectVersion(bucket, key, "null")
+
+		return "", false
+	}
+
+	marker := &s3Object{deleteMarker: true}
+	s.s3PutObjectVersion(bucket, key, marker)
+
+	return marker.versionID, true
+}
+
+// s3ParseTagging parses the URL encoded tags of a PutObject request.
+func s3ParseTagging(tagging *string) ([]*s3.Tag, error) {
+	values, err := url.ParseQuery(aws.StringValue(tagging))
+
+	if err != nil {
+		return nil, newError("InvalidArgument", "The header 'x-amz-tagging' shall be encoded as UTF-8 then URLEncoded URL query parameters without tag name duplicates.")
+	}
+
+	var keys []string
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var tags []*s3.Tag
+
+	for _, key := range keys {
+		tags = append(tags, &s3.Tag{Key: aws.String(key), Value: aws.String(values.Get(key))})
+	}
+
+	return tags, nil
+}
+
+func (s *Server) s3PutObject(in *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+
+	if in.Body != nil {
+		body, _ = ioutil.ReadAll(in.Body)
+		in.Body = nil
+	}
+
+	tags, err := s3ParseTagging(in.Tagging)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sum := md5.Sum(body)
+	object := &s3Object{
+		body:  body,
+		etag:  `"` + hex.EncodeToString(sum[:]) + `"`,
+		input: in,
+		tags:  tags,
+	}
+
+	if in.ServerSideEncryption == nil && bucket.encryption != nil && len(bucket.encryption.Rules) > 0 {
+		if rule := bucket.encryption.Rules[0].ApplyServerSideEncryptionByDefault; rule != nil {
+			in.ServerSideEncryption = rule.SSEAlgorithm
+			in.SSEKMSKeyId = rule.KMSMasterKeyID
+		}
+	}
+
+	s.s3PutObjectVersion(bucket, aws.StringValue(in.Key), object)
+
+	out := &s3.PutObjectOutput{
+		ETag:                 aws.String(object.etag),
+		SSEKMSKeyId:          in.SSEKMSKeyId,
+		ServerSideEncryption: in.ServerSideEncryption,
+	}
+
+	if bucket.versioning != nil {
+		out.VersionId = aws.String(object.versionID)
+	}
+
+	return out, nil
+}
+
+// s3HeadObjectOutput returns the metadata of an object.
+func (s *Server) s3HeadObjectOutput(bucket *s3Bucket, object *s3Object) *s3.HeadObjectOutput {
+	in := object.input
+
+	contentType := in.ContentType
+	if contentType == nil {
+		contentType = aws.String("binary/octet-stream")
+	}
+
+	storageClass := in.StorageClass
+	if aws.StringValue(storageClass) == s3.StorageClassStandard {
+		storageClass = nil
+	}
+
+	out := &s3.HeadObjectOutput{
+		CacheControl:              in.CacheControl,
+		ContentDisposition:        in.ContentDisposition,
+		ContentEncoding:           in.ContentEncoding,
+		ContentLanguage:           in.ContentLanguage,
+		ContentLength:             aws.Int64(int64(len(object.body))),
+		ContentType:               contentType,
+		ETag:                      aws.String(object.etag),
+		LastModified:              aws.Time(object.lastModified),
+		Metadata:                  in.Metadata,
+		ObjectLockLegalHoldStatus: in.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            in.ObjectLockMode,
+		ObjectLockRetainUntilDate: in.ObjectLockRetainUntilDate,
+		SSEKMSKeyId:               in.SSEKMSKeyId,
+		ServerSideEncryption:      in.ServerSideEncryption,
+		StorageClass:              storageClass,
+		WebsiteRedirectLocation:   in.WebsiteRedirectLocation,
+	}
+
+	if bucket.versioning != nil {
+		out.VersionId = aws.String(object.versionID)
+	}
+
+	return out
+}
+
+func (s *Server) s3HeadObject(in *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := bucket.object(in.Key, in.VersionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.s3HeadObjectOutput(bucket, object), nil
+}
+
+func (s *Server) s3GetObject(in *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := bucket.object(in.Key, in.VersionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	head := s.s3HeadObjectOutput(bucket, object)
+
+	return &s3.GetObjectOutput{
+		Body:                      ioutil.NopCloser(bytes.NewReader(object.body)),
+		CacheControl:              head.CacheControl,
+		ContentDisposition:        head.ContentDisposition,
+		ContentEncoding:           head.ContentEncoding,
+		ContentLanguage:           head.ContentLanguage,
+		ContentLength:             head.ContentLength,
+		ContentType:               head.ContentType,
+		ETag:                      head.ETag,
+		LastModified:              head.LastModified,
+		Metadata:                  head.Metadata,
+		ObjectLockLegalHoldStatus: head.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            head.ObjectLockMode,
+		ObjectLockRetainUntilDate: head.ObjectLockRetainUntilDate,
+		SSEKMSKeyId:               head.SSEKMSKeyId,
+		ServerSideEncryption:      head.ServerSideEncryption,
+		StorageClass:              head.StorageClass,
+		TagCount:                  aws.Int64(int64(len(object.tags))),
+		VersionId:                 head.VersionId,
+		WebsiteRedirectLocation:   head.WebsiteRedirectLocation,
+	}, nil
+}
+
+func (s *Server) s3DeleteObject(in *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	versionID, deleteMarker := s.s3DeleteObjectVersion(bucket, aws.StringValue(in.Key), in.VersionId)
+
+	out := &s3.DeleteObjectOutput{}
+
+	if versionID != "" {
+		out.VersionId = aws.String(versionID)
+	}
+
+	if deleteMarker {
+		out.DeleteMarker = aws.Bool(true)
+	}
+
+	return out, nil
+}
+
+func (s *Server) s3DeleteObjects(in *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := &s3.DeleteObjectsOutput{}
+
+	for _, identifier := range in.Delete.Objects {
+		versionID, deleteMarker := s.s3DeleteObjectVersion(bucket, aws.StringValue(identifier.Key), identifier.VersionId)
+
+		if aws.BoolValue(in.Delete.Quiet) {
+			continue
+		}
+
+		deleted := &s3.DeletedObject{Key: identifier.Key, VersionId: identifier.VersionId}
+
+		if deleteMarker {
+			deleted.DeleteMarker = aws.Bool(true)
+			deleted.DeleteMarkerVersionId = aws.String(versionID)
+		}
+
+		out.Deleted = append(out.Deleted, deleted)
+	}
+
+	return out, nil
+}
+
+// sortedKeys returns the sorted keys of the objects of a bucket with a
+// prefix.
+func (b *s3Bucket) sortedKeys(prefix *string) []string {
+	var keys []string
+
+	for key := range b.objects {
+		if strings.HasPrefix(key, aws.StringValue(prefix)) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (s *Server) s3ListObjects(in *s3.ListObjectsInput) (*s3.ListObjectsOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := &s3.ListObjectsOutput{
+		IsTruncated: aws.Bool(false),
+		Marker:      aws.String(aws.StringValue(in.Marker)),
+		MaxKeys:     aws.Int64(1000),
+		Name:        in.Bucket,
+		Prefix:      aws.String(aws.StringValue(in.Prefix)),
+	}
+
+	for _, key := range bucket.sortedKeys(in.Prefix) {
+		object, err := bucket.object(aws.String(key), nil)
+
+		if err != nil {
+			continue
+		}
+
+		out.Contents = append(out.Contents, &s3.Object{
+			ETag:         aws.String(object.etag),
+			Key:          aws.String(key),
+			LastModified: aws.Time(object.lastModified),
+			Owner:        s.s3Owner(),
+			Size:         aws.Int64(int64(len(object.body))),
+			StorageClass: aws.String(s3.ObjectStorageClassStandard),
+		})
+	}
+
+	return out, nil
+}
+
+func (s *Server) s3ListObjectVersions(in *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := &s3.ListObjectVersionsOutput{
+		IsTruncated: aws.Bool(false),
+		MaxKeys:     aws.Int64(1000),
+		Name:        in.Bucket,
+		Prefix:      aws.String(aws.StringValue(in.Prefix)),
+	}
+
+	for _, key := range bucket.sortedKeys(in.Prefix) {
+		versions := bucket.objects[key]
+
+		for i := len(versions) - 1; i >= 0; i-- {
+			version := versions[i]
+			isLatest := aws.Bool(i == len(versions)-1)
+
+			if version.deleteMarker {
+				out.DeleteMarkers = append(out.DeleteMarkers, &s3.DeleteMarkerEntry{
+					IsLatest:     isLatest,
+					Key:          aws.String(key),
+					LastModified: aws.Time(version.lastModified),
+					Owner:        s.s3Owner(),
+					VersionId:    aws.String(version.versionID),
+				})
+
+				continue
+			}
+
+			out.Versions = append(out.Versions, &s3.ObjectVersion{
+				ETag:         aws.String(version.etag),
+				IsLatest:     isLatest,
+				Key:          aws.String(key),
+				LastModified: aws.Time(version.lastModified),
+				Owner:        s.s3Owner(),
+				Size:         aws.Int64(int64(len(version.body))),
+				StorageClass: aws.String(s3.ObjectVersionStorageClassStandard),
+				VersionId:    aws.String(version.versionID),
+			})
+		}
+	}
+
+	return out, nil
+}
+
+func (s *Server) s3GetObjectTagging(in *s3.GetObjectTaggingInput) (*s3.GetObjectTaggingOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := bucket.object(in.Key, in.VersionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetObjectTaggingOutput{TagSet: append([]*s3.Tag{}, object.tags...), VersionId: in.VersionId}, nil
+}
+
+func (s *Server) s3PutObjectTagging(in *s3.PutObjectTaggingInput) (*s3.PutObjectTaggingOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := bucket.object(in.Key, in.VersionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object.tags = in.Tagging.TagSet
+
+	return &s3.PutObjectTaggingOutput{VersionId: in.VersionId}, nil
+}
+
+func (s *Server) s3DeleteObjectTagging(in *s3.DeleteObjectTaggingInput) (*s3.DeleteObjectTaggingOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := bucket.object(in.Key, in.VersionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object.tags = nil
+
+	return &s3.DeleteObjectTaggingOutput{VersionId: in.VersionId}, nil
+}
+
+// The object lock settings of an object are kept on the input of the
+// PutObject request that created it, where HeadObject reads them from.
+
+func (s *Server) s3PutObjectLegalHold(in *s3.PutObjectLegalHoldInput) (*s3.PutObjectLegalHoldOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := bucket.object(in.Key, in.VersionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object.input.ObjectLockLegalHoldStatus = nil
+	if in.LegalHold != nil {
+		object.input.ObjectLockLegalHoldStatus = in.LegalHold.Status
+	}
+
+	return &s3.PutObjectLegalHoldOutput{}, nil
+}
+
+func (s *Server) s3PutObjectRetention(in *s3.PutObjectRetentionInput) (*s3.PutObjectRetentionOutput, error) {
+	bucket, err := s.s3Bucket(in.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := bucket.object(in.Key, in.VersionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object.input.ObjectLockMode = nil
+	object.input.ObjectLockRetainUntilDate = nil
+	if in.Retention != nil {
+		object.input.ObjectLockMode = in.Retention.Mode
+		object.input.ObjectLockRetainUntilDate = in.Retention.RetainUntilDate
+	}
+
+	return &s3.PutObjectRetentionOutput{}, nil
+}
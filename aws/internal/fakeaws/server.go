@@ -0,0 +1,195 @@
+// Package fakeaws implements an in-process stand-in for a core set of AWS
+// APIs, so acceptance tests of the resources built on them can run without
+// network access or AWS credentials.
+//
+// A Server serves the EC2 (VPCs, subnets and security groups), S3 (buckets),
+// IAM (roles and policies), STS, SQS, SNS, DynamoDB and KMS (keys) APIs from
+// an in-memory state model. The provider is pointed at it via the endpoints
+// returned by Server.Endpoints. Requests are routed to a service by the
+// credential scope of their Signature Version 4 Authorization header, so all
+// services share a single listener. The state is not partitioned by region,
+// the region of the credential scope is only used for the ARNs and locations
+// of new resources.
+package fakeaws
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultAccountID is the AWS account ID of a Server.
+const DefaultAccountID = "123456789012"
+
+// Services are the provider endpoints served by a Server.
+var Services = []string{
+	"dynamodb",
+	"ec2",
+	"iam",
+	"kms",
+	"s3",
+	"sns",
+	"sqs",
+	"sts",
+}
+
+// Server is an in-process fake AWS API server.
+type Server struct {
+	*httptest.Server
+
+	AccountID string
+
+	// The service states are guarded by the Server mutex, each API call is
+	// handled as a single transaction.
+	mu       sync.Mutex
+	region   string
+	dynamodb *dynamodbState
+	ec2      *ec2State
+	iam      *iamState
+	kms      *kmsState
+	s3       *s3State
+	sns      *snsState
+	sqs      *sqsState
+
+	handlers map[string]http.HandlerFunc
+	ids      uint64
+}
+
+// NewServer starts a Server with an empty state.
+func NewServer() *Server {
+	s := &Server{
+		AccountID: DefaultAccountID,
+		dynamodb:  newDynamodbState(),
+		ec2:       newEc2State(),
+		iam:       newIamState(),
+		kms:       newKmsState(),
+		s3:        newS3State(),
+		sns:       newSnsState(),
+		sqs:       newSqsState(),
+	}
+
+	s.handlers = map[string]http.HandlerFunc{
+		"dynamodb": s.serveDynamodb,
+		"ec2":      s.serveEc2,
+		"iam":      s.serveIam,
+		"kms":      s.serveKms,
+		"s3":       s.serveS3,
+		"sns":      s.serveSns,
+		"sqs":      s.serveSqs,
+		"sts":      s.serveSts,
+	}
+
+	s.Server = httptest.NewServer(s)
+
+	return s
+}
+
+// Endpoints returns the provider endpoints configuration for the services
+// served by the Server.
+func (s *Server) Endpoints() map[string]string {
+	endpoints := make(map[string]string, len(Services))
+
+	for _, service := range Services {
+		endpoints[service] = s.URL
+	}
+
+	return endpoints
+}
+
+// credentialScopeRegexp matches the signing region and service of a
+// Signature Version 4 Authorization header.
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/[0-9]{8}/([^/]+)/([^/]+)/aws4_request`)
+
+// ServeHTTP routes a request to the service it is signed for.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m := credentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization"))
+
+	if m == nil {
+		http.Error(w, "missing Signature Version 4 Authorization header", http.StatusForbidden)
+		return
+	}
+
+	handler, ok := s.handlers[m[2]]
+
+	if !ok {
+		http.Error(w, fmt.Sprintf("unsupported service: %s", m[2]), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.region = m[1]
+
+	handler(w, r)
+}
+
+// newID returns a unique identifier, e.g. for "vpc" vpc-0000000000000001.
+func (s *Server) newID(prefix string) string {
+	return fmt.Sprintf("%s-%016x", prefix, atomic.AddUint64(&s.ids, 1))
+}
+
+// requestID returns a new request ID.
+func (s *Server) requestID() string {
+	id := atomic.AddUint64(&s.ids, 1)
+
+	return fmt.Sprintf("%08x-0000-4000-8000-%012x", id, id)
+}
+
+// arn returns the ARN of a resource of the Server account.
+func (s *Server) arn(service, region, resource string) string {
+	return fmt.Sprintf("arn:aws:%s:%s:%s:%s", service, region, s.AccountID, resource)
+}
+
+// Error is an AWS API error returned by a fake service.
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// newError returns an Error with HTTP status 400.
+func newError(code, format string, a ...interface{}) *Error {
+	return &Error{
+		StatusCode: http.StatusBadRequest,
+		Code:       code,
+		Message:    fmt.Sprintf(format, a...),
+	}
+}
+
+// newNotFoundError returns an Error with HTTP status 404.
+func newNotFoundError(code, format string, a ...interface{}) *Error {
+	return &Error{
+		StatusCode: http.StatusNotFound,
+		Code:       code,
+		Message:    fmt.Sprintf(format, a...),
+	}
+}
+
+// errorOf converts a handler error into an Error.
+func errorOf(err error) *Error {
+	if e, ok := err.(*Error); ok {
+		return e
+	}
+
+	return &Error{
+		StatusCode: http.StatusInternalServerError,
+		Code:       "InternalFailure",
+		Message:    err.Error(),
+	}
+}
+
+// notImplemented returns the error for API operations without a handler.
+func notImplemented(service, operation string) *Error {
+	log.Printf("[WARN] fakeaws: %s %s is not implemented", service, operation)
+
+	return newError("NotImplemented", "%s %s is not implemented by the fake AWS server", service, operation)
+}
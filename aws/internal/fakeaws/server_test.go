@@ -0,0 +1,300 @@
+package fakeaws
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func testSession(t *testing.T, s *Server) *session.Session {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("fakeaws", "fakeaws", ""),
+		Endpoint:         aws.String(s.URL),
+		Region:           aws.String("us-west-2"),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return sess
+}
+
+func testErrorCode(t *testing.T, err error, code string) {
+	if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != code {
+		t.Fatalf("expected error code %s, got: %v", code, err)
+	}
+}
+
+func TestServerEc2(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	conn := ec2.New(testSession(t, s))
+
+	vpc, err := conn.CreateVpc(&ec2.CreateVpcInput{CidrBlock: aws.String("10.1.0.0/16")})
+	if err != nil {
+		t.Fatalf("error creating VPC: %s", err)
+	}
+
+	vpcID := vpc.Vpc.VpcId
+
+	if _, err := conn.CreateTags(&ec2.CreateTagsInput{
+		Resources: []*string{vpcID},
+		Tags:      []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+	}); err != nil {
+		t.Fatalf("error tagging VPC: %s", err)
+	}
+
+	vpcs, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{
+		Filters: []*ec2.Filter{{Name: aws.String("tag:Name"), Values: []*string{aws.String("te*")}}},
+	})
+	if err != nil {
+		t.Fatalf("error describing VPCs: %s", err)
+	}
+
+	if len(vpcs.Vpcs) != 1 || aws.StringValue(vpcs.Vpcs[0].VpcId) != aws.StringValue(vpcID) {
+		t.Fatalf("expected VPC %s, got: %s", aws.StringValue(vpcID), vpcs)
+	}
+
+	groups, err := conn.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("vpc-id"), Values: []*string{vpcID}},
+			{Name: aws.String("group-name"), Values: []*string{aws.String("default")}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error describing security groups: %s", err)
+	}
+
+	if len(groups.SecurityGroups) != 1 {
+		t.Fatalf("expected the default security group, got: %s", groups)
+	}
+
+	subnet, err := conn.CreateSubnet(&ec2.CreateSubnetInput{CidrBlock: aws.String("10.1.1.0/24"), VpcId: vpcID})
+	if err != nil {
+		t.Fatalf("error creating subnet: %s", err)
+	}
+
+	_, err = conn.DeleteVpc(&ec2.DeleteVpcInput{VpcId: vpcID})
+	testErrorCode(t, err, "DependencyViolation")
+
+	if _, err := conn.DeleteSubnet(&ec2.DeleteSubnetInput{SubnetId: subnet.Subnet.SubnetId}); err != nil {
+		t.Fatalf("error deleting subnet: %s", err)
+	}
+
+	if _, err := conn.DeleteVpc(&ec2.DeleteVpcInput{VpcId: vpcID}); err != nil {
+		t.Fatalf("error deleting VPC: %s", err)
+	}
+
+	_, err = conn.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: []*string{vpcID}})
+	testErrorCode(t, err, "InvalidVpcID.NotFound")
+}
+
+func TestServerS3(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	conn := s3.New(testSession(t, s))
+
+	if _, err := conn.CreateBucket(&s3.CreateBucketInput{
+		Bucket: aws.String("test-bucket"),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String("us-west-2"),
+		},
+	}); err != nil {
+		t.Fatalf("error creating bucket: %s", err)
+	}
+
+	location, err := conn.GetBucketLocation(&s3.GetBucketLocationInput{Bucket: aws.String("test-bucket")})
+	if err != nil {
+		t.Fatalf("error getting bucket location: %s", err)
+	}
+
+	if got := aws.StringValue(location.LocationConstraint); got != "us-west-2" {
+		t.Fatalf("expected location us-west-2, got: %s", got)
+	}
+
+	_, err = conn.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: aws.String("test-bucket")})
+	testErrorCode(t, err, "NoSuchBucketPolicy")
+
+	if _, err := conn.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket:                  aws.String("test-bucket"),
+		VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(s3.BucketVersioningStatusEnabled)},
+	}); err != nil {
+		t.Fatalf("error enabling versioning: %s", err)
+	}
+
+	if _, err := conn.PutObject(&s3.PutObjectInput{
+		Body:   strings.NewReader("test"),
+		Bucket: aws.String("test-bucket"),
+		Key:    aws.String("test-key"),
+	}); err != nil {
+		t.Fatalf("error putting object: %s", err)
+	}
+
+	if _, err := conn.DeleteObject(&s3.DeleteObjectInput{Bucket: aws.String("test-bucket"), Key: aws.String("test-key")}); err != nil {
+		t.Fatalf("error deleting object: %s", err)
+	}
+
+	versions, err := conn.ListObjectVersions(&s3.ListObjectVersionsInput{Bucket: aws.String("test-bucket")})
+	if err != nil {
+		t.Fatalf("error listing object versions: %s", err)
+	}
+
+	if len(versions.Versions) != 1 || len(versions.DeleteMarkers) != 1 {
+		t.Fatalf("expected a version and a delete marker, got: %s", versions)
+	}
+
+	_, err = conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("test-bucket")})
+	testErrorCode(t, err, "BucketNotEmpty")
+
+	_, err = conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("missing-bucket")})
+	testErrorCode(t, err, "NotFound")
+}
+
+func TestServerIam(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	conn := iam.New(testSession(t, s))
+
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	role, err := conn.CreateRole(&iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(policy),
+		RoleName:                 aws.String("test-role"),
+	})
+	if err != nil {
+		t.Fatalf("error creating role: %s", err)
+	}
+
+	if expected, got := "arn:aws:iam::"+DefaultAccountID+":role/test-role", aws.StringValue(role.Role.Arn); got != expected {
+		t.Fatalf("expected ARN %s, got: %s", expected, got)
+	}
+
+	_, err = conn.CreateRole(&iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(policy),
+		RoleName:                 aws.String("test-role"),
+	})
+	testErrorCode(t, err, iam.ErrCodeEntityAlreadyExistsException)
+
+	if _, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
+		PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+		RoleName:  aws.String("test-role"),
+	}); err != nil {
+		t.Fatalf("error attaching policy: %s", err)
+	}
+
+	_, err = conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String("test-role")})
+	testErrorCode(t, err, iam.ErrCodeDeleteConflictException)
+
+	identity, err := sts.New(testSession(t, s)).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatalf("error getting caller identity: %s", err)
+	}
+
+	if got := aws.StringValue(identity.Account); got != DefaultAccountID {
+		t.Fatalf("expected account %s, got: %s", DefaultAccountID, got)
+	}
+}
+
+func TestServerMessaging(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	sess := testSession(t, s)
+
+	queue, err := sqs.New(sess).CreateQueue(&sqs.CreateQueueInput{
+		Attributes: map[string]*string{sqs.QueueAttributeNameDelaySeconds: aws.String("10")},
+		QueueName:  aws.String("test-queue"),
+	})
+	if err != nil {
+		t.Fatalf("error creating queue: %s", err)
+	}
+
+	attributes, err := sqs.New(sess).GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: []*string{aws.String(sqs.QueueAttributeNameAll)},
+		QueueUrl:       queue.QueueUrl,
+	})
+	if err != nil {
+		t.Fatalf("error getting queue attributes: %s", err)
+	}
+
+	if got := aws.StringValue(attributes.Attributes[sqs.QueueAttributeNameDelaySeconds]); got != "10" {
+		t.Fatalf("expected DelaySeconds 10, got: %s", got)
+	}
+
+	topic, err := sns.New(sess).CreateTopic(&sns.CreateTopicInput{Name: aws.String("test-topic")})
+	if err != nil {
+		t.Fatalf("error creating topic: %s", err)
+	}
+
+	if _, err := sns.New(sess).Subscribe(&sns.SubscribeInput{
+		Endpoint: attributes.Attributes[sqs.QueueAttributeNameQueueArn],
+		Protocol: aws.String("sqs"),
+		TopicArn: topic.TopicArn,
+	}); err != nil {
+		t.Fatalf("error subscribing: %s", err)
+	}
+
+	subscriptions, err := sns.New(sess).ListSubscriptionsByTopic(&sns.ListSubscriptionsByTopicInput{TopicArn: topic.TopicArn})
+	if err != nil {
+		t.Fatalf("error listing subscriptions: %s", err)
+	}
+
+	if len(subscriptions.Subscriptions) != 1 {
+		t.Fatalf("expected a subscription, got: %s", subscriptions)
+	}
+}
+
+func TestServerDynamodb(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	conn := dynamodb.New(testSession(t, s))
+
+	if _, err := conn.CreateTable(&dynamodb.CreateTableInput{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{
+			{AttributeName: aws.String("id"), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+		},
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{AttributeName: aws.String("id"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+		},
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String("test-table"),
+	}); err != nil {
+		t.Fatalf("error creating table: %s", err)
+	}
+
+	table, err := conn.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("test-table")})
+	if err != nil {
+		t.Fatalf("error describing table: %s", err)
+	}
+
+	if got := aws.StringValue(table.Table.TableStatus); got != dynamodb.TableStatusActive {
+		t.Fatalf("expected status %s, got: %s", dynamodb.TableStatusActive, got)
+	}
+
+	if _, err := conn.DeleteTable(&dynamodb.DeleteTableInput{TableName: aws.String("test-table")}); err != nil {
+		t.Fatalf("error deleting table: %s", err)
+	}
+
+	_, err = conn.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("test-table")})
+	testErrorCode(t, err, dynamodb.ErrCodeResourceNotFoundException)
+}
@@ -0,0 +1,314 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+)
+
+// snsState is the state of the fake SNS API, keyed by ARN.
+type snsState struct {
+	subscriptions map[string]map[string]*string
+	topics        map[string]*snsTopic
+}
+
+func newSnsState() *snsState {
+	return &snsState{
+		subscriptions: make(map[string]map[string]*string),
+		topics:        make(map[string]*snsTopic),
+	}
+}
+
+// snsTopic is a topic with its attributes and tags.
+type snsTopic struct {
+	attributes map[string]*string
+	tags       []*sns.Tag
+}
+
+func (s *Server) serveSns(w http.ResponseWriter, r *http.Request) {
+	s.serveQuery(w, r, "sns", false, operations{
+		"CreateTopic":               s.snsCreateTopic,
+		"DeleteTopic":               s.snsDeleteTopic,
+		"GetSubscriptionAttributes": s.snsGetSubscriptionAttributes,
+		"GetTopicAttributes":        s.snsGetTopicAttributes,
+		"ListSubscriptionsByTopic":  s.snsListSubscriptionsByTopic,
+		"ListTagsForResource":       s.snsListTagsForResource,
+		"ListTopics":                s.snsListTopics,
+		"SetSubscriptionAttributes": s.snsSetSubscriptionAttributes,
+		"SetTopicAttributes":        s.snsSetTopicAttributes,
+		"Subscribe":                 s.snsSubscribe,
+		"TagResource":               s.snsTagResource,
+		"Unsubscribe":               s.snsUnsubscribe,
+		"UntagResource":             s.snsUntagResource,
+	})
+}
+
+// snsTopic returns a topic by ARN.
+func (s *Server) snsTopic(arn *string) (*snsTopic, error) {
+	topic, ok := s.sns.topics[aws.StringValue(arn)]
+
+	if !ok {
+		return nil, newNotFoundError(sns.ErrCodeNotFoundException, "Topic does not exist")
+	}
+
+	return topic, nil
+}
+
+// snsDefaultTopicPolicy returns the access policy of a new topic.
+func (s *Server) snsDefaultTopicPolicy(arn string) string {
+	return fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish","SNS:Receive"],"Resource":"%s","Condition":{"StringEquals":{"AWS:SourceOwner":"%s"}}}]}`, arn, s.AccountID)
+}
+
+func (s *Server) snsCreateTopic(in *sns.CreateTopicInput) (*sns.CreateTopicOutput, error) {
+	arn := s.arn("sns", s.region, aws.StringValue(in.Name))
+
+	if _, ok := s.sns.topics[arn]; ok {
+		return &sns.CreateTopicOutput{TopicArn: aws.String(arn)}, nil
+	}
+
+	topic := &snsTopic{
+		attributes: map[string]*string{
+			"DisplayName":            aws.String(""),
+			"Owner":                  aws.String(s.AccountID),
+			"Policy":                 aws.String(s.snsDefaultTopicPolicy(arn)),
+			"SubscriptionsConfirmed": aws.String("0"),
+			"SubscriptionsDeleted":   aws.String("0"),
+			"SubscriptionsPending":   aws.String("0"),
+			"TopicArn":               aws.String(arn),
+		},
+		tags: in.Tags,
+	}
+
+	for k, v := range in.Attributes {
+		topic.attributes[k] = v
+	}
+
+	s.sns.topics[arn] = topic
+
+	return &sns.CreateTopicOutput{TopicArn: aws.String(arn)}, nil
+}
+
+func (s *Server) snsListTopics(in *sns.ListTopicsInput) (*sns.ListTopicsOutput, error) {
+	var arns []string
+	for arn := range s.sns.topics {
+		arns = append(arns, arn)
+	}
+	sort.Strings(arns)
+
+	out := &sns.ListTopicsOutput{}
+
+	for _, arn := range arns {
+		out.Topics = append(out.Topics, &sns.Topic{TopicArn: aws.String(arn)})
+	}
+
+	return out, nil
+}
+
+func (s *Server) snsDeleteTopic(in *sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error) {
+	delete(s.sns.topics, aws.StringValue(in.TopicArn))
+
+	for arn, subscription := range s.sns.subscriptions {
+		if aws.StringValue(subscription["TopicArn"]) == aws.StringValue(in.TopicArn) {
+			delete(s.sns.subscriptions, arn)
+		}
+	}
+
+	return &sns.DeleteTopicOutput{}, nil
+}
+
+func (s *Server) snsGetTopicAttributes(in *sns.GetTopicAttributesInput) (*sns.GetTopicAttributesOutput, error) {
+	topic, err := s.snsTopic(in.TopicArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sns.GetTopicAttributesOutput{Attributes: topic.attributes}, nil
+}
+
+func (s *Server) snsSetTopicAttributes(in *sns.SetTopicAttributesInput) (*sns.SetTopicAttributesOutput, error) {
+	topic, err := s.snsTopic(in.TopicArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := aws.StringValue(in.AttributeName)
+
+	switch {
+	case aws.StringValue(in.AttributeValue) != "":
+		topic.attributes[name] = in.AttributeValue
+	case name == "Policy":
+		topic.attributes[name] = aws.String(s.snsDefaultTopicPolicy(aws.StringValue(in.TopicArn)))
+	default:
+		delete(topic.attributes, name)
+	}
+
+	return &sns.SetTopicAttributesOutput{}, nil
+}
+
+func (s *Server) snsListTagsForResource(in *sns.ListTagsForResourceInput) (*sns.ListTagsForResourceOutput, error) {
+	topic, err := s.snsTopic(in.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sns.ListTagsForResourceOutput{Tags: topic.tags}, nil
+}
+
+func (s *Server) snsTagResource(in *sns.TagResourceInput) (*sns.TagResourceOutput, error) {
+	topic, err := s.snsTopic(in.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range in.Tags {
+		topic.tags = append(snsRemoveTag(topic.tags, aws.StringValue(tag.Key)), tag)
+	}
+
+	return &sns.TagResourceOutput{}, nil
+}
+
+func (s *Server) snsUntagResource(in *sns.UntagResourceInput) (*sns.UntagResourceOutput, error) {
+	topic, err := s.snsTopic(in.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range in.TagKeys {
+		topic.tags = snsRemoveTag(topic.tags, aws.StringValue(key))
+	}
+
+	return &sns.UntagResourceOutput{}, nil
+}
+
+func snsRemoveTag(tags []*sns.Tag, key string) []*sns.Tag {
+	var result []*sns.Tag
+
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) != key {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
+
+// Subscriptions are confirmed immediately, no messages are delivered.
+
+func (s *Server) snsSubscribe(in *sns.SubscribeInput) (*sns.SubscribeOutput, error) {
+	topic, err := s.snsTopic(in.TopicArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arn := aws.StringValue(in.TopicArn) + ":" + s.requestID()
+	subscription := map[string]*string{
+		"ConfirmationWasAuthenticated": aws.String("true"),
+		"Endpoint":                     in.Endpoint,
+		"Owner":                        aws.String(s.AccountID),
+		"PendingConfirmation":          aws.String("false"),
+		"Protocol":                     in.Protocol,
+		"RawMessageDelivery":           aws.String("false"),
+		"SubscriptionArn":              aws.String(arn),
+		"TopicArn":                     in.TopicArn,
+	}
+
+	for k, v := range in.Attributes {
+		subscription[k] = v
+	}
+
+	s.sns.subscriptions[arn] = subscription
+
+	confirmed, _ := strconv.Atoi(aws.StringValue(topic.attributes["SubscriptionsConfirmed"]))
+	topic.attributes["SubscriptionsConfirmed"] = aws.String(strconv.Itoa(confirmed + 1))
+
+	return &sns.SubscribeOutput{SubscriptionArn: aws.String(arn)}, nil
+}
+
+// snsSubscription returns a subscription by ARN.
+func (s *Server) snsSubscription(arn *string) (map[string]*string, error) {
+	subscription, ok := s.sns.subscriptions[aws.StringValue(arn)]
+
+	if !ok {
+		return nil, newNotFoundError(sns.ErrCodeNotFoundException, "Subscription does not exist")
+	}
+
+	return subscription, nil
+}
+
+func (s *Server) snsGetSubscriptionAttributes(in *sns.GetSubscriptionAttributesInput) (*sns.GetSubscriptionAttributesOutput, error) {
+	subscription, err := s.snsSubscription(in.SubscriptionArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sns.GetSubscriptionAttributesOutput{Attributes: subscription}, nil
+}
+
+func (s *Server) snsSetSubscriptionAttributes(in *sns.SetSubscriptionAttributesInput) (*sns.SetSubscriptionAttributesOutput, error) {
+	subscription, err := s.snsSubscription(in.SubscriptionArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	subscription[aws.StringValue(in.AttributeName)] = in.AttributeValue
+
+	return &sns.SetSubscriptionAttributesOutput{}, nil
+}
+
+func (s *Server) snsListSubscriptionsByTopic(in *sns.ListSubscriptionsByTopicInput) (*sns.ListSubscriptionsByTopicOutput, error) {
+	if _, err := s.snsTopic(in.TopicArn); err != nil {
+		return nil, err
+	}
+
+	var arns []string
+	for arn, subscription := range s.sns.subscriptions {
+		if aws.StringValue(subscription["TopicArn"]) == aws.StringValue(in.TopicArn) {
+			arns = append(arns, arn)
+		}
+	}
+	sort.Strings(arns)
+
+	out := &sns.ListSubscriptionsByTopicOutput{}
+
+	for _, arn := range arns {
+		subscription := s.sns.subscriptions[arn]
+		out.Subscriptions = append(out.Subscriptions, &sns.Subscription{
+			Endpoint:        subscription["Endpoint"],
+			Owner:           subscription["Owner"],
+			Protocol:        subscription["Protocol"],
+			SubscriptionArn: aws.String(arn),
+			TopicArn:        in.TopicArn,
+		})
+	}
+
+	return out, nil
+}
+
+func (s *Server) snsUnsubscribe(in *sns.UnsubscribeInput) (*sns.UnsubscribeOutput, error) {
+	subscription, err := s.snsSubscription(in.SubscriptionArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if topic, ok := s.sns.topics[aws.StringValue(subscription["TopicArn"])]; ok {
+		confirmed, _ := strconv.Atoi(aws.StringValue(topic.attributes["SubscriptionsConfirmed"]))
+		topic.attributes["SubscriptionsConfirmed"] = aws.String(strconv.Itoa(confirmed - 1))
+	}
+
+	delete(s.sns.subscriptions, aws.StringValue(in.SubscriptionArn))
+
+	return &sns.UnsubscribeOutput{}, nil
+}
@@ -0,0 +1,235 @@
+package fakeaws
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+// sqsState is the state of the fake SQS API, queues are keyed by URL.
+type sqsState struct {
+	queues map[string]*sqsQueue
+}
+
+func newSqsState() *sqsState {
+	return &sqsState{
+		queues: make(map[string]*sqsQueue),
+	}
+}
+
+// sqsQueue is a queue with its attributes and tags.
+type sqsQueue struct {
+	attributes map[string]*string
+	name       string
+	tags       map[string]*string
+}
+
+// sqsDefaultQueueAttributes are the attributes of a new queue.
+var sqsDefaultQueueAttributes = map[string]string{
+	sqs.QueueAttributeNameApproximateNumberOfMessages:           "0",
+	sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed:    "0",
+	sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible: "0",
+	sqs.QueueAttributeNameDelaySeconds:                          "0",
+	sqs.QueueAttributeNameMaximumMessageSize:                    "262144",
+	sqs.QueueAttributeNameMessageRetentionPeriod:                "345600",
+	sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds:         "0",
+	sqs.QueueAttributeNameVisibilityTimeout:                     "30",
+}
+
+func (s *Server) serveSqs(w http.ResponseWriter, r *http.Request) {
+	s.serveQuery(w, r, "sqs", false, operations{
+		"CreateQueue":        s.sqsCreateQueue,
+		"DeleteQueue":        s.sqsDeleteQueue,
+		"GetQueueAttributes": s.sqsGetQueueAttributes,
+		"GetQueueUrl":        s.sqsGetQueueUrl,
+		"ListQueueTags":      s.sqsListQueueTags,
+		"ListQueues":         s.sqsListQueues,
+		"SetQueueAttributes": s.sqsSetQueueAttributes,
+		"TagQueue":           s.sqsTagQueue,
+		"UntagQueue":         s.sqsUntagQueue,
+	})
+}
+
+// sqsQueue returns a queue by URL.
+func (s *Server) sqsQueue(url *string) (*sqsQueue, error) {
+	queue, ok := s.sqs.queues[aws.StringValue(url)]
+
+	if !ok {
+		return nil, newError(sqs.ErrCodeQueueDoesNotExist, "The specified queue does not exist for this wsdl version.")
+	}
+
+	return queue, nil
+}
+
+// sqsQueueURL returns the URL of a queue.
+func (s *Server) sqsQueueURL(name string) string {
+	return s.URL + "/" + s.AccountID + "/" + name
+}
+
+func (s *Server) sqsCreateQueue(in *sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error) {
+	name := aws.StringValue(in.QueueName)
+	url := s.sqsQueueURL(name)
+
+	if queue, ok := s.sqs.queues[url]; ok {
+		for k, v := range in.Attributes {
+			if aws.StringValue(queue.attributes[k]) != aws.StringValue(v) {
+				return nil, newError(sqs.ErrCodeQueueNameExists, "A queue already exists with the same name and a different value for attribute %s", k)
+			}
+		}
+
+		return &sqs.CreateQueueOutput{QueueUrl: aws.String(url)}, nil
+	}
+
+	if strings.HasSuffix(name, ".fifo") != (aws.StringValue(in.Attributes[sqs.QueueAttributeNameFifoQueue]) == "true") {
+		return nil, newError("InvalidParameterValue", "The name of a FIFO queue can only include alphanumeric characters, hyphens, or underscores, must end with .fifo suffix")
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	queue := &sqsQueue{
+		attributes: map[string]*string{
+			sqs.QueueAttributeNameCreatedTimestamp:      aws.String(now),
+			sqs.QueueAttributeNameLastModifiedTimestamp: aws.String(now),
+			sqs.QueueAttributeNameQueueArn:              aws.String(s.arn("sqs", s.region, name)),
+		},
+		name: name,
+		tags: make(map[string]*string),
+	}
+
+	for k, v := range sqsDefaultQueueAttributes {
+		queue.attributes[k] = aws.String(v)
+	}
+
+	for k, v := range in.Attributes {
+		queue.attributes[k] = v
+	}
+
+	s.sqs.queues[url] = queue
+
+	return &sqs.CreateQueueOutput{QueueUrl: aws.String(url)}, nil
+}
+
+func (s *Server) sqsGetQueueUrl(in *sqs.GetQueueUrlInput) (*sqs.GetQueueUrlOutput, error) {
+	url := s.sqsQueueURL(aws.StringValue(in.QueueName))
+
+	if _, err := s.sqsQueue(aws.String(url)); err != nil {
+		return nil, err
+	}
+
+	return &sqs.GetQueueUrlOutput{QueueUrl: aws.String(url)}, nil
+}
+
+func (s *Server) sqsListQueues(in *sqs.ListQueuesInput) (*sqs.ListQueuesOutput, error) {
+	out := &sqs.ListQueuesOutput{}
+
+	var urls []string
+	for url, queue := range s.sqs.queues {
+		if strings.HasPrefix(queue.name, aws.StringValue(in.QueueNamePrefix)) {
+			urls = append(urls, url)
+		}
+	}
+	sort.Strings(urls)
+
+	out.QueueUrls = aws.StringSlice(urls)
+
+	return out, nil
+}
+
+func (s *Server) sqsDeleteQueue(in *sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error) {
+	if _, err := s.sqsQueue(in.QueueUrl); err != nil {
+		return nil, err
+	}
+
+	delete(s.sqs.queues, aws.StringValue(in.QueueUrl))
+
+	return &sqs.DeleteQueueOutput{}, nil
+}
+
+func (s *Server) sqsGetQueueAttributes(in *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
+	queue, err := s.sqsQueue(in.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := &sqs.GetQueueAttributesOutput{Attributes: make(map[string]*string)}
+
+	for _, name := range in.AttributeNames {
+		if aws.StringValue(name) == sqs.QueueAttributeNameAll {
+			for k, v := range queue.attributes {
+				out.Attributes[k] = v
+			}
+
+			continue
+		}
+
+		if v, ok := queue.attributes[aws.StringValue(name)]; ok {
+			out.Attributes[aws.StringValue(name)] = v
+		}
+	}
+
+	return out, nil
+}
+
+func (s *Server) sqsSetQueueAttributes(in *sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error) {
+	queue, err := s.sqsQueue(in.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range in.Attributes {
+		if aws.StringValue(v) == "" {
+			delete(queue.attributes, k)
+			continue
+		}
+
+		queue.attributes[k] = v
+	}
+
+	queue.attributes[sqs.QueueAttributeNameLastModifiedTimestamp] = aws.String(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return &sqs.SetQueueAttributesOutput{}, nil
+}
+
+func (s *Server) sqsListQueueTags(in *sqs.ListQueueTagsInput) (*sqs.ListQueueTagsOutput, error) {
+	queue, err := s.sqsQueue(in.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqs.ListQueueTagsOutput{Tags: queue.tags}, nil
+}
+
+func (s *Server) sqsTagQueue(in *sqs.TagQueueInput) (*sqs.TagQueueOutput, error) {
+	queue, err := s.sqsQueue(in.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range in.Tags {
+		queue.tags[k] = v
+	}
+
+	return &sqs.TagQueueOutput{}, nil
+}
+
+func (s *Server) sqsUntagQueue(in *sqs.UntagQueueInput) (*sqs.UntagQueueOutput, error) {
+	queue, err := s.sqsQueue(in.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range in.TagKeys {
+		delete(queue.tags, aws.StringValue(k))
+	}
+
+	return &sqs.UntagQueueOutput{}, nil
+}
@@ -0,0 +1,22 @@
+package fakeaws
+
+import (
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func (s *Server) serveSts(w http.ResponseWriter, r *http.Request) {
+	s.serveQuery(w, r, "sts", false, operations{
+		"GetCallerIdentity": s.stsGetCallerIdentity,
+	})
+}
+
+func (s *Server) stsGetCallerIdentity(in *sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
+	return &sts.GetCallerIdentityOutput{
+		Account: aws.String(s.AccountID),
+		Arn:     aws.String(s.arn("iam", "", "user/fakeaws")),
+		UserId:  aws.String("AIDAFAKEAWS0000000000"),
+	}, nil
+}
@@ -0,0 +1,228 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const filename = `service_tags_gen.go`
+
+// Representing types such as []*ec2.Tag, []*iam.Tag, ...
+var sliceServiceNames = []string{
+	"acm",
+	"acmpca",
+	"cloudfront",
+	"cloudhsmv2",
+	"cloudtrail",
+	"cloudwatch",
+	"cloudwatchevents",
+	"codebuild",
+	"databasemigrationservice",
+	"datasync",
+	"dax",
+	"directconnect",
+	"directoryservice",
+	"docdb",
+	"dynamodb",
+	"ec2",
+	"ecr",
+	"ecs",
+	"efs",
+	"elasticache",
+	"elasticbeanstalk",
+	"elasticsearchservice",
+	"elb",
+	"elbv2",
+	"emr",
+	"firehose",
+	"fsx",
+	"iam",
+	"inspector",
+	"kinesis",
+	"kinesisanalytics",
+	"kms",
+	"licensemanager",
+	"neptune",
+	"ram",
+	"rds",
+	"redshift",
+	"route53",
+	"route53resolver",
+	"s3",
+	"sagemaker",
+	"secretsmanager",
+	"sfn",
+	"sns",
+	"ssm",
+	"transfer",
+	"workspaces",
+}
+
+// Representing types such as map[string]*string
+var mapServiceNames = []string{
+	"apigateway",
+	"appsync",
+	"backup",
+	"batch",
+	"cloudwatchlogs",
+	"cognitoidentityprovider",
+	"glacier",
+	"kafka",
+	"kinesisvideo",
+	"lambda",
+	"mediapackage",
+	"mq",
+	"opsworks",
+	"sqs",
+}
+
+type TemplateData struct {
+	MapServiceNames   []string
+	SliceServiceNames []string
+}
+
+func main() {
+	// Always sort to reduce any potential generation churn
+	sort.Strings(mapServiceNames)
+	sort.Strings(sliceServiceNames)
+
+	templateData := TemplateData{
+		MapServiceNames:   mapServiceNames,
+		SliceServiceNames: sliceServiceNames,
+	}
+	templateFuncMap := template.FuncMap{
+		"TagKeyField":   ServiceTagKeyField,
+		"TagType":       ServiceTagType,
+		"TagValueField": ServiceTagValueField,
+		"Title":         strings.Title,
+	}
+
+	tmpl, err := template.New("servicetags").Funcs(templateFuncMap).Parse(templateBody)
+
+	if err != nil {
+		log.Fatalf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		log.Fatalf("error creating file (%s): %s", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+var templateBody = `
+// Code generated by generators/servicetags/main.go; DO NOT EDIT.
+
+package keyvaluetags
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+{{- range .SliceServiceNames }}
+	"github.com/aws/aws-sdk-go/service/{{ . }}"
+{{- end }}
+)
+
+// map[string]*string handling
+{{- range .MapServiceNames }}
+
+// {{ . | Title }}Tags returns {{ . }} service tags.
+func (tags KeyValueTags) {{ . | Title }}Tags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// {{ . | Title }}KeyValueTags creates KeyValueTags from {{ . }} service tags.
+func {{ . | Title }}KeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+{{- end }}
+
+// []*SERVICE.Tag handling
+{{- range .SliceServiceNames }}
+
+// {{ . | Title }}Tags returns {{ . }} service tags.
+func (tags KeyValueTags) {{ . | Title }}Tags() []*{{ . }}.{{ . | TagType }} {
+	result := make([]*{{ . }}.{{ . | TagType }}, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &{{ . }}.{{ . | TagType }}{
+			{{ . | TagKeyField }}:   aws.String(k),
+			{{ . | TagValueField }}: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// {{ . | Title }}KeyValueTags creates KeyValueTags from {{ . }} service tags.
+func {{ . | Title }}KeyValueTags(tags []*{{ . }}.{{ . | TagType }}) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.{{ . | TagKeyField }})] = tag.{{ . | TagValueField }}
+	}
+
+	return New(m)
+}
+{{- end }}
+`
+
+// ServiceTagType determines the service tagging tag type.
+func ServiceTagType(serviceName string) string {
+	switch serviceName {
+	case "datasync":
+		return "TagListEntry"
+	default:
+		return "Tag"
+	}
+}
+
+// ServiceTagKeyField determines the service tagging tag key field.
+func ServiceTagKeyField(serviceName string) string {
+	switch serviceName {
+	case "kms":
+		return "TagKey"
+	default:
+		return "Key"
+	}
+}
+
+// ServiceTagValueField determines the service tagging tag value field.
+func ServiceTagValueField(serviceName string) string {
+	switch serviceName {
+	case "kms":
+		return "TagValue"
+	default:
+		return "Value"
+	}
+}
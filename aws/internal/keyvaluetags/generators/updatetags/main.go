@@ -0,0 +1,717 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const filename = `update_tags_gen.go`
+
+var serviceNames = []string{
+	"acm",
+	"acmpca",
+	"apigateway",
+	"appsync",
+	"backup",
+	"cloudfront",
+	"cloudhsmv2",
+	"cloudtrail",
+	"cloudwatch",
+	"cloudwatchevents",
+	"cloudwatchlogs",
+	"databasemigrationservice",
+	"datasync",
+	"dax",
+	"directconnect",
+	"directoryservice",
+	"docdb",
+	"dynamodb",
+	"ec2",
+	"ecr",
+	"ecs",
+	"efs",
+	"elasticache",
+	"elasticbeanstalk",
+	"elasticsearchservice",
+	"elb",
+	"elbv2",
+	"emr",
+	"firehose",
+	"fsx",
+	"kafka",
+	"kinesis",
+	"kinesisanalytics",
+	"kinesisvideo",
+	"kms",
+	"lambda",
+	"licensemanager",
+	"mediapackage",
+	"mq",
+	"neptune",
+	"opsworks",
+	"ram",
+	"rds",
+	"redshift",
+	"route53",
+	"route53resolver",
+	"sagemaker",
+	"secretsmanager",
+	"sfn",
+	"sns",
+	"sqs",
+	"ssm",
+	"transfer",
+	"workspaces",
+}
+
+type TemplateData struct {
+	ServiceNames []string
+}
+
+func main() {
+	// Always sort to reduce any potential generation churn
+	sort.Strings(serviceNames)
+
+	templateData := TemplateData{
+		ServiceNames: serviceNames,
+	}
+	templateFuncMap := template.FuncMap{
+		"ClientType":                       ServiceClientType,
+		"TagFunction":                      ServiceTagFunction,
+		"TagFunctionBatchSize":             ServiceTagFunctionBatchSize,
+		"TagInputIdentifierField":          ServiceTagInputIdentifierField,
+		"TagInputIdentifierRequiresSlice":  ServiceTagInputIdentifierRequiresSlice,
+		"TagInputResourceTypeField":        ServiceTagInputResourceTypeField,
+		"TagInputTagsField":                ServiceTagInputTagsField,
+		"TagInputCustomValue":              ServiceTagInputCustomValue,
+		"Title":                            strings.Title,
+		"UntagFunction":                    ServiceUntagFunction,
+		"UntagInputRequiresTagType":        ServiceUntagInputRequiresTagType,
+		"UntagInputTagsField":              ServiceUntagInputTagsField,
+		"UntagInputCustomValue":            ServiceUntagInputCustomValue,
+		"TagAndUntagFunctionsAreIdentical": ServiceTagAndUntagFunctionsAreIdentical,
+	}
+
+	tmpl, err := template.New("updatetags").Funcs(templateFuncMap).Parse(templateBody)
+
+	if err != nil {
+		log.Fatalf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		log.Fatalf("error creating file (%s): %s", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+var templateBody = `
+// Code generated by generators/updatetags/main.go; DO NOT EDIT.
+
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+{{- range .ServiceNames }}
+	"github.com/aws/aws-sdk-go/service/{{ . }}"
+{{- end }}
+)
+{{- range .ServiceNames }}
+
+// {{ . | Title }}UpdateTags updates {{ . }} service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func {{ . | Title }}UpdateTags(conn {{ . | ClientType }}, identifier string{{ if . | TagInputResourceTypeField }}, resourceType string{{ end }}, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+	{{- if . | TagAndUntagFunctionsAreIdentical }}
+	removedTags := oldTags.Removed(newTags).IgnoreAws()
+	updatedTags := oldTags.Updated(newTags).IgnoreAws()
+
+	if len(removedTags) == 0 && len(updatedTags) == 0 {
+		return nil
+	}
+
+	input := &{{ . }}.{{ . | TagFunction }}Input{
+		{{- if . | TagInputIdentifierRequiresSlice }}
+		{{ . | TagInputIdentifierField }}: aws.StringSlice([]string{identifier}),
+		{{- else }}
+		{{ . | TagInputIdentifierField }}: aws.String(identifier),
+		{{- end }}
+		{{- if . | TagInputResourceTypeField }}
+		{{ . | TagInputResourceTypeField }}: aws.String(resourceType),
+		{{- end }}
+	}
+
+	if len(removedTags) > 0 {
+		input.{{ . | UntagInputTagsField }} = aws.StringSlice(removedTags.Keys())
+	}
+
+	if len(updatedTags) > 0 {
+		input.{{ . | TagInputTagsField }} = updatedTags.{{ . | Title }}Tags()
+	}
+
+	_, err := conn.{{ . | TagFunction }}(input)
+
+	if err != nil {
+		return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+	}
+	{{- else }}
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		{{- if . | TagFunctionBatchSize }}
+		for _, removedTags := range removedTags.Chunks({{ . | TagFunctionBatchSize }}) {
+		{{- end }}
+		input := &{{ . }}.{{ . | UntagFunction }}Input{
+			{{- if . | TagInputIdentifierRequiresSlice }}
+			{{ . | TagInputIdentifierField }}: aws.StringSlice([]string{identifier}),
+			{{- else }}
+			{{ . | TagInputIdentifierField }}: aws.String(identifier),
+			{{- end }}
+			{{- if . | TagInputResourceTypeField }}
+			{{ . | TagInputResourceTypeField }}: aws.String(resourceType),
+			{{- end }}
+			{{- if . | UntagInputCustomValue }}
+			{{ . | UntagInputTagsField }}: {{ . | UntagInputCustomValue }},
+			{{- else if . | UntagInputRequiresTagType }}
+			{{ . | UntagInputTagsField }}: removedTags.{{ . | Title }}Tags(),
+			{{- else }}
+			{{ . | UntagInputTagsField }}: aws.StringSlice(removedTags.Keys()),
+			{{- end }}
+		}
+
+		_, err := conn.{{ . | UntagFunction }}(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+		{{- if . | TagFunctionBatchSize }}
+		}
+		{{- end }}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		{{- if . | TagFunctionBatchSize }}
+		for _, updatedTags := range updatedTags.Chunks({{ . | TagFunctionBatchSize }}) {
+		{{- end }}
+		input := &{{ . }}.{{ . | TagFunction }}Input{
+			{{- if . | TagInputIdentifierRequiresSlice }}
+			{{ . | TagInputIdentifierField }}: aws.StringSlice([]string{identifier}),
+			{{- else }}
+			{{ . | TagInputIdentifierField }}: aws.String(identifier),
+			{{- end }}
+			{{- if . | TagInputResourceTypeField }}
+			{{ . | TagInputResourceTypeField }}: aws.String(resourceType),
+			{{- end }}
+			{{- if . | TagInputCustomValue }}
+			{{ . | TagInputTagsField }}: {{ . | TagInputCustomValue }},
+			{{- else }}
+			{{ . | TagInputTagsField }}: updatedTags.{{ . | Title }}Tags(),
+			{{- end }}
+		}
+
+		_, err := conn.{{ . | TagFunction }}(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+		{{- if . | TagFunctionBatchSize }}
+		}
+		{{- end }}
+	}
+	{{- end }}
+
+	return nil
+}
+{{- end }}
+`
+
+// ServiceClientType determines the service client Go type.
+// The SDK does not provide a constant or reproducible inference methodology.
+func ServiceClientType(serviceName string) string {
+	var clientType string
+
+	switch serviceName {
+	case "acm":
+		clientType = "ACM"
+	case "acmpca":
+		clientType = "ACMPCA"
+	case "apigateway":
+		clientType = "APIGateway"
+	case "appsync":
+		clientType = "AppSync"
+	case "backup":
+		clientType = "Backup"
+	case "cloudfront":
+		clientType = "CloudFront"
+	case "cloudhsmv2":
+		clientType = "CloudHSMV2"
+	case "cloudtrail":
+		clientType = "CloudTrail"
+	case "cloudwatch":
+		clientType = "CloudWatch"
+	case "cloudwatchevents":
+		clientType = "CloudWatchEvents"
+	case "cloudwatchlogs":
+		clientType = "CloudWatchLogs"
+	case "databasemigrationservice":
+		clientType = "DatabaseMigrationService"
+	case "datasync":
+		clientType = "DataSync"
+	case "dax":
+		clientType = "DAX"
+	case "directconnect":
+		clientType = "DirectConnect"
+	case "directoryservice":
+		clientType = "DirectoryService"
+	case "docdb":
+		clientType = "DocDB"
+	case "dynamodb":
+		clientType = "DynamoDB"
+	case "ec2":
+		clientType = "EC2"
+	case "ecr":
+		clientType = "ECR"
+	case "ecs":
+		clientType = "ECS"
+	case "efs":
+		clientType = "EFS"
+	case "elasticache":
+		clientType = "ElastiCache"
+	case "elasticbeanstalk":
+		clientType = "ElasticBeanstalk"
+	case "elasticsearchservice":
+		clientType = "ElasticsearchService"
+	case "elb":
+		clientType = "ELB"
+	case "elbv2":
+		clientType = "ELBV2"
+	case "emr":
+		clientType = "EMR"
+	case "firehose":
+		clientType = "Firehose"
+	case "fsx":
+		clientType = "FSx"
+	case "kafka":
+		clientType = "Kafka"
+	case "kinesis":
+		clientType = "Kinesis"
+	case "kinesisanalytics":
+		clientType = "KinesisAnalytics"
+	case "kinesisvideo":
+		clientType = "KinesisVideo"
+	case "kms":
+		clientType = "KMS"
+	case "lambda":
+		clientType = "Lambda"
+	case "licensemanager":
+		clientType = "LicenseManager"
+	case "mediapackage":
+		clientType = "MediaPackage"
+	case "mq":
+		clientType = "MQ"
+	case "neptune":
+		clientType = "Neptune"
+	case "opsworks":
+		clientType = "OpsWorks"
+	case "ram":
+		clientType = "RAM"
+	case "rds":
+		clientType = "RDS"
+	case "redshift":
+		clientType = "Redshift"
+	case "route53":
+		clientType = "Route53"
+	case "route53resolver":
+		clientType = "Route53Resolver"
+	case "sagemaker":
+		clientType = "SageMaker"
+	case "secretsmanager":
+		clientType = "SecretsManager"
+	case "sfn":
+		clientType = "SFN"
+	case "sns":
+		clientType = "SNS"
+	case "sqs":
+		clientType = "SQS"
+	case "ssm":
+		clientType = "SSM"
+	case "transfer":
+		clientType = "Transfer"
+	case "workspaces":
+		clientType = "WorkSpaces"
+	default:
+		log.Fatalf("unrecognized ServiceClientType: %s", serviceName)
+	}
+
+	return "*" + serviceName + "." + clientType
+}
+
+// ServiceTagFunction determines the service tagging function.
+func ServiceTagFunction(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "AddTagsToCertificate"
+	case "acmpca":
+		return "TagCertificateAuthority"
+	case "cloudtrail":
+		return "AddTags"
+	case "cloudwatchlogs":
+		return "TagLogGroup"
+	case "databasemigrationservice":
+		return "AddTagsToResource"
+	case "directoryservice":
+		return "AddTagsToResource"
+	case "docdb":
+		return "AddTagsToResource"
+	case "ec2":
+		return "CreateTags"
+	case "efs":
+		return "CreateTags"
+	case "elasticache":
+		return "AddTagsToResource"
+	case "elasticbeanstalk":
+		return "UpdateTagsForResource"
+	case "elasticsearchservice":
+		return "AddTags"
+	case "elb":
+		return "AddTags"
+	case "elbv2":
+		return "AddTags"
+	case "emr":
+		return "AddTags"
+	case "firehose":
+		return "TagDeliveryStream"
+	case "kinesis":
+		return "AddTagsToStream"
+	case "kinesisvideo":
+		return "TagStream"
+	case "mq":
+		return "CreateTags"
+	case "neptune":
+		return "AddTagsToResource"
+	case "rds":
+		return "AddTagsToResource"
+	case "redshift":
+		return "CreateTags"
+	case "route53":
+		return "ChangeTagsForResource"
+	case "sagemaker":
+		return "AddTags"
+	case "sqs":
+		return "TagQueue"
+	case "ssm":
+		return "AddTagsToResource"
+	case "workspaces":
+		return "CreateTags"
+	default:
+		return "TagResource"
+	}
+}
+
+// ServiceTagFunctionBatchSize determines the batch size (if any) for tagging and untagging.
+func ServiceTagFunctionBatchSize(serviceName string) string {
+	switch serviceName {
+	case "kinesis":
+		return "10"
+	default:
+		return ""
+	}
+}
+
+// ServiceTagInputIdentifierField determines the service tag identifier field.
+func ServiceTagInputIdentifierField(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "CertificateArn"
+	case "acmpca":
+		return "CertificateAuthorityArn"
+	case "cloudfront":
+		return "Resource"
+	case "cloudhsmv2":
+		return "ResourceId"
+	case "cloudtrail":
+		return "ResourceId"
+	case "cloudwatch":
+		return "ResourceARN"
+	case "cloudwatchevents":
+		return "ResourceARN"
+	case "cloudwatchlogs":
+		return "LogGroupName"
+	case "dax":
+		return "ResourceName"
+	case "directoryservice":
+		return "ResourceId"
+	case "docdb":
+		return "ResourceName"
+	case "ec2":
+		return "Resources"
+	case "efs":
+		return "FileSystemId"
+	case "elasticache":
+		return "ResourceName"
+	case "elasticsearchservice":
+		return "ARN"
+	case "elb":
+		return "LoadBalancerNames"
+	case "elbv2":
+		return "ResourceArns"
+	case "emr":
+		return "ResourceId"
+	case "firehose":
+		return "DeliveryStreamName"
+	case "fsx":
+		return "ResourceARN"
+	case "kinesis":
+		return "StreamName"
+	case "kinesisanalytics":
+		return "ResourceARN"
+	case "kinesisvideo":
+		return "StreamARN"
+	case "kms":
+		return "KeyId"
+	case "lambda":
+		return "Resource"
+	case "neptune":
+		return "ResourceName"
+	case "ram":
+		return "ResourceShareArn"
+	case "rds":
+		return "ResourceName"
+	case "redshift":
+		return "ResourceName"
+	case "route53":
+		return "ResourceId"
+	case "secretsmanager":
+		return "SecretId"
+	case "sqs":
+		return "QueueUrl"
+	case "ssm":
+		return "ResourceId"
+	case "transfer":
+		return "Arn"
+	case "workspaces":
+		return "ResourceId"
+	default:
+		return "ResourceArn"
+	}
+}
+
+// ServiceTagInputIdentifierRequiresSlice determines if the service tagging resource field requires a slice.
+func ServiceTagInputIdentifierRequiresSlice(serviceName string) string {
+	switch serviceName {
+	case "ec2":
+		return "yes"
+	case "elb":
+		return "yes"
+	case "elbv2":
+		return "yes"
+	default:
+		return ""
+	}
+}
+
+// ServiceTagInputResourceTypeField determines the service tagging resource type field.
+func ServiceTagInputResourceTypeField(serviceName string) string {
+	switch serviceName {
+	case "route53":
+		return "ResourceType"
+	case "ssm":
+		return "ResourceType"
+	default:
+		return ""
+	}
+}
+
+// ServiceTagInputTagsField determines the service tagging tags field.
+func ServiceTagInputTagsField(serviceName string) string {
+	switch serviceName {
+	case "cloudhsmv2":
+		return "TagList"
+	case "cloudtrail":
+		return "TagsList"
+	case "elasticbeanstalk":
+		return "TagsToAdd"
+	case "elasticsearchservice":
+		return "TagList"
+	case "route53":
+		return "AddTags"
+	default:
+		return "Tags"
+	}
+}
+
+// ServiceTagInputCustomValue determines any custom value for the service tagging tags field.
+func ServiceTagInputCustomValue(serviceName string) string {
+	switch serviceName {
+	case "cloudfront":
+		return "&cloudfront.Tags{Items: updatedTags.CloudfrontTags()}"
+	case "kinesis":
+		return "aws.StringMap(updatedTags.Map())"
+	default:
+		return ""
+	}
+}
+
+// ServiceTagAndUntagFunctionsAreIdentical determines if the service
+// tags and untags resources with a single API call.
+func ServiceTagAndUntagFunctionsAreIdentical(serviceName string) string {
+	if ServiceTagFunction(serviceName) == ServiceUntagFunction(serviceName) {
+		return "yes"
+	}
+
+	return ""
+}
+
+// ServiceUntagFunction determines the service untagging function.
+func ServiceUntagFunction(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "RemoveTagsFromCertificate"
+	case "acmpca":
+		return "UntagCertificateAuthority"
+	case "cloudtrail":
+		return "RemoveTags"
+	case "cloudwatchlogs":
+		return "UntagLogGroup"
+	case "databasemigrationservice":
+		return "RemoveTagsFromResource"
+	case "directoryservice":
+		return "RemoveTagsFromResource"
+	case "docdb":
+		return "RemoveTagsFromResource"
+	case "ec2":
+		return "DeleteTags"
+	case "efs":
+		return "DeleteTags"
+	case "elasticache":
+		return "RemoveTagsFromResource"
+	case "elasticbeanstalk":
+		return "UpdateTagsForResource"
+	case "elasticsearchservice":
+		return "RemoveTags"
+	case "elb":
+		return "RemoveTags"
+	case "elbv2":
+		return "RemoveTags"
+	case "emr":
+		return "RemoveTags"
+	case "firehose":
+		return "UntagDeliveryStream"
+	case "kinesis":
+		return "RemoveTagsFromStream"
+	case "kinesisvideo":
+		return "UntagStream"
+	case "mq":
+		return "DeleteTags"
+	case "neptune":
+		return "RemoveTagsFromResource"
+	case "rds":
+		return "RemoveTagsFromResource"
+	case "redshift":
+		return "DeleteTags"
+	case "route53":
+		return "ChangeTagsForResource"
+	case "sagemaker":
+		return "DeleteTags"
+	case "sqs":
+		return "UntagQueue"
+	case "ssm":
+		return "RemoveTagsFromResource"
+	case "workspaces":
+		return "DeleteTags"
+	default:
+		return "UntagResource"
+	}
+}
+
+// ServiceUntagInputRequiresTagType determines if the service untagging requires full Tag type.
+func ServiceUntagInputRequiresTagType(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "yes"
+	case "acmpca":
+		return "yes"
+	case "cloudtrail":
+		return "yes"
+	case "ec2":
+		return "yes"
+	default:
+		return ""
+	}
+}
+
+// ServiceUntagInputTagsField determines the service untagging tags field.
+func ServiceUntagInputTagsField(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "Tags"
+	case "acmpca":
+		return "Tags"
+	case "backup":
+		return "TagKeyList"
+	case "cloudhsmv2":
+		return "TagKeyList"
+	case "cloudtrail":
+		return "TagsList"
+	case "cloudwatchlogs":
+		return "Tags"
+	case "datasync":
+		return "Keys"
+	case "ec2":
+		return "Tags"
+	case "elasticbeanstalk":
+		return "TagsToRemove"
+	case "elb":
+		return "Tags"
+	case "kinesisvideo":
+		return "TagKeyList"
+	case "route53":
+		return "RemoveTagKeys"
+	default:
+		return "TagKeys"
+	}
+}
+
+// ServiceUntagInputCustomValue determines any custom value for the service untagging tags field.
+func ServiceUntagInputCustomValue(serviceName string) string {
+	switch serviceName {
+	case "cloudfront":
+		return "&cloudfront.TagKeys{Items: aws.StringSlice(removedTags.Keys())}"
+	case "elb":
+		return "removedTags.ElbTagKeys()"
+	default:
+		return ""
+	}
+}
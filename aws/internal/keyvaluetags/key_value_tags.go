@@ -0,0 +1,350 @@
+//go:generate go run generators/servicetags/main.go
+//go:generate go run generators/updatetags/main.go
+
+package keyvaluetags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+)
+
+const (
+	AwsTagKeyPrefix = `aws:`
+)
+
+// IgnoreConfig contains various options for removing resource tags.
+type IgnoreConfig struct {
+	Keys        KeyValueTags
+	KeyPrefixes KeyValueTags
+}
+
+// DefaultConfig contains tags to default across all resources.
+type DefaultConfig struct {
+	Tags KeyValueTags
+}
+
+// MergeTags returns the result of keyvaluetags.Merge() on the given
+// DefaultConfig.Tags with KeyValueTags provided as an argument,
+// overriding the value of any tag with a matching key.
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	return dc.Tags.Merge(tags)
+}
+
+// KeyValueTags is a standard implementation for AWS key-value resource tags.
+// The AWS Go SDK is split into multiple service packages, each service with
+// its own Go struct type representing a resource tag. To standardize logic
+// across all these Go types, we convert them into this Go type.
+type KeyValueTags map[string]*string
+
+// IgnoreAws returns non-AWS tag keys.
+func (tags KeyValueTags) IgnoreAws() KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if !strings.HasPrefix(k, AwsTagKeyPrefix) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// IgnoreConfig returns any tags not removed by a given configuration.
+func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
+	if config == nil {
+		return tags
+	}
+
+	result := tags.IgnorePrefixes(config.KeyPrefixes)
+	result = result.Ignore(config.Keys)
+
+	return result
+}
+
+// Ignore returns non-matching tag keys.
+func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := ignoreTags[k]; ok {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnorePrefixes returns non-matching tag key prefixes.
+func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for ignoreTagPrefix := range ignoreTagPrefixes {
+			if strings.HasPrefix(k, ignoreTagPrefix) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// Keys returns tag keys.
+func (tags KeyValueTags) Keys() []string {
+	result := make([]string, 0, len(tags))
+
+	for k := range tags {
+		result = append(result, k)
+	}
+
+	return result
+}
+
+// Map returns tag keys mapped to their values.
+func (tags KeyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		if v == nil {
+			result[k] = ""
+			continue
+		}
+
+		result[k] = *v
+	}
+
+	return result
+}
+
+// Merge adds missing and updates existing tags.
+func (tags KeyValueTags) Merge(mergeTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	for k, v := range mergeTags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Only returns matching tag keys.
+func (tags KeyValueTags) Only(onlyTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := onlyTags[k]; !ok {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// Removed returns tags removed.
+func (tags KeyValueTags) Removed(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// RemoveDefaultConfig returns tags not matching (both key and value) a
+// configured default tag.
+func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if defaultVal, ok := dc.Tags[k]; !ok || !stringPointerEqual(defaultVal, v) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns tags added and updated.
+func (tags KeyValueTags) Updated(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, newV := range newTags {
+		if oldV, ok := tags[k]; !ok || !stringPointerEqual(oldV, newV) {
+			result[k] = newV
+		}
+	}
+
+	return result
+}
+
+// Chunks returns a slice of KeyValueTags, each of the specified size.
+// This is useful for services with a limit on the number of tags per call.
+func (tags KeyValueTags) Chunks(size int) []KeyValueTags {
+	result := []KeyValueTags{}
+
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	for i := 0; i < len(keys); i += size {
+		end := i + size
+
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		chunk := make(KeyValueTags)
+
+		for _, k := range keys[i:end] {
+			chunk[k] = tags[k]
+		}
+
+		result = append(result, chunk)
+	}
+
+	return result
+}
+
+// Equal returns whether or not two sets of key-value tags are equal.
+func (tags KeyValueTags) Equal(other KeyValueTags) bool {
+	if tags == nil && other == nil {
+		return true
+	}
+
+	if tags == nil || other == nil {
+		return false
+	}
+
+	if len(tags) != len(other) {
+		return false
+	}
+
+	for k, v := range tags {
+		o, ok := other[k]
+
+		if !ok || !stringPointerEqual(v, o) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hash returns a stable hash value.
+// The returned value may be negative (i.e. not suitable for a 'Set' function).
+func (tags KeyValueTags) Hash() int {
+	hash := 0
+
+	for k, v := range tags {
+		if v == nil {
+			hash = hash ^ hashcode.String(k)
+			continue
+		}
+
+		hash = hash ^ hashcode.String(fmt.Sprintf("%s-%s", k, *v))
+	}
+
+	return hash
+}
+
+// New creates KeyValueTags from common Terraform Provider SDK types.
+// Supports map[string]string, map[string]*string, map[string]interface{}, and []interface{}.
+// When passed []interface{}, all elements are treated as keys and assigned nil values.
+func New(i interface{}) KeyValueTags {
+	switch value := i.(type) {
+	case KeyValueTags:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			kvtm[k] = v
+		}
+
+		return kvtm
+	case map[string]string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v // Prevent referencing issues
+			kvtm[k] = &str
+		}
+
+		return kvtm
+	case map[string]*string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			kvtm[k] = v
+		}
+
+		return kvtm
+	case map[string]interface{}:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := fmt.Sprintf("%v", v)
+			kvtm[k] = &str
+		}
+
+		return kvtm
+	case []string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for _, v := range value {
+			kvtm[v] = nil
+		}
+
+		return kvtm
+	case []interface{}:
+		kvtm := make(KeyValueTags, len(value))
+
+		for _, v := range value {
+			kvtm[v.(string)] = nil
+		}
+
+		return kvtm
+	default:
+		return make(KeyValueTags)
+	}
+}
+
+func stringPointerEqual(s1, s2 *string) bool {
+	if s1 == nil && s2 == nil {
+		return true
+	}
+
+	if s1 == nil || s2 == nil {
+		return false
+	}
+
+	return *s1 == *s2
+}
@@ -0,0 +1,586 @@
+package keyvaluetags
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		tags          KeyValueTags
+		want          map[string]string
+	}{
+		{
+			name:          "nil default config",
+			defaultConfig: nil,
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no overlap",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"owner": "team",
+				}),
+			},
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1":  "value1",
+				"owner": "team",
+			},
+		},
+		{
+			name: "resource tag overrides default",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"owner": "team",
+				}),
+			},
+			tags: New(map[string]string{
+				"owner": "other",
+			}),
+			want: map[string]string{
+				"owner": "other",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.MergeTags(testCase.tags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreAws(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"aws:cloudformation:key2": "value2",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"key2":                    "value2",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreAws()
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreConfig(t *testing.T) {
+	testCases := []struct {
+		name         string
+		tags         KeyValueTags
+		ignoreConfig *IgnoreConfig
+		want         map[string]string
+	}{
+		{
+			name: "nil config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			ignoreConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "keys",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{"key1"}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "key prefixes",
+			tags: New(map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"key2":                       "value2",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPrefixes: New([]string{"kubernetes.io/"}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys and key prefixes",
+			tags: New(map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"key2":                       "value2",
+				"key3":                       "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"key3"}),
+				KeyPrefixes: New([]string{"kubernetes.io/"}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreConfig(testCase.ignoreConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsKeys(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want []string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: []string{},
+		},
+		{
+			name: "non-empty",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: []string{
+				"key1",
+				"key2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Keys()
+
+			testKeyValueTagsVerifyKeys(t, got, testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsMerge(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tags      KeyValueTags
+		mergeTags KeyValueTags
+		want      map[string]string
+	}{
+		{
+			name:      "empty",
+			tags:      New(map[string]string{}),
+			mergeTags: New(map[string]string{}),
+			want:      map[string]string{},
+		},
+		{
+			name: "add_all",
+			tags: New(map[string]string{}),
+			mergeTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			mergeTags: New(map[string]string{
+				"key1": "value1updated",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Merge(testCase.mergeTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsOnly(t *testing.T) {
+	tags := New(map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+	})
+
+	got := tags.Only(New([]string{"key2", "key3"}))
+
+	testKeyValueTagsVerifyMap(t, got.Map(), map[string]string{
+		"key2": "value2",
+	})
+}
+
+func TestKeyValueTagsRemoved(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: New(map[string]string{}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "all_new",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "mixed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1updated",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.Removed(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "nil default config",
+			tags: New(map[string]string{
+				"owner": "team",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"owner": "team",
+			},
+		},
+		{
+			name: "matching default removed",
+			tags: New(map[string]string{
+				"key1":  "value1",
+				"owner": "team",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"owner": "team",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "differing value kept",
+			tags: New(map[string]string{
+				"owner": "other",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"owner": "team",
+				}),
+			},
+			want: map[string]string{
+				"owner": "other",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.RemoveDefaultConfig(testCase.defaultConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsUpdated(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: New(map[string]string{}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "all_new",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "mixed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1updated",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.Updated(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsChunks(t *testing.T) {
+	tags := New(map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+		"key3": "value3",
+	})
+
+	got := tags.Chunks(2)
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 chunks, got %d", len(got))
+	}
+
+	testKeyValueTagsVerifyMap(t, got[0].Merge(got[1]).Map(), tags.Map())
+
+	if len(got[0]) != 2 || len(got[1]) != 1 {
+		t.Fatalf("unexpected chunk sizes: %d, %d", len(got[0]), len(got[1]))
+	}
+}
+
+func TestKeyValueTagsEqual(t *testing.T) {
+	testCases := []struct {
+		name  string
+		tags  KeyValueTags
+		other KeyValueTags
+		want  bool
+	}{
+		{
+			name:  "both nil",
+			tags:  nil,
+			other: nil,
+			want:  true,
+		},
+		{
+			name:  "one nil",
+			tags:  New(map[string]string{}),
+			other: nil,
+			want:  false,
+		},
+		{
+			name:  "equal",
+			tags:  New(map[string]string{"key1": "value1"}),
+			other: New(map[string]string{"key1": "value1"}),
+			want:  true,
+		},
+		{
+			name:  "different value",
+			tags:  New(map[string]string{"key1": "value1"}),
+			other: New(map[string]string{"key1": "value2"}),
+			want:  false,
+		},
+		{
+			name:  "different key",
+			tags:  New(map[string]string{"key1": "value1"}),
+			other: New(map[string]string{"key2": "value1"}),
+			want:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Equal(testCase.other)
+
+			if got != testCase.want {
+				t.Fatalf("expected %t, got %t", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsHash(t *testing.T) {
+	tags1 := New(map[string]string{"key1": "value1", "key2": "value2"})
+	tags2 := New(map[string]string{"key2": "value2", "key1": "value1"})
+	tags3 := New(map[string]string{"key1": "value1", "key2": "value3"})
+
+	if tags1.Hash() != tags2.Hash() {
+		t.Fatalf("expected equal hashes for equal tags")
+	}
+
+	if tags1.Hash() == tags3.Hash() {
+		t.Fatalf("expected different hashes for different tags")
+	}
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name   string
+		source interface{}
+		want   map[string]string
+	}{
+		{
+			name:   "nil",
+			source: nil,
+			want:   map[string]string{},
+		},
+		{
+			name: "map_string_interface",
+			source: map[string]interface{}{
+				"key1": "value1",
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "map_string_string",
+			source: map[string]string{
+				"key1": "value1",
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "interface_slice",
+			source: []interface{}{
+				"key1",
+			},
+			want: map[string]string{
+				"key1": "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := New(testCase.source)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func testKeyValueTagsVerifyKeys(t *testing.T, got []string, want []string) {
+	sort.Strings(got)
+	sort.Strings(want)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected keys %v, got %v", want, got)
+	}
+}
+
+func testKeyValueTagsVerifyMap(t *testing.T, got map[string]string, want map[string]string) {
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
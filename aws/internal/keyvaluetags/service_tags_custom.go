@@ -0,0 +1,23 @@
+package keyvaluetags
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+)
+
+// Custom service tag functions using the same format as generated code.
+
+// ElbTagKeys returns elb service tag keys.
+func (tags KeyValueTags) ElbTagKeys() []*elb.TagKeyOnly {
+	result := make([]*elb.TagKeyOnly, 0, len(tags))
+
+	for k := range tags.Map() {
+		tagKey := &elb.TagKeyOnly{
+			Key: aws.String(k),
+		}
+
+		result = append(result, tagKey)
+	}
+
+	return result
+}
@@ -0,0 +1,73 @@
+package keyvaluetags
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestEc2KeyValueTags(t *testing.T) {
+	tags := []*ec2.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("aws:cloudformation:stack-name"),
+			Value: aws.String("stack"),
+		},
+	}
+
+	got := Ec2KeyValueTags(tags).IgnoreAws()
+
+	testKeyValueTagsVerifyMap(t, got.Map(), map[string]string{
+		"key1": "value1",
+	})
+
+	roundTrip := Ec2KeyValueTags(got.Ec2Tags())
+
+	if !roundTrip.Equal(got) {
+		t.Errorf("expected round trip %v to equal %v", roundTrip.Map(), got.Map())
+	}
+}
+
+func TestKmsKeyValueTags(t *testing.T) {
+	tags := New(map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+	})
+
+	kmsTags := tags.KmsTags()
+
+	if len(kmsTags) != 2 {
+		t.Fatalf("expected 2 KMS tags, got %d", len(kmsTags))
+	}
+
+	for _, tag := range kmsTags {
+		if aws.StringValue(tag.TagValue) != tags.Map()[aws.StringValue(tag.TagKey)] {
+			t.Errorf("unexpected KMS tag: %s", tag)
+		}
+	}
+
+	got := KmsKeyValueTags(kmsTags)
+
+	if !got.Equal(tags) {
+		t.Errorf("expected %v to equal %v", got.Map(), tags.Map())
+	}
+}
+
+func TestSqsKeyValueTags(t *testing.T) {
+	tags := map[string]*string{
+		"key1": aws.String("value1"),
+	}
+
+	got := SqsKeyValueTags(tags)
+
+	testKeyValueTagsVerifyMap(t, got.Map(), map[string]string{
+		"key1": "value1",
+	})
+	testKeyValueTagsVerifyMap(t, aws.StringValueMap(got.SqsTags()), map[string]string{
+		"key1": "value1",
+	})
+}
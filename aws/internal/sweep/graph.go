@@ -0,0 +1,118 @@
+package sweep
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// Graph is the dependency graph between sweepers. A sweeper depends on the
+// sweepers that delete the resources which would otherwise block its own
+// deletions, and runs after them.
+type Graph struct {
+	dependencies map[string][]string
+}
+
+// NewGraph returns an empty Graph.
+func NewGraph() *Graph {
+	return &Graph{
+		dependencies: make(map[string][]string),
+	}
+}
+
+// Add adds a sweeper and the names of the sweepers it depends on.
+func (g *Graph) Add(name string, dependencies []string) error {
+	if _, ok := g.dependencies[name]; ok {
+		return fmt.Errorf("sweeper (%s) is already registered", name)
+	}
+
+	g.dependencies[name] = append([]string{}, dependencies...)
+
+	return nil
+}
+
+// Dependencies returns the names of the sweepers that the sweeper depends on.
+func (g *Graph) Dependencies(name string) []string {
+	return g.dependencies[name]
+}
+
+// Validate returns an error for every dependency on a sweeper that is not
+// registered and for every dependency cycle.
+func (g *Graph) Validate() error {
+	var errors *multierror.Error
+
+	for _, name := range g.names() {
+		for _, dependency := range g.dependencies[name] {
+			if _, ok := g.dependencies[dependency]; !ok {
+				errors = multierror.Append(errors, fmt.Errorf("sweeper (%s) depends on unknown sweeper (%s)", name, dependency))
+			}
+		}
+	}
+
+	if _, err := g.Order(); err != nil {
+		errors = multierror.Append(errors, err)
+	}
+
+	return errors.ErrorOrNil()
+}
+
+// Order returns the names of all sweepers, each one after the sweepers it
+// depends on. Unknown dependencies are ignored.
+func (g *Graph) Order() ([]string, error) {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	state := make(map[string]int)
+	order := make([]string, 0, len(g.dependencies))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("sweeper dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		}
+
+		state[name] = visiting
+
+		for _, dependency := range g.dependencies[name] {
+			if _, ok := g.dependencies[dependency]; !ok {
+				continue
+			}
+
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+		order = append(order, name)
+
+		return nil
+	}
+
+	for _, name := range g.names() {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+func (g *Graph) names() []string {
+	names := make([]string, 0, len(g.dependencies))
+
+	for name := range g.dependencies {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
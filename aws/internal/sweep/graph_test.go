@@ -0,0 +1,67 @@
+package sweep
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGraphOrder(t *testing.T) {
+	g := NewGraph()
+
+	for name, dependencies := range map[string][]string{
+		"aws_vpc":               {"aws_subnet", "aws_internet_gateway"},
+		"aws_subnet":            {"aws_instance"},
+		"aws_internet_gateway":  {"aws_instance"},
+		"aws_instance":          nil,
+		"aws_iam_role":          {"aws_lambda_function"},
+		"aws_lambda_function":   nil,
+		"aws_security_group":    {"aws_instance", "aws_elasticache_cluster"},
+		"aws_elasticache_group": nil,
+	} {
+		if err := g.Add(name, dependencies); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	order, err := g.Order()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"aws_elasticache_group",
+		"aws_lambda_function",
+		"aws_iam_role",
+		"aws_instance",
+		"aws_internet_gateway",
+		"aws_security_group",
+		"aws_subnet",
+		"aws_vpc",
+	}
+
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("got order %v, expected %v", order, expected)
+	}
+
+	err = g.Validate()
+	if err == nil || !strings.Contains(err.Error(), "sweeper (aws_security_group) depends on unknown sweeper (aws_elasticache_cluster)") {
+		t.Errorf("got error %v, expected unknown sweeper error", err)
+	}
+
+	if err := g.Add("aws_vpc", nil); err == nil {
+		t.Error("expected error adding a sweeper twice")
+	}
+}
+
+func TestGraphOrderCycle(t *testing.T) {
+	g := NewGraph()
+
+	g.Add("aws_a", []string{"aws_b"})
+	g.Add("aws_b", []string{"aws_c"})
+	g.Add("aws_c", []string{"aws_a"})
+
+	if _, err := g.Order(); err == nil || err.Error() != "sweeper dependency cycle: aws_a -> aws_b -> aws_c -> aws_a" {
+		t.Errorf("got error %v, expected dependency cycle", err)
+	}
+}
@@ -0,0 +1,297 @@
+// Package sweep implements the filtering, reporting and deletion shared by the
+// acceptance test sweepers. A sweeper lists the resources of one type, wraps
+// each one in a Resource with a function that deletes it and passes them to
+// Options.Sweep, which selects the resources to delete with the sweeper's own
+// Filter and the global Options.Filter.
+package sweep
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+// Resource is a resource that a sweeper can delete.
+type Resource struct {
+	// ID identifies the resource in reports and errors.
+	ID string
+
+	// Name is matched against the name filters. Defaults to ID.
+	Name string
+
+	// Tags are the resource tags, nil when the listing does not return them.
+	Tags keyvaluetags.KeyValueTags
+
+	// CreatedAt is when the resource was created, zero when unknown.
+	CreatedAt time.Time
+
+	// Delete deletes the resource and waits for any deletion it depends on,
+	// such as removing the resource's children, to complete.
+	Delete func() error
+}
+
+func (r *Resource) name() string {
+	if r.Name != "" {
+		return r.Name
+	}
+
+	return r.ID
+}
+
+func (r *Resource) String() string {
+	if r.Name != "" && r.Name != r.ID {
+		return fmt.Sprintf("%s (%s)", r.ID, r.Name)
+	}
+
+	return r.ID
+}
+
+// Filter selects the resources to delete. A resource must match every
+// configured condition, an empty Filter matches all resources.
+type Filter struct {
+	// NamePrefixes and NamePatterns match the resource name, which must match
+	// at least one of them when either is set.
+	NamePrefixes []string
+	NamePatterns []*regexp.Regexp
+
+	// Tags must all be present on the resource. A nil value matches any
+	// value. Resources whose tags are not known never match.
+	Tags keyvaluetags.KeyValueTags
+
+	// MinAge is the minimum time since the resource was created. Resources
+	// whose creation time is not known never match.
+	MinAge time.Duration
+}
+
+// Match returns whether the resource matches the filter and, when it does
+// not, the reason why.
+func (f Filter) Match(r *Resource, now time.Time) (bool, string) {
+	if len(f.NamePrefixes) > 0 || len(f.NamePatterns) > 0 {
+		if !f.matchName(r.name()) {
+			return false, "name does not match"
+		}
+	}
+
+	if len(f.Tags) > 0 {
+		if r.Tags == nil {
+			return false, "tags are not known"
+		}
+
+		for _, k := range f.Tags.Keys() {
+			v, ok := r.Tags[k]
+			if !ok {
+				return false, fmt.Sprintf("tag %q is not set", k)
+			}
+
+			if want := f.Tags[k]; want != nil && (v == nil || *v != *want) {
+				return false, fmt.Sprintf("tag %q does not match", k)
+			}
+		}
+	}
+
+	if f.MinAge > 0 {
+		if r.CreatedAt.IsZero() {
+			return false, "creation time is not known"
+		}
+
+		if age := now.Sub(r.CreatedAt); age < f.MinAge {
+			return false, fmt.Sprintf("created %s ago", age.Truncate(time.Second))
+		}
+	}
+
+	return true, ""
+}
+
+func (f Filter) matchName(name string) bool {
+	for _, prefix := range f.NamePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	for _, pattern := range f.NamePatterns {
+		if pattern.MatchString(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseTags parses a comma separated list of key=value or key tag filters.
+func ParseTags(s string) (keyvaluetags.KeyValueTags, error) {
+	tags := keyvaluetags.KeyValueTags{}
+
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+
+		if part == "" {
+			continue
+		}
+
+		kv := strings.SplitN(part, "=", 2)
+		if kv[0] == "" {
+			return nil, fmt.Errorf("unexpected format of tag filter (%q), expected KEY or KEY=VALUE", part)
+		}
+
+		if len(kv) == 1 {
+			tags[kv[0]] = nil
+			continue
+		}
+
+		v := kv[1]
+		tags[kv[0]] = &v
+	}
+
+	return tags, nil
+}
+
+// Options are the settings shared by all sweepers in a run.
+type Options struct {
+	// DryRun reports the resources that would be deleted without deleting
+	// them.
+	DryRun bool
+
+	// Filter is applied to every sweeper in addition to its own filter.
+	Filter Filter
+
+	// Parallelism is the number of concurrent deletions, at least 1.
+	Parallelism int
+
+	// RateLimit is the maximum number of deletions started per second, 0 for
+	// no limit.
+	RateLimit float64
+
+	// Report receives the dry-run report.
+	Report io.Writer
+
+	// Now returns the current time, time.Now when nil.
+	Now func() time.Time
+}
+
+// Sweep deletes the resources that match both the sweeper's filter and the
+// global filter. In dry-run mode it writes a report of every listed resource
+// and whether it would be deleted instead.
+func (o *Options) Sweep(region, resourceType string, filter Filter, resources []*Resource) error {
+	now := time.Now()
+	if o.Now != nil {
+		now = o.Now()
+	}
+
+	var matches []*Resource
+	reasons := make(map[*Resource]string)
+
+	for _, r := range resources {
+		ok, reason := filter.Match(r, now)
+		if ok {
+			ok, reason = o.Filter.Match(r, now)
+		}
+
+		if !ok {
+			reasons[r] = reason
+			continue
+		}
+
+		matches = append(matches, r)
+	}
+
+	if o.DryRun {
+		return o.report(region, resourceType, resources, reasons, len(matches))
+	}
+
+	if len(matches) == 0 {
+		log.Printf("[DEBUG] No %s resources to sweep in %s (%d listed)", resourceType, region, len(resources))
+		return nil
+	}
+
+	log.Printf("[INFO] Sweeping %d of %d %s resources in %s", len(matches), len(resources), resourceType, region)
+
+	return o.delete(resourceType, matches)
+}
+
+func (o *Options) report(region, resourceType string, resources []*Resource, reasons map[*Resource]string, matches int) error {
+	if o.Report == nil {
+		return nil
+	}
+
+	sorted := make([]*Resource, len(resources))
+	copy(sorted, resources)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	w := tabwriter.NewWriter(o.Report, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintf(w, "%s in %s: would delete %d of %d\n", resourceType, region, matches, len(resources))
+
+	for _, r := range sorted {
+		if reason, ok := reasons[r]; ok {
+			fmt.Fprintf(w, "  keep\t%s\t%s\n", r, reason)
+			continue
+		}
+
+		fmt.Fprintf(w, "  delete\t%s\t\n", r)
+	}
+
+	return w.Flush()
+}
+
+func (o *Options) delete(resourceType string, resources []*Resource) error {
+	parallelism := o.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	var throttle <-chan time.Time
+	if o.RateLimit > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / o.RateLimit))
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
+	queue := make(chan *Resource)
+
+	var errors *multierror.Error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for r := range queue {
+				log.Printf("[INFO] Deleting %s: %s", resourceType, r)
+
+				if err := r.Delete(); err != nil {
+					mu.Lock()
+					errors = multierror.Append(errors, fmt.Errorf("error deleting %s (%s): %s", resourceType, r.ID, err))
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i, r := range resources {
+		if throttle != nil && i > 0 {
+			<-throttle
+		}
+
+		queue <- r
+	}
+
+	close(queue)
+	wg.Wait()
+
+	return errors.ErrorOrNil()
+}
@@ -0,0 +1,237 @@
+package sweep
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func TestFilterMatch(t *testing.T) {
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		filter     Filter
+		resource   *Resource
+		wantMatch  bool
+		wantReason string
+	}{
+		{
+			name:      "empty filter",
+			filter:    Filter{},
+			resource:  &Resource{ID: "anything"},
+			wantMatch: true,
+		},
+		{
+			name:      "name prefix",
+			filter:    Filter{NamePrefixes: []string{"tf-acc-test-", "terraform-"}},
+			resource:  &Resource{ID: "i-12345678", Name: "terraform-20190601"},
+			wantMatch: true,
+		},
+		{
+			name:       "name prefix mismatch",
+			filter:     Filter{NamePrefixes: []string{"tf-acc-test-"}},
+			resource:   &Resource{ID: "i-12345678", Name: "production"},
+			wantReason: "name does not match",
+		},
+		{
+			name:      "name defaults to ID",
+			filter:    Filter{NamePrefixes: []string{"tf-acc-test-"}},
+			resource:  &Resource{ID: "tf-acc-test-1"},
+			wantMatch: true,
+		},
+		{
+			name:      "name pattern",
+			filter:    Filter{NamePrefixes: []string{"tf-"}, NamePatterns: []*regexp.Regexp{regexp.MustCompile(`-test-\d+$`)}},
+			resource:  &Resource{ID: "acc-test-123"},
+			wantMatch: true,
+		},
+		{
+			name:      "tag value",
+			filter:    Filter{Tags: keyvaluetags.New(map[string]string{"Environment": "test"})},
+			resource:  &Resource{ID: "r", Tags: keyvaluetags.New(map[string]string{"Environment": "test", "Name": "r"})},
+			wantMatch: true,
+		},
+		{
+			name:       "tag value mismatch",
+			filter:     Filter{Tags: keyvaluetags.New(map[string]string{"Environment": "test"})},
+			resource:   &Resource{ID: "r", Tags: keyvaluetags.New(map[string]string{"Environment": "production"})},
+			wantReason: `tag "Environment" does not match`,
+		},
+		{
+			name:      "tag key",
+			filter:    Filter{Tags: keyvaluetags.KeyValueTags{"Sweepable": nil}},
+			resource:  &Resource{ID: "r", Tags: keyvaluetags.New(map[string]string{"Sweepable": "yes"})},
+			wantMatch: true,
+		},
+		{
+			name:       "tag key missing",
+			filter:     Filter{Tags: keyvaluetags.KeyValueTags{"Sweepable": nil}},
+			resource:   &Resource{ID: "r", Tags: keyvaluetags.KeyValueTags{}},
+			wantReason: `tag "Sweepable" is not set`,
+		},
+		{
+			name:       "tags unknown",
+			filter:     Filter{Tags: keyvaluetags.KeyValueTags{"Sweepable": nil}},
+			resource:   &Resource{ID: "r"},
+			wantReason: "tags are not known",
+		},
+		{
+			name:      "old enough",
+			filter:    Filter{MinAge: time.Hour},
+			resource:  &Resource{ID: "r", CreatedAt: now.Add(-2 * time.Hour)},
+			wantMatch: true,
+		},
+		{
+			name:       "too new",
+			filter:     Filter{MinAge: time.Hour},
+			resource:   &Resource{ID: "r", CreatedAt: now.Add(-10 * time.Minute)},
+			wantReason: "created 10m0s ago",
+		},
+		{
+			name:       "creation time unknown",
+			filter:     Filter{MinAge: time.Hour},
+			resource:   &Resource{ID: "r"},
+			wantReason: "creation time is not known",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			match, reason := testCase.filter.Match(testCase.resource, now)
+
+			if match != testCase.wantMatch {
+				t.Errorf("got match %t, expected %t", match, testCase.wantMatch)
+			}
+
+			if reason != testCase.wantReason {
+				t.Errorf("got reason %q, expected %q", reason, testCase.wantReason)
+			}
+		})
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	tags, err := ParseTags("Environment=test, Sweepable ,Empty=")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := tags.Keys(), []string{"Empty", "Environment", "Sweepable"}; !reflect.DeepEqual(sortedStrings(got), want) {
+		t.Errorf("got keys %v, expected %v", got, want)
+	}
+
+	if v := tags["Environment"]; v == nil || *v != "test" {
+		t.Errorf("got Environment %v, expected test", v)
+	}
+
+	if v := tags["Sweepable"]; v != nil {
+		t.Errorf("got Sweepable %q, expected any value", *v)
+	}
+
+	if v := tags["Empty"]; v == nil || *v != "" {
+		t.Errorf("got Empty %v, expected empty value", v)
+	}
+
+	if _, err := ParseTags("=value"); err == nil {
+		t.Error("expected error for empty key")
+	}
+}
+
+func TestOptionsSweep(t *testing.T) {
+	var mu sync.Mutex
+	var deleted []string
+
+	resource := func(id string, err error) *Resource {
+		return &Resource{
+			ID: id,
+			Delete: func() error {
+				mu.Lock()
+				defer mu.Unlock()
+				deleted = append(deleted, id)
+				return err
+			},
+		}
+	}
+
+	resources := []*Resource{
+		resource("tf-acc-test-1", nil),
+		resource("tf-acc-test-2", errors.New("DependencyViolation")),
+		resource("tf-acc-test-keep", nil),
+		resource("production", nil),
+	}
+
+	filter := Filter{NamePrefixes: []string{"tf-acc-test-"}}
+
+	t.Run("dry run", func(t *testing.T) {
+		deleted = nil
+
+		var report bytes.Buffer
+		options := &Options{
+			DryRun: true,
+			Filter: Filter{NamePatterns: []*regexp.Regexp{regexp.MustCompile(`\d$`)}},
+			Report: &report,
+		}
+
+		if err := options.Sweep("us-west-2", "aws_vpc", filter, resources); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(deleted) > 0 {
+			t.Errorf("dry run deleted %v", deleted)
+		}
+
+		expected := []string{
+			"aws_vpc in us-west-2: would delete 2 of 4",
+			"  keep    production        name does not match",
+			"  delete  tf-acc-test-1",
+			"  delete  tf-acc-test-2",
+			"  keep    tf-acc-test-keep  name does not match",
+		}
+
+		if got := strings.Split(strings.TrimRight(report.String(), "\n"), "\n"); !reflect.DeepEqual(trimLines(got), expected) {
+			t.Errorf("got report:\n%s\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		deleted = nil
+
+		options := &Options{
+			Parallelism: 2,
+			RateLimit:   1000,
+		}
+
+		err := options.Sweep("us-west-2", "aws_vpc", filter, resources)
+
+		if err == nil || !strings.Contains(err.Error(), "error deleting aws_vpc (tf-acc-test-2): DependencyViolation") {
+			t.Errorf("got error %v, expected tf-acc-test-2 deletion error", err)
+		}
+
+		if got, want := sortedStrings(deleted), []string{"tf-acc-test-1", "tf-acc-test-2", "tf-acc-test-keep"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("got deleted %v, expected %v", got, want)
+		}
+	})
+}
+
+func sortedStrings(s []string) []string {
+	sorted := append([]string{}, s...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func trimLines(lines []string) []string {
+	trimmed := make([]string, len(lines))
+	for i, line := range lines {
+		trimmed[i] = strings.TrimRight(line, " ")
+	}
+	return trimmed
+}
@@ -0,0 +1,367 @@
+package aws
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	lexVersionLatest = "$LATEST"
+
+	lexNameRegexp = `^([A-Za-z]_?)+$`
+)
+
+var lexMessageResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"content": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"content_type": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				lexmodelbuildingservice.ContentTypeCustomPayload,
+				lexmodelbuildingservice.ContentTypePlainText,
+				lexmodelbuildingservice.ContentTypeSsml,
+			}, false),
+		},
+		"group_number": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 5),
+		},
+	},
+}
+
+var lexStatementResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"message": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 15,
+			Elem:     lexMessageResource,
+		},
+		"response_card": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 50000),
+		},
+	},
+}
+
+var lexPromptResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"max_attempts": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 5),
+		},
+		"message": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 15,
+			Elem:     lexMessageResource,
+		},
+		"response_card": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 50000),
+		},
+	},
+}
+
+var lexCodeHookResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"message_version": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 5),
+		},
+		"uri": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateArn,
+		},
+	},
+}
+
+func expandLexMessages(l []interface{}) []*lexmodelbuildingservice.Message {
+	messages := make([]*lexmodelbuildingservice.Message, 0, len(l))
+
+	for _, v := range l {
+		m := v.(map[string]interface{})
+
+		message := &lexmodelbuildingservice.Message{
+			Content:     aws.String(m["content"].(string)),
+			ContentType: aws.String(m["content_type"].(string)),
+		}
+
+		if v, ok := m["group_number"].(int); ok && v > 0 {
+			message.GroupNumber = aws.Int64(int64(v))
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages
+}
+
+func flattenLexMessages(messages []*lexmodelbuildingservice.Message) *schema.Set {
+	l := make([]interface{}, 0, len(messages))
+
+	for _, message := range messages {
+		l = append(l, map[string]interface{}{
+			"content":      aws.StringValue(message.Content),
+			"content_type": aws.StringValue(message.ContentType),
+			"group_number": int(aws.Int64Value(message.GroupNumber)),
+		})
+	}
+
+	return schema.NewSet(schema.HashResource(lexMessageResource), l)
+}
+
+func expandLexStatement(l []interface{}) *lexmodelbuildingservice.Statement {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	statement := &lexmodelbuildingservice.Statement{
+		Messages: expandLexMessages(m["message"].(*schema.Set).List()),
+	}
+
+	if v, ok := m["response_card"].(string); ok && v != "" {
+		statement.ResponseCard = aws.String(v)
+	}
+
+	return statement
+}
+
+func flattenLexStatement(statement *lexmodelbuildingservice.Statement) []interface{} {
+	if statement == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"message":       flattenLexMessages(statement.Messages),
+			"response_card": aws.StringValue(statement.ResponseCard),
+		},
+	}
+}
+
+func expandLexPrompt(l []interface{}) *lexmodelbuildingservice.Prompt {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	prompt := &lexmodelbuildingservice.Prompt{
+		MaxAttempts: aws.Int64(int64(m["max_attempts"].(int))),
+		Messages:    expandLexMessages(m["message"].(*schema.Set).List()),
+	}
+
+	if v, ok := m["response_card"].(string); ok && v != "" {
+		prompt.ResponseCard = aws.String(v)
+	}
+
+	return prompt
+}
+
+func flattenLexPrompt(prompt *lexmodelbuildingservice.Prompt) []interface{} {
+	if prompt == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"max_attempts":  int(aws.Int64Value(prompt.MaxAttempts)),
+			"message":       flattenLexMessages(prompt.Messages),
+			"response_card": aws.StringValue(prompt.ResponseCard),
+		},
+	}
+}
+
+func expandLexCodeHook(l []interface{}) *lexmodelbuildingservice.CodeHook {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.CodeHook{
+		MessageVersion: aws.String(m["message_version"].(string)),
+		Uri:            aws.String(m["uri"].(string)),
+	}
+}
+
+func flattenLexCodeHook(codeHook *lexmodelbuildingservice.CodeHook) []interface{} {
+	if codeHook == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"message_version": aws.StringValue(codeHook.MessageVersion),
+			"uri":             aws.StringValue(codeHook.Uri),
+		},
+	}
+}
+
+// lexLatestVersion returns the highest numbered version from a list of Lex
+// versions, or $LATEST when no version has been published.
+func lexLatestVersion(versions []string) string {
+	latest := 0
+
+	for _, version := range versions {
+		if v, err := strconv.Atoi(version); err == nil && v > latest {
+			latest = v
+		}
+	}
+
+	if latest == 0 {
+		return lexVersionLatest
+	}
+
+	return strconv.Itoa(latest)
+}
+
+func getLexBotLatestVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	input := &lexmodelbuildingservice.GetBotVersionsInput{
+		Name: aws.String(name),
+	}
+	var versions []string
+
+	err := conn.GetBotVersionsPages(input, func(page *lexmodelbuildingservice.GetBotVersionsOutput, lastPage bool) bool {
+		for _, bot := range page.Bots {
+			versions = append(versions, aws.StringValue(bot.Version))
+		}
+
+		return !lastPage
+	})
+
+	return lexLatestVersion(versions), err
+}
+
+func getLexIntentLatestVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	input := &lexmodelbuildingservice.GetIntentVersionsInput{
+		Name: aws.String(name),
+	}
+	var versions []string
+
+	err := conn.GetIntentVersionsPages(input, func(page *lexmodelbuildingservice.GetIntentVersionsOutput, lastPage bool) bool {
+		for _, intent := range page.Intents {
+			versions = append(versions, aws.StringValue(intent.Version))
+		}
+
+		return !lastPage
+	})
+
+	return lexLatestVersion(versions), err
+}
+
+func getLexSlotTypeLatestVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	input := &lexmodelbuildingservice.GetSlotTypeVersionsInput{
+		Name: aws.String(name),
+	}
+	var versions []string
+
+	err := conn.GetSlotTypeVersionsPages(input, func(page *lexmodelbuildingservice.GetSlotTypeVersionsOutput, lastPage bool) bool {
+		for _, slotType := range page.SlotTypes {
+			versions = append(versions, aws.StringValue(slotType.Version))
+		}
+
+		return !lastPage
+	})
+
+	return lexLatestVersion(versions), err
+}
+
+func refreshLexBotStatus(conn *lexmodelbuildingservice.LexModelBuildingService, name, version string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(name),
+			VersionOrAlias: aws.String(version),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if aws.StringValue(output.Status) == lexmodelbuildingservice.StatusFailed {
+			return output, lexmodelbuildingservice.StatusFailed, errors.New(aws.StringValue(output.FailureReason))
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitForLexBotBuild(conn *lexmodelbuildingservice.LexModelBuildingService, name, version string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelbuildingservice.StatusBuilding},
+		Target: []string{
+			lexmodelbuildingservice.StatusNotBuilt,
+			lexmodelbuildingservice.StatusReady,
+			lexmodelbuildingservice.StatusReadyBasicTesting,
+		},
+		Refresh: refreshLexBotStatus(conn, name, version),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForLexBotDeletion(conn *lexmodelbuildingservice.LexModelBuildingService, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			lexmodelbuildingservice.StatusBuilding,
+			lexmodelbuildingservice.StatusFailed,
+			lexmodelbuildingservice.StatusNotBuilt,
+			lexmodelbuildingservice.StatusReady,
+			lexmodelbuildingservice.StatusReadyBasicTesting,
+		},
+		Target: []string{},
+		Refresh: func() (interface{}, string, error) {
+			output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+				Name:           aws.String(name),
+				VersionOrAlias: aws.String(lexVersionLatest),
+			})
+
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+				return nil, "", nil
+			}
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			return output, aws.StringValue(output.Status), nil
+		},
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestLexLatestVersion(t *testing.T) {
+	testCases := []struct {
+		Versions []string
+		Expected string
+	}{
+		{
+			Versions: nil,
+			Expected: lexVersionLatest,
+		},
+		{
+			Versions: []string{lexVersionLatest},
+			Expected: lexVersionLatest,
+		},
+		{
+			Versions: []string{lexVersionLatest, "1", "2"},
+			Expected: "2",
+		},
+		{
+			Versions: []string{"9", lexVersionLatest, "10", "2"},
+			Expected: "10",
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := lexLatestVersion(tc.Versions); got != tc.Expected {
+			t.Errorf("lexLatestVersion(%q) = %q, expected %q", tc.Versions, got, tc.Expected)
+		}
+	}
+}
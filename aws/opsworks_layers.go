@@ -44,6 +44,21 @@ var (
 	opsworksFalseString = "false"
 )
 
+// opsworksLayerTypeResourceNames maps layer types to the resource types that
+// manage them, for import error messages.
+var opsworksLayerTypeResourceNames = map[string]string{
+	"custom":            "aws_opsworks_custom_layer",
+	"db-master":         "aws_opsworks_mysql_layer",
+	"java-app":          "aws_opsworks_java_app_layer",
+	"lb":                "aws_opsworks_haproxy_layer",
+	"memcached":         "aws_opsworks_memcached_layer",
+	"monitoring-master": "aws_opsworks_ganglia_layer",
+	"nodejs-app":        "aws_opsworks_nodejs_app_layer",
+	"php-app":           "aws_opsworks_php_app_layer",
+	"rails-app":         "aws_opsworks_rails_app_layer",
+	"web":               "aws_opsworks_static_web_layer",
+}
+
 func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 	resourceSchema := map[string]*schema.Schema{
 		"auto_assign_elastic_ips": {
@@ -251,13 +266,48 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 			return lt.Delete(d, client)
 		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				client := meta.(*AWSClient).opsworksconn
+				return lt.Import(d, client)
+			},
 		},
 
 		Schema: resourceSchema,
 	}
 }
 
+// Import checks that the layer being imported is of this layer type, as
+// all layer types share the same API and IDs.
+func (lt *opsworksLayerType) Import(d *schema.ResourceData, client *opsworks.OpsWorks) ([]*schema.ResourceData, error) {
+	req := &opsworks.DescribeLayersInput{
+		LayerIds: []*string{
+			aws.String(d.Id()),
+		},
+	}
+
+	log.Printf("[DEBUG] Importing OpsWorks layer: %s", d.Id())
+
+	resp, err := client.DescribeLayers(req)
+	if err != nil {
+		return nil, fmt.Errorf("error reading OpsWorks layer (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.Layers) == 0 {
+		return nil, fmt.Errorf("OpsWorks layer (%s) not found", d.Id())
+	}
+
+	layerType := aws.StringValue(resp.Layers[0].Type)
+	if layerType != lt.TypeName {
+		if resourceName, ok := opsworksLayerTypeResourceNames[layerType]; ok {
+			return nil, fmt.Errorf("OpsWorks layer (%s) is a %q layer, not %q: import it as %s instead", d.Id(), layerType, lt.TypeName, resourceName)
+		}
+
+		return nil, fmt.Errorf("OpsWorks layer (%s) is a %q layer, not %q", d.Id(), layerType, lt.TypeName)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func (lt *opsworksLayerType) Read(d *schema.ResourceData, client *opsworks.OpsWorks) error {
 
 	req := &opsworks.DescribeLayersInput{
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+)
+
+func TestOpsworksLayerTypeImport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.Write([]byte(`{"Layers":[{"LayerId":"c3d5f1a2-1111-2222-3333-444455556666","Type":"php-app"}]}`))
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Endpoint:    aws.String(ts.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-east-1"),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	conn := opsworks.New(sess)
+
+	phpAppLayer := &opsworksLayerType{TypeName: "php-app"}
+	d := phpAppLayer.SchemaResource().Data(nil)
+	d.SetId("c3d5f1a2-1111-2222-3333-444455556666")
+
+	if _, err := phpAppLayer.Import(d, conn); err != nil {
+		t.Fatalf("expected import of a php-app layer to succeed, got: %s", err)
+	}
+
+	javaAppLayer := &opsworksLayerType{TypeName: "java-app"}
+	d = javaAppLayer.SchemaResource().Data(nil)
+	d.SetId("c3d5f1a2-1111-2222-3333-444455556666")
+
+	_, err = javaAppLayer.Import(d, conn)
+	if err == nil {
+		t.Fatal("expected import of a php-app layer as java-app to fail")
+	}
+	if !strings.Contains(err.Error(), "import it as aws_opsworks_php_app_layer instead") {
+		t.Fatalf("expected error to name the php-app layer resource, got: %s", err)
+	}
+}
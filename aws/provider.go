@@ -2,9 +2,11 @@ package aws
 
 import (
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -15,7 +17,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -40,6 +42,8 @@ func Provider() terraform.ResourceProvider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -88,8 +92,14 @@ func Provider() terraform.ResourceProvider {
 				Set:           schema.HashString,
 			},
 
+			"default_tags": defaultTagsSchema(),
+
 			"endpoints": endpointsSchema(),
 
+			"ignore_tags": ignoreTagsSchema(),
+
+			"retry": retrySchema(),
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -97,6 +107,46 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["insecure"],
 			},
 
+			"api_audit_log_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["api_audit_log_path"],
+			},
+
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["read_only"],
+			},
+
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["http_proxy"],
+			},
+
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", ""),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_fips_endpoint"],
+			},
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -205,8 +255,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_iam_instance_profile":               dataSourceAwsIAMInstanceProfile(),
 			"aws_iam_policy":                         dataSourceAwsIAMPolicy(),
 			"aws_iam_policy_document":                dataSourceAwsIamPolicyDocument(),
+			"aws_iam_principal_policy_simulation":    dataSourceAwsIAMPrincipalPolicySimulation(),
 			"aws_iam_role":                           dataSourceAwsIAMRole(),
 			"aws_iam_server_certificate":             dataSourceAwsIAMServerCertificate(),
+			"aws_iam_service_last_accessed":          dataSourceAwsIAMServiceLastAccessed(),
 			"aws_iam_user":                           dataSourceAwsIAMUser(),
 			"aws_internet_gateway":                   dataSourceAwsInternetGateway(),
 			"aws_iot_endpoint":                       dataSourceAwsIotEndpoint(),
@@ -266,6 +318,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_vpc_peering_connection":             dataSourceAwsVpcPeeringConnection(),
 			"aws_vpn_gateway":                        dataSourceAwsVpnGateway(),
 			"aws_workspaces_bundle":                  dataSourceAwsWorkspaceBundle(),
+			"aws_workspaces_directory":               dataSourceAwsWorkspacesDirectory(),
 
 			// Adding the Aliases for the ALB -> LB Rename
 			"aws_lb":               dataSourceAwsLb(),
@@ -307,6 +360,17 @@ func Provider() terraform.ResourceProvider {
 			"aws_api_gateway_usage_plan":                              resourceAwsApiGatewayUsagePlan(),
 			"aws_api_gateway_usage_plan_key":                          resourceAwsApiGatewayUsagePlanKey(),
 			"aws_api_gateway_vpc_link":                                resourceAwsApiGatewayVpcLink(),
+			"aws_apigatewayv2_api":                                    resourceAwsApiGatewayV2Api(),
+			"aws_apigatewayv2_api_mapping":                            resourceAwsApiGatewayV2ApiMapping(),
+			"aws_apigatewayv2_authorizer":                             resourceAwsApiGatewayV2Authorizer(),
+			"aws_apigatewayv2_deployment":                             resourceAwsApiGatewayV2Deployment(),
+			"aws_apigatewayv2_domain_name":                            resourceAwsApiGatewayV2DomainName(),
+			"aws_apigatewayv2_integration":                            resourceAwsApiGatewayV2Integration(),
+			"aws_apigatewayv2_integration_response":                   resourceAwsApiGatewayV2IntegrationResponse(),
+			"aws_apigatewayv2_model":                                  resourceAwsApiGatewayV2Model(),
+			"aws_apigatewayv2_route":                                  resourceAwsApiGatewayV2Route(),
+			"aws_apigatewayv2_route_response":                         resourceAwsApiGatewayV2RouteResponse(),
+			"aws_apigatewayv2_stage":                                  resourceAwsApiGatewayV2Stage(),
 			"aws_app_cookie_stickiness_policy":                        resourceAwsAppCookieStickinessPolicy(),
 			"aws_appautoscaling_target":                               resourceAwsAppautoscalingTarget(),
 			"aws_appautoscaling_policy":                               resourceAwsAppautoscalingPolicy(),
@@ -470,6 +534,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_emr_instance_group":                                  resourceAwsEMRInstanceGroup(),
 			"aws_emr_security_configuration":                          resourceAwsEMRSecurityConfiguration(),
 			"aws_flow_log":                                            resourceAwsFlowLog(),
+			"aws_fsx_backup":                                          resourceAwsFsxBackup(),
+			"aws_fsx_lustre_file_system":                              resourceAwsFsxLustreFileSystem(),
+			"aws_fsx_windows_file_system":                             resourceAwsFsxWindowsFileSystem(),
 			"aws_gamelift_alias":                                      resourceAwsGameliftAlias(),
 			"aws_gamelift_build":                                      resourceAwsGameliftBuild(),
 			"aws_gamelift_fleet":                                      resourceAwsGameliftFleet(),
@@ -531,6 +598,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_kinesis_firehose_delivery_stream":                    resourceAwsKinesisFirehoseDeliveryStream(),
 			"aws_kinesis_stream":                                      resourceAwsKinesisStream(),
 			"aws_kinesis_analytics_application":                       resourceAwsKinesisAnalyticsApplication(),
+			"aws_kinesis_video_stream":                                resourceAwsKinesisVideoStream(),
 			"aws_kms_alias":                                           resourceAwsKmsAlias(),
 			"aws_kms_external_key":                                    resourceAwsKmsExternalKey(),
 			"aws_kms_grant":                                           resourceAwsKmsGrant(),
@@ -543,6 +611,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_lambda_layer_version":                                resourceAwsLambdaLayerVersion(),
 			"aws_launch_configuration":                                resourceAwsLaunchConfiguration(),
 			"aws_launch_template":                                     resourceAwsLaunchTemplate(),
+			"aws_lex_bot":                                             resourceAwsLexBot(),
+			"aws_lex_bot_alias":                                       resourceAwsLexBotAlias(),
+			"aws_lex_intent":                                          resourceAwsLexIntent(),
+			"aws_lex_slot_type":                                       resourceAwsLexSlotType(),
 			"aws_licensemanager_association":                          resourceAwsLicenseManagerAssociation(),
 			"aws_licensemanager_license_configuration":                resourceAwsLicenseManagerLicenseConfiguration(),
 			"aws_lightsail_domain":                                    resourceAwsLightsailDomain(),
@@ -651,12 +723,22 @@ func Provider() terraform.ResourceProvider {
 			"aws_ses_template":                                        resourceAwsSesTemplate(),
 			"aws_s3_account_public_access_block":                      resourceAwsS3AccountPublicAccessBlock(),
 			"aws_s3_bucket":                                           resourceAwsS3Bucket(),
+			"aws_s3_bucket_analytics_configuration":                   resourceAwsS3BucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                        resourceAwsS3BucketCorsConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":                   resourceAwsS3BucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                                   resourceAwsS3BucketLogging(),
 			"aws_s3_bucket_policy":                                    resourceAwsS3BucketPolicy(),
 			"aws_s3_bucket_public_access_block":                       resourceAwsS3BucketPublicAccessBlock(),
 			"aws_s3_bucket_object":                                    resourceAwsS3BucketObject(),
+			"aws_s3_bucket_objects_sync":                              resourceAwsS3BucketObjectsSync(),
 			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
 			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
 			"aws_s3_bucket_inventory":                                 resourceAwsS3BucketInventory(),
+			"aws_s3_bucket_replication_configuration":                 resourceAwsS3BucketReplicationConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration":      resourceAwsS3BucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                                resourceAwsS3BucketVersioning(),
+			"aws_s3_bucket_website_configuration":                     resourceAwsS3BucketWebsiteConfiguration(),
+			"aws_s3control_job":                                       resourceAwsS3ControlJob(),
 			"aws_security_group":                                      resourceAwsSecurityGroup(),
 			"aws_network_interface_sg_attachment":                     resourceAwsNetworkInterfaceSGAttachment(),
 			"aws_default_security_group":                              resourceAwsDefaultSecurityGroup(),
@@ -755,6 +837,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_wafregional_web_acl_association":                     resourceAwsWafRegionalWebAclAssociation(),
 			"aws_worklink_fleet":                                      resourceAwsWorkLinkFleet(),
 			"aws_worklink_website_certificate_authority_association":  resourceAwsWorkLinkWebsiteCertificateAuthorityAssociation(),
+			"aws_workspaces_ip_group":                                 resourceAwsWorkspacesIpGroup(),
+			"aws_workspaces_ip_group_association":                     resourceAwsWorkspacesIpGroupAssociation(),
+			"aws_workspaces_workspace":                                resourceAwsWorkspacesWorkspace(),
 			"aws_batch_compute_environment":                           resourceAwsBatchComputeEnvironment(),
 			"aws_batch_job_definition":                                resourceAwsBatchJobDefinition(),
 			"aws_batch_job_queue":                                     resourceAwsBatchJobQueue(),
@@ -790,6 +875,18 @@ func Provider() terraform.ResourceProvider {
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	for _, r := range provider.DataSourcesMap {
+		dataSourceWithTagsConfig(r)
+		dataSourceWithRegionConfig(r)
+	}
+
+	for _, r := range provider.ResourcesMap {
+		resourceWithTagsConfig(r)
+		resourceWithRegionConfig(r)
+	}
+
+	return provider
 }
 
 var descriptions map[string]string
@@ -824,6 +921,22 @@ func init() {
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
 
+		"api_audit_log_path": "Path of a file to which one JSON line is appended per AWS API call, " +
+			"with secret request parameters redacted.",
+
+		"read_only": "Reject AWS API calls which may modify resources, " +
+			"only allowing operations such as Describe, Get and List.",
+
+		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
+			"If omitted, the proxy environment variables are used.",
+
+		"custom_ca_bundle": "File containing custom root and intermediate certificates. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
+		"use_fips_endpoint": "Resolve an endpoint with FIPS capability.",
+
+		"use_dualstack_endpoint": "Resolve an endpoint with DualStack capability.",
+
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
 
@@ -844,6 +957,26 @@ func init() {
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
 
+		"default_tags": "Configuration block with settings to default resource tags across all resources.",
+
+		"default_tags_tags": "Resource tags to default across all resources. " +
+			"Tags configured on a resource take precedence over these.",
+
+		"ignore_tags": "Configuration block with settings to ignore resource tags across all resources.",
+
+		"retry": "Configuration block with settings for retrying and rate limiting AWS API requests.",
+
+		"retry_mode": "The retry mode. Valid values are legacy, standard and adaptive.",
+
+		"retry_max_backoff": "The maximum delay, in seconds, between retries in the standard and adaptive retry modes.",
+
+		"retry_service_request_rates": "Map of AWS Go SDK service names, such as ec2 or route53, " +
+			"to the maximum number of requests per second sent to the service in each region.",
+
+		"ignore_tags_keys": "Resource tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Resource tag key prefixes to ignore across all resources.",
+
 		"assume_role_role_arn": "The ARN of an IAM role to assume prior to making API calls.",
 
 		"assume_role_session_name": "The session name to use when assuming the role. If omitted," +
@@ -855,6 +988,27 @@ func init() {
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
+
+		"assume_role_duration_seconds": "The duration, in seconds, of the role session. " +
+			"Valid values are between 900 and 43200.",
+
+		"assume_role_policy_arns": "ARNs of IAM managed policies to use as managed session policies. " +
+			"The resulting session permissions are the intersection of these and the role's policies.",
+
+		"assume_role_tags": "Session tags to pass when assuming the role.",
+
+		"assume_role_transitive_tag_keys": "Keys of session tags that persist to subsequent sessions in a role chain.",
+
+		"assume_role_with_web_identity": "Configuration block to assume an IAM role using a web identity token, " +
+			"such as an OpenID Connect token issued to a CI runner.",
+
+		"assume_role_with_web_identity_role_arn": "The ARN of the IAM role to assume with the web identity token.",
+
+		"assume_role_with_web_identity_session_name": "The session name to use when assuming the role. If omitted," +
+			" a timestamp based session name is used.",
+
+		"assume_role_with_web_identity_web_identity_token_file": "Path to a file containing the OAuth 2.0 access token " +
+			"or OpenID Connect ID token. The file is re-read each time credentials are refreshed.",
 	}
 
 	endpointServiceNames = []string{
@@ -987,6 +1141,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
 		Insecure:                d.Get("insecure").(bool),
+		HTTPProxy:               d.Get("http_proxy").(string),
+		ReadOnly:                d.Get("read_only").(bool),
+		UseDualStackEndpoint:    d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:         d.Get("use_fips_endpoint").(bool),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -995,6 +1153,20 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
 	}
 
+	// Set APIAuditLogPath, expanding home directory
+	auditLogPath, err := homedir.Expand(d.Get("api_audit_log_path").(string))
+	if err != nil {
+		return nil, err
+	}
+	config.APIAuditLogPath = auditLogPath
+
+	// Set CustomCABundle, expanding home directory
+	caBundlePath, err := homedir.Expand(d.Get("custom_ca_bundle").(string))
+	if err != nil {
+		return nil, err
+	}
+	config.CustomCABundle = caBundlePath
+
 	// Set CredsFilename, expanding home directory
 	credsPath, err := homedir.Expand(d.Get("shared_credentials_file").(string))
 	if err != nil {
@@ -1009,16 +1181,84 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.AssumeRoleSessionName = assumeRole["session_name"].(string)
 		config.AssumeRoleExternalID = assumeRole["external_id"].(string)
 
+		config.AssumeRoleDurationSeconds = assumeRole["duration_seconds"].(int)
+
 		if v := assumeRole["policy"].(string); v != "" {
 			config.AssumeRolePolicy = v
 		}
 
+		for _, v := range assumeRole["policy_arns"].(*schema.Set).List() {
+			config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, v.(string))
+		}
+
+		if v := assumeRole["tags"].(map[string]interface{}); len(v) > 0 {
+			config.AssumeRoleTags = make(map[string]string)
+			for k, vv := range v {
+				config.AssumeRoleTags[k] = vv.(string)
+			}
+		}
+
+		for _, v := range assumeRole["transitive_tag_keys"].(*schema.Set).List() {
+			config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, v.(string))
+		}
+
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
 			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
 	} else {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		assumeRole := l[0].(map[string]interface{})
+		config.AssumeRoleWithWebIdentityDurationSeconds = assumeRole["duration_seconds"].(int)
+		config.AssumeRoleWithWebIdentityPolicy = assumeRole["policy"].(string)
+		config.AssumeRoleWithWebIdentityRoleARN = assumeRole["role_arn"].(string)
+		config.AssumeRoleWithWebIdentitySessionName = assumeRole["session_name"].(string)
+
+		tokenFile, err := homedir.Expand(assumeRole["web_identity_token_file"].(string))
+		if err != nil {
+			return nil, err
+		}
+		config.AssumeRoleWithWebIdentityTokenFile = tokenFile
+
+		for _, v := range assumeRole["policy_arns"].(*schema.Set).List() {
+			config.AssumeRoleWithWebIdentityPolicyARNs = append(config.AssumeRoleWithWebIdentityPolicyARNs, v.(string))
+		}
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q, TokenFile: %q)",
+			config.AssumeRoleWithWebIdentityRoleARN, config.AssumeRoleWithWebIdentitySessionName, config.AssumeRoleWithWebIdentityTokenFile)
+	}
+
+	if l, ok := d.Get("default_tags").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		config.DefaultTags = make(map[string]string)
+		for k, v := range l[0].(map[string]interface{})["tags"].(map[string]interface{}) {
+			config.DefaultTags[k] = v.(string)
+		}
+	}
+
+	if l, ok := d.Get("ignore_tags").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		ignoreTags := l[0].(map[string]interface{})
+		for _, v := range ignoreTags["keys"].(*schema.Set).List() {
+			config.IgnoreTagsKeys = append(config.IgnoreTagsKeys, v.(string))
+		}
+		for _, v := range ignoreTags["key_prefixes"].(*schema.Set).List() {
+			config.IgnoreTagsKeyPrefixes = append(config.IgnoreTagsKeyPrefixes, v.(string))
+		}
+	}
+
+	if l, ok := d.Get("retry").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		retry := l[0].(map[string]interface{})
+		config.RetryMode = retry["mode"].(string)
+		config.RetryMaxBackoff = time.Duration(retry["max_backoff"].(int)) * time.Second
+
+		if v := retry["service_request_rates"].(map[string]interface{}); len(v) > 0 {
+			config.RetryServiceRequestRates = make(map[string]float64)
+			for k, vv := range v {
+				config.RetryServiceRequestRates[k] = vv.(float64)
+			}
+		}
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1076,6 +1316,164 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(900, 43200),
+					Description:  descriptions["assume_role_duration_seconds"],
+				},
+
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateArn},
+					Description: descriptions["assume_role_policy_arns"],
+				},
+
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["assume_role_tags"],
+				},
+
+				"transitive_tag_keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["assume_role_transitive_tag_keys"],
+				},
+			},
+		},
+	}
+}
+
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["assume_role_with_web_identity"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+					Description:  descriptions["assume_role_with_web_identity_role_arn"],
+				},
+
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["assume_role_with_web_identity_session_name"],
+				},
+
+				"web_identity_token_file": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["assume_role_with_web_identity_web_identity_token_file"],
+				},
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(900, 43200),
+					Description:  descriptions["assume_role_duration_seconds"],
+				},
+
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.ValidateJsonString,
+					Description:  descriptions["assume_role_policy"],
+				},
+
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateArn},
+					Description: descriptions["assume_role_policy_arns"],
+				},
+			},
+		},
+	}
+}
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["default_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["default_tags_tags"],
+				},
+			},
+		},
+	}
+}
+
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["retry"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      RetryModeLegacy,
+					ValidateFunc: validation.StringInSlice(retryModes(), false),
+					Description:  descriptions["retry_mode"],
+				},
+				"max_backoff": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["retry_max_backoff"],
+				},
+				"service_request_rates": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeFloat},
+					Description: descriptions["retry_service_request_rates"],
+				},
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["ignore_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
+				},
 			},
 		},
 	}
@@ -11,10 +11,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-template/template"
 	"github.com/terraform-providers/terraform-provider-tls/tls"
 )
@@ -25,8 +28,26 @@ var testAccProviderFactories func(providers *[]*schema.Provider) map[string]terr
 var testAccProvider *schema.Provider
 var testAccTemplateProvider *schema.Provider
 
+// testAccFakeAwsServer is the fake AWS API server the acceptance tests run
+// against when TF_AWS_FAKE is set.
+var testAccFakeAwsServer *fakeaws.Server
+
 func init() {
-	testAccProvider = Provider().(*schema.Provider)
+	if os.Getenv("TF_AWS_FAKE") != "" {
+		testAccFakeAwsServer = fakeaws.NewServer()
+
+		for k, v := range map[string]string{
+			"AWS_ACCESS_KEY_ID":     "fakeaws",
+			"AWS_SECRET_ACCESS_KEY": "fakeaws",
+			"AWS_DEFAULT_REGION":    "us-west-2",
+		} {
+			if os.Getenv(k) == "" {
+				os.Setenv(k, v)
+			}
+		}
+	}
+
+	testAccProvider = testAccFakeAwsProvider(Provider().(*schema.Provider))
 	testAccTemplateProvider = template.Provider().(*schema.Provider)
 	testAccProviders = map[string]terraform.ResourceProvider{
 		"aws":      testAccProvider,
@@ -35,8 +56,8 @@ func init() {
 	testAccProviderFactories = func(providers *[]*schema.Provider) map[string]terraform.ResourceProviderFactory {
 		return map[string]terraform.ResourceProviderFactory{
 			"aws": func() (terraform.ResourceProvider, error) {
-				p := Provider()
-				*providers = append(*providers, p.(*schema.Provider))
+				p := testAccFakeAwsProvider(Provider().(*schema.Provider))
+				*providers = append(*providers, p)
 				return p, nil
 			},
 			"tls": func() (terraform.ResourceProvider, error) {
@@ -55,6 +76,36 @@ func init() {
 	}
 }
 
+// testAccFakeAwsProvider points a provider at the fake AWS API server, if
+// any, overriding the endpoints of the provider configuration.
+func testAccFakeAwsProvider(p *schema.Provider) *schema.Provider {
+	if testAccFakeAwsServer == nil {
+		return p
+	}
+
+	configure := p.ConfigureFunc
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		endpoints := make(map[string]interface{})
+		for k, v := range testAccFakeAwsServer.Endpoints() {
+			endpoints[k] = v
+		}
+
+		if err := d.Set("endpoints", []interface{}{endpoints}); err != nil {
+			return nil, err
+		}
+		if err := d.Set("s3_force_path_style", true); err != nil {
+			return nil, err
+		}
+		if err := d.Set("skip_metadata_api_check", true); err != nil {
+			return nil, err
+		}
+
+		return configure(d)
+	}
+
+	return p
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -443,6 +494,86 @@ func TestAccAWSProvider_Endpoints_Deprecated(t *testing.T) {
 	})
 }
 
+func TestAccAWSProvider_DefaultTags(t *testing.T) {
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigDefaultTags("team", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "terraform-testacc-provider-default-tags"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Name", "terraform-testacc-provider-default-tags"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.owner", "team"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.env", "test"),
+				),
+			},
+			{
+				Config: testAccAWSProviderConfigDefaultTags("team", "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.env", "prod"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_IgnoreTags(t *testing.T) {
+	var vpc ec2.Vpc
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigIgnoreTags(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckAWSProviderIgnoreTagsAddVpcTags(&vpc, map[string]string{
+						"ignorekey":                       "external",
+						"kubernetes.io/cluster/testacc":   "shared",
+						"kubernetes.io/role/internal-elb": "1",
+					}),
+				),
+			},
+			{
+				Config: testAccAWSProviderConfigIgnoreTags(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "terraform-testacc-provider-ignore-tags"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSProviderIgnoreTagsAddVpcTags(vpc *ec2.Vpc, tags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		_, err := conn.CreateTags(&ec2.CreateTagsInput{
+			Resources: []*string{vpc.VpcId},
+			Tags:      keyvaluetags.New(tags).IgnoreAws().Ec2Tags(),
+		})
+
+		return err
+	}
+}
+
 func testAccCheckAWSProviderEndpoints(providers *[]*schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if providers == nil {
@@ -595,3 +726,43 @@ data "aws_arn" "test" {
 }
 `, endpoints)
 }
+
+func testAccAWSProviderConfigDefaultTags(owner, env string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      owner = %[1]q
+      env   = %[2]q
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-provider-default-tags"
+  }
+}
+`, owner, env)
+}
+
+func testAccAWSProviderConfigIgnoreTags() string {
+	return `
+provider "aws" {
+  ignore_tags {
+    keys         = ["ignorekey"]
+    key_prefixes = ["kubernetes.io/"]
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-provider-ignore-tags"
+  }
+}
+`
+}
@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// ErrCodeReadOnlyOperation is the error code of API calls rejected by the
+// provider read_only argument.
+const ErrCodeReadOnlyOperation = "ReadOnlyOperation"
+
+// readOnlyOperationPrefixes are the name prefixes of API operations allowed
+// by the provider read_only argument. Besides the Describe, Get and List
+// operations, these are read only operations used by resource refreshes and
+// data sources, e.g. S3 HeadObject, DynamoDB Query and IAM
+// SimulatePrincipalPolicy. IAM GenerateServiceLastAccessedDetails only starts
+// a report job and is allowed by its full name.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"GenerateServiceLastAccessedDetails",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Simulate",
+}
+
+// readOnlyOperation returns whether an API operation does not mutate
+// resources.
+func readOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// readOnlyHandler rejects requests for mutating API operations.
+func readOnlyHandler(r *request.Request) {
+	if r.Operation == nil || readOnlyOperation(r.Operation.Name) {
+		return
+	}
+
+	call := fmt.Sprintf("%s %s", retryServiceName(r), r.Operation.Name)
+
+	if resource := requestResource(r.Params); resource != "" {
+		call = fmt.Sprintf("%s of %s", call, resource)
+	}
+
+	r.Error = awserr.New(ErrCodeReadOnlyOperation, fmt.Sprintf("%s blocked, the provider is configured with read_only", call), nil)
+	r.Retryable = aws.Bool(false)
+}
+
+// configureReadOnly installs the read only handler on a session before any
+// service client is created from it.
+func (c *Config) configureReadOnly(sess *session.Session) {
+	if !c.ReadOnly {
+		return
+	}
+
+	sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.ReadOnly",
+		Fn:   readOnlyHandler,
+	})
+}
@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestReadOnlyOperation(t *testing.T) {
+	testCases := []struct {
+		Operation string
+		Expected  bool
+	}{
+		{Operation: "DescribeVpcs", Expected: true},
+		{Operation: "GetBucketPolicy", Expected: true},
+		{Operation: "ListRoles", Expected: true},
+		{Operation: "HeadObject", Expected: true},
+		{Operation: "BatchGetItem", Expected: true},
+		{Operation: "Query", Expected: true},
+		{Operation: "SimulatePrincipalPolicy", Expected: true},
+		{Operation: "GenerateServiceLastAccessedDetails", Expected: true},
+		{Operation: "GenerateCredentialReport", Expected: false},
+		{Operation: "CreateVpc", Expected: false},
+		{Operation: "PutBucketPolicy", Expected: false},
+		{Operation: "DeleteRole", Expected: false},
+		{Operation: "BatchWriteItem", Expected: false},
+		{Operation: "TagResource", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Operation, func(t *testing.T) {
+			if got := readOnlyOperation(testCase.Operation); got != testCase.Expected {
+				t.Errorf("expected %t, got: %t", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func TestConfigConfigureReadOnly(t *testing.T) {
+	var requests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`<DescribeVpcsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><vpcSet/></DescribeVpcsResponse>`))
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Endpoint:    aws.String(ts.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	(&Config{ReadOnly: true}).configureReadOnly(sess)
+	conn := ec2.New(sess)
+
+	if _, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{}); err != nil {
+		t.Fatalf("expected read only operation to succeed, got: %s", err)
+	}
+
+	_, err = conn.DeleteVpc(&ec2.DeleteVpcInput{VpcId: aws.String("vpc-12345678")})
+
+	if !isAWSErr(err, ErrCodeReadOnlyOperation, "ec2 DeleteVpc of vpc-12345678 blocked") {
+		t.Fatalf("expected %s error, got: %v", ErrCodeReadOnlyOperation, err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 request to be sent, got: %d", requests)
+	}
+
+	sess, err = session.NewSession(&aws.Config{Region: aws.String("us-west-2")})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	validateHandlers := sess.Handlers.Validate.Len()
+	(&Config{}).configureReadOnly(sess)
+
+	if sess.Handlers.Validate.Len() != validateHandlers {
+		t.Fatal("expected no read only handler by default")
+	}
+}
+
+func TestReadOnlyHandler_message(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	req, _ := ec2.New(sess).CreateVpcRequest(&ec2.CreateVpcInput{CidrBlock: aws.String("10.0.0.0/16")})
+	readOnlyHandler(req)
+
+	if req.Error == nil || !strings.Contains(req.Error.Error(), "ec2 CreateVpc blocked, the provider is configured with read_only") {
+		t.Fatalf("unexpected error: %v", req.Error)
+	}
+}
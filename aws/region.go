@@ -0,0 +1,273 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// awsRegionalClients caches the AWSClient built for each region requested
+// by a resource "region" argument. It is shared by all regional clients of
+// a provider.
+type awsRegionalClients struct {
+	sync.Mutex
+
+	clients map[string]*AWSClient
+}
+
+// regionalClient returns an AWSClient whose service connections target the
+// given region. The client is built on first use from the provider session,
+// reusing its credentials, and cached for subsequent calls.
+func (client *AWSClient) regionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == client.region {
+		return client, nil
+	}
+
+	if client.regionalClients == nil || client.session == nil || client.config == nil {
+		return nil, fmt.Errorf("provider does not support the region argument")
+	}
+
+	client.regionalClients.Lock()
+	defer client.regionalClients.Unlock()
+
+	if regionalClient, ok := client.regionalClients.clients[region]; ok {
+		return regionalClient, nil
+	}
+
+	if !client.config.SkipRegionValidation {
+		if err := awsbase.ValidateRegion(region); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("[INFO] Building AWS client for region: %s", region)
+
+	config := *client.config
+	config.Region = region
+	// Supported platforms are an account setting, there is no need to look
+	// them up again for every region.
+	config.SkipGetEC2Platforms = true
+
+	regionalClient := config.clientFromSession(client.session.Copy(&aws.Config{Region: aws.String(region)}), client.accountid, client.partition)
+	regionalClient.regionalClients = client.regionalClients
+	regionalClient.supportedplatforms = client.supportedplatforms
+
+	client.regionalClients.clients[region] = regionalClient
+
+	return regionalClient, nil
+}
+
+// regionalMeta returns the provider meta to use for the given region.
+func regionalMeta(region string, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*AWSClient)
+
+	if !ok || region == "" {
+		return meta, nil
+	}
+
+	regionalClient, err := client.regionalClient(region)
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring AWS client for region (%s): %s", region, err)
+	}
+
+	return regionalClient, nil
+}
+
+// importIDRegionRegexp matches region names in import IDs, e.g. eu-west-1 or
+// us-gov-west-1.
+var importIDRegionRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+
+// parseImportIDRegion splits an import ID of the form <id>@<region> into the
+// resource ID and region. IDs without a region suffix, including IDs that
+// contain an @ such as email addresses, are returned unchanged.
+func parseImportIDRegion(id string) (string, string) {
+	i := strings.LastIndex(id, "@")
+
+	if i <= 0 || !importIDRegionRegexp.MatchString(id[i+1:]) {
+		return id, ""
+	}
+
+	return id[:i], id[i+1:]
+}
+
+// setRegion sets the "region" attribute to the region of the provider meta.
+func setRegion(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(*AWSClient)
+
+	if !ok || d.Id() == "" {
+		return nil
+	}
+
+	if err := d.Set("region", client.region); err != nil {
+		return fmt.Errorf("error setting region: %s", err)
+	}
+
+	return nil
+}
+
+// resourceWithRegionConfig extends a resource with an optional "region"
+// argument overriding the provider region. All resource functions are called
+// with the provider meta for that region, so a single provider configuration
+// can manage resources across regions. Resources which already have a
+// "region" attribute are left untouched.
+func resourceWithRegionConfig(r *schema.Resource) {
+	if _, ok := r.Schema["region"]; ok {
+		return
+	}
+
+	r.Schema["region"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+
+	create, read, update, del, exists := r.Create, r.Read, r.Update, r.Delete, r.Exists
+
+	if create != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := regionalMeta(d.Get("region").(string), meta)
+			if err != nil {
+				return err
+			}
+
+			if err := create(d, meta); err != nil {
+				return err
+			}
+
+			return setRegion(d, meta)
+		}
+	}
+
+	if read != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := regionalMeta(d.Get("region").(string), meta)
+			if err != nil {
+				return err
+			}
+
+			if err := read(d, meta); err != nil {
+				return err
+			}
+
+			return setRegion(d, meta)
+		}
+	}
+
+	if update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := regionalMeta(d.Get("region").(string), meta)
+			if err != nil {
+				return err
+			}
+
+			return update(d, meta)
+		}
+	}
+
+	if del != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := regionalMeta(d.Get("region").(string), meta)
+			if err != nil {
+				return err
+			}
+
+			return del(d, meta)
+		}
+	}
+
+	if exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			meta, err := regionalMeta(d.Get("region").(string), meta)
+			if err != nil {
+				return false, err
+			}
+
+			return exists(d, meta)
+		}
+	}
+
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(diff *schema.ResourceDiff, meta interface{}) error {
+			meta, err := regionalMeta(diff.Get("region").(string), meta)
+			if err != nil {
+				return err
+			}
+
+			return customizeDiff(diff, meta)
+		}
+	}
+
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+
+		// The region argument is not known during import, it is read from
+		// an optional @<region> suffix of the import ID instead.
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			id, region := parseImportIDRegion(d.Id())
+
+			if region != "" {
+				d.SetId(id)
+
+				if err := d.Set("region", region); err != nil {
+					return nil, fmt.Errorf("error setting region: %s", err)
+				}
+			}
+
+			meta, err := regionalMeta(region, meta)
+			if err != nil {
+				return nil, err
+			}
+
+			results, err := state(d, meta)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, result := range results {
+				if err := setRegion(result, meta); err != nil {
+					return nil, err
+				}
+			}
+
+			return results, nil
+		}
+	}
+}
+
+// dataSourceWithRegionConfig extends a data source with an optional "region"
+// argument overriding the provider region. Data sources which already have a
+// "region" attribute are left untouched.
+func dataSourceWithRegionConfig(r *schema.Resource) {
+	if _, ok := r.Schema["region"]; ok {
+		return
+	}
+
+	r.Schema["region"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	read := r.Read
+
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		meta, err := regionalMeta(d.Get("region").(string), meta)
+		if err != nil {
+			return err
+		}
+
+		if err := read(d, meta); err != nil {
+			return err
+		}
+
+		return setRegion(d, meta)
+	}
+}
@@ -0,0 +1,273 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func testAWSClientWithRegionalClients(t *testing.T, region string) *AWSClient {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String(region),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	config := &Config{
+		Endpoints:           make(map[string]string),
+		Region:              region,
+		SkipGetEC2Platforms: true,
+	}
+
+	client := config.clientFromSession(sess, "123456789012", "aws")
+	client.regionalClients = &awsRegionalClients{
+		clients: make(map[string]*AWSClient),
+	}
+
+	return client
+}
+
+func TestAWSClientRegionalClient(t *testing.T) {
+	client := testAWSClientWithRegionalClients(t, "us-west-2")
+
+	regionalClient, err := client.regionalClient("")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if regionalClient != client {
+		t.Fatal("expected provider client for empty region")
+	}
+
+	regionalClient, err = client.regionalClient("eu-west-1")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if regionalClient.region != "eu-west-1" {
+		t.Fatalf("expected region eu-west-1, got: %s", regionalClient.region)
+	}
+	if got := aws.StringValue(regionalClient.ec2conn.Config.Region); got != "eu-west-1" {
+		t.Fatalf("expected EC2 connection region eu-west-1, got: %s", got)
+	}
+	if regionalClient.accountid != client.accountid {
+		t.Fatalf("expected account ID %s, got: %s", client.accountid, regionalClient.accountid)
+	}
+	if got := aws.StringValue(client.ec2conn.Config.Region); got != "us-west-2" {
+		t.Fatalf("expected provider EC2 connection region us-west-2, got: %s", got)
+	}
+
+	cachedClient, err := client.regionalClient("eu-west-1")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if cachedClient != regionalClient {
+		t.Fatal("expected cached regional client")
+	}
+
+	// Regional clients share the cache of the provider client.
+	cachedClient, err = regionalClient.regionalClient("eu-west-1")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if cachedClient != regionalClient {
+		t.Fatal("expected cached regional client")
+	}
+
+	if _, err := client.regionalClient("not-a-region"); err == nil {
+		t.Fatal("expected error for invalid region")
+	}
+}
+
+func TestResourceWithRegionConfig(t *testing.T) {
+	var createRegion, readRegion string
+
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			createRegion = meta.(*AWSClient).region
+			d.SetId("test")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			readRegion = meta.(*AWSClient).region
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+
+	resourceWithRegionConfig(r)
+
+	if _, ok := r.Schema["region"]; !ok {
+		t.Fatal("expected region attribute")
+	}
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	client := testAWSClientWithRegionalClients(t, "us-west-2")
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":   "test",
+		"region": "eu-west-1",
+	})
+
+	if err := r.Create(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if createRegion != "eu-west-1" {
+		t.Fatalf("expected Create in region eu-west-1, got: %s", createRegion)
+	}
+
+	if err := r.Read(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if readRegion != "eu-west-1" {
+		t.Fatalf("expected Read in region eu-west-1, got: %s", readRegion)
+	}
+	if got := d.Get("region").(string); got != "eu-west-1" {
+		t.Fatalf("expected region eu-west-1, got: %s", got)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": "test",
+	})
+
+	if err := r.Create(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if createRegion != "us-west-2" {
+		t.Fatalf("expected Create in provider region us-west-2, got: %s", createRegion)
+	}
+	if got := d.Get("region").(string); got != "us-west-2" {
+		t.Fatalf("expected region us-west-2, got: %s", got)
+	}
+}
+
+func TestParseImportIDRegion(t *testing.T) {
+	testCases := []struct {
+		ID             string
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{
+			ID:         "vpc-0a1b2c3d",
+			ExpectedID: "vpc-0a1b2c3d",
+		},
+		{
+			ID:             "vpc-0a1b2c3d@eu-west-1",
+			ExpectedID:     "vpc-0a1b2c3d",
+			ExpectedRegion: "eu-west-1",
+		},
+		{
+			ID:             "arn:aws-us-gov:sns:us-gov-west-1:123456789012:topic@us-gov-west-1",
+			ExpectedID:     "arn:aws-us-gov:sns:us-gov-west-1:123456789012:topic",
+			ExpectedRegion: "us-gov-west-1",
+		},
+		{
+			ID:         "user@example.com",
+			ExpectedID: "user@example.com",
+		},
+		{
+			ID:         "@eu-west-1",
+			ExpectedID: "@eu-west-1",
+		},
+	}
+
+	for _, tc := range testCases {
+		id, region := parseImportIDRegion(tc.ID)
+
+		if id != tc.ExpectedID || region != tc.ExpectedRegion {
+			t.Errorf("parseImportIDRegion(%q) = (%q, %q), expected (%q, %q)", tc.ID, id, region, tc.ExpectedID, tc.ExpectedRegion)
+		}
+	}
+}
+
+func TestResourceWithRegionConfig_import(t *testing.T) {
+	var importID, importRegion, readRegion string
+
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			readRegion = meta.(*AWSClient).region
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID = d.Id()
+				importRegion = meta.(*AWSClient).region
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+
+	resourceWithRegionConfig(r)
+
+	client := testAWSClientWithRegionalClients(t, "us-west-2")
+
+	d := r.Data(nil)
+	d.SetId("test@eu-west-1")
+
+	results, err := r.Importer.State(d, client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if importID != "test" {
+		t.Fatalf("expected import ID test, got: %s", importID)
+	}
+	if importRegion != "eu-west-1" {
+		t.Fatalf("expected import in region eu-west-1, got: %s", importRegion)
+	}
+	if got := results[0].Get("region").(string); got != "eu-west-1" {
+		t.Fatalf("expected region eu-west-1, got: %s", got)
+	}
+
+	// Read after import uses the imported region.
+	if err := r.Read(results[0], client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if readRegion != "eu-west-1" {
+		t.Fatalf("expected Read in region eu-west-1, got: %s", readRegion)
+	}
+
+	d = r.Data(nil)
+	d.SetId("test")
+
+	results, err = r.Importer.State(d, client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if importRegion != "us-west-2" {
+		t.Fatalf("expected import in provider region us-west-2, got: %s", importRegion)
+	}
+	if got := results[0].Get("region").(string); got != "us-west-2" {
+		t.Fatalf("expected region us-west-2, got: %s", got)
+	}
+}
+
+func TestResourceWithRegionConfig_existingRegion(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	resourceWithRegionConfig(r)
+
+	if r.Schema["region"].Optional {
+		t.Fatal("expected existing region attribute to be left untouched")
+	}
+}
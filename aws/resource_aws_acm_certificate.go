@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsAcmCertificate() *schema.Resource {
@@ -139,7 +140,7 @@ func resourceAwsAcmCertificateCreateImported(d *schema.ResourceData, meta interf
 	if v, ok := d.GetOk("tags"); ok {
 		params := &acm.AddTagsToCertificateInput{
 			CertificateArn: resp.CertificateArn,
-			Tags:           tagsFromMapACM(v.(map[string]interface{})),
+			Tags:           keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().AcmTags(),
 		}
 		_, err := acmconn.AddTagsToCertificate(params)
 
@@ -177,7 +178,7 @@ func resourceAwsAcmCertificateCreateRequested(d *schema.ResourceData, meta inter
 	if v, ok := d.GetOk("tags"); ok {
 		params := &acm.AddTagsToCertificateInput{
 			CertificateArn: resp.CertificateArn,
-			Tags:           tagsFromMapACM(v.(map[string]interface{})),
+			Tags:           keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().AcmTags(),
 		}
 		_, err := acmconn.AddTagsToCertificate(params)
 
@@ -237,7 +238,7 @@ func resourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) err
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("error listing tags for certificate (%s): %s", d.Id(), err))
 		}
-		if err := d.Set("tags", tagsToMapACM(tagResp.Tags)); err != nil {
+		if err := d.Set("tags", keyvaluetags.AcmKeyValueTags(tagResp.Tags).IgnoreAws().Map()); err != nil {
 			return resource.NonRetryableError(err)
 		}
 
@@ -266,7 +267,7 @@ func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := setTagsACM(acmconn, d)
 		if err != nil {
 			return err
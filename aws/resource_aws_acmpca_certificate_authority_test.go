@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_acmpca_certificate_authority", &resource.Sweeper{
+	addTestSweepers("aws_acmpca_certificate_authority", &resource.Sweeper{
 		Name: "aws_acmpca_certificate_authority",
 		F:    testSweepAcmpcaCertificateAuthorities,
 	})
@@ -35,26 +36,32 @@ func testSweepAcmpcaCertificateAuthorities(region string) error {
 		}
 		return fmt.Errorf("Error retrieving ACMPCA Certificate Authorities: %s", err)
 	}
-	if len(certificateAuthorities) == 0 {
-		log.Print("[DEBUG] No ACMPCA Certificate Authorities to sweep")
-		return nil
-	}
+
+	var resources []*sweep.Resource
 
 	for _, certificateAuthority := range certificateAuthorities {
 		arn := aws.StringValue(certificateAuthority.Arn)
-		log.Printf("[INFO] Deleting ACMPCA Certificate Authority: %s", arn)
-		input := &acmpca.DeleteCertificateAuthorityInput{
-			CertificateAuthorityArn:     aws.String(arn),
-			PermanentDeletionTimeInDays: aws.Int64(int64(7)),
-		}
 
-		_, err := conn.DeleteCertificateAuthority(input)
-		if err != nil {
-			if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
-			log.Printf("[ERROR] Failed to delete ACMPCA Certificate Authority (%s): %s", arn, err)
-		}
+		resources = append(resources, &sweep.Resource{
+			ID:        arn,
+			CreatedAt: aws.TimeValue(certificateAuthority.CreatedAt),
+			Delete: func() error {
+				input := &acmpca.DeleteCertificateAuthorityInput{
+					CertificateAuthorityArn:     aws.String(arn),
+					PermanentDeletionTimeInDays: aws.Int64(int64(7)),
+				}
+
+				_, err := conn.DeleteCertificateAuthority(input)
+				if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+					return nil
+				}
+				return err
+			},
+		})
+	}
+
+	if err := testSweepResources(region, "aws_acmpca_certificate_authority", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
 	}
 
 	return nil
@@ -108,7 +108,7 @@ func resourceAwsApiGatewayMethodResponseCreate(d *schema.ResourceData, meta inte
 	resourceAwsApiGatewayMethodResponseMutex.Lock()
 	defer resourceAwsApiGatewayMethodResponseMutex.Unlock()
 
-	_, err := retryOnAwsCode(apigateway.ErrCodeConflictException, func() (interface{}, error) {
+	_, err := retryOnAwsCode(meta, apigateway.ErrCodeConflictException, func() (interface{}, error) {
 		return conn.PutMethodResponse(&apigateway.PutMethodResponseInput{
 			HttpMethod:         aws.String(d.Get("http_method").(string)),
 			ResourceId:         aws.String(d.Get("resource_id").(string)),
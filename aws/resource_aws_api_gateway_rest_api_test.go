@@ -11,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
+	addTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
 		Name: "aws_api_gateway_rest_api",
 		F:    testSweepAPIGatewayRestApis,
 	})
@@ -27,27 +29,33 @@ func testSweepAPIGatewayRestApis(region string) error {
 	}
 	conn := client.(*AWSClient).apigateway
 
+	var resources []*sweep.Resource
+
 	err = conn.GetRestApisPages(&apigateway.GetRestApisInput{}, func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
 		for _, item := range page.Items {
 			input := &apigateway.DeleteRestApiInput{
 				RestApiId: item.Id,
 			}
-			log.Printf("[INFO] Deleting API Gateway REST API: %s", input)
-			// TooManyRequestsException: Too Many Requests can take over a minute to resolve itself
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteRestApi(input)
-				if err != nil {
-					if isAWSErr(err, apigateway.ErrCodeTooManyRequestsException, "") {
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
+
+			resources = append(resources, &sweep.Resource{
+				ID:        aws.StringValue(item.Id),
+				Name:      aws.StringValue(item.Name),
+				Tags:      keyvaluetags.ApigatewayKeyValueTags(item.Tags),
+				CreatedAt: aws.TimeValue(item.CreatedDate),
+				Delete: func() error {
+					// TooManyRequestsException: Too Many Requests can take over a minute to resolve itself
+					return resource.Retry(2*time.Minute, func() *resource.RetryError {
+						_, err := conn.DeleteRestApi(input)
+						if err != nil {
+							if isAWSErr(err, apigateway.ErrCodeTooManyRequestsException, "") {
+								return resource.RetryableError(err)
+							}
+							return resource.NonRetryableError(err)
+						}
+						return nil
+					})
+				},
 			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete API Gateway REST API %s: %s", *item.Name, err)
-				continue
-			}
 		}
 		return !lastPage
 	})
@@ -59,6 +67,10 @@ func testSweepAPIGatewayRestApis(region string) error {
 		return fmt.Errorf("Error retrieving API Gateway REST APIs: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_api_gateway_rest_api", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -10,10 +10,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	addTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    testSweepAPIGatewayVpcLinks,
 	})
@@ -26,24 +27,27 @@ func testSweepAPIGatewayVpcLinks(region string) error {
 	}
 	conn := client.(*AWSClient).apigateway
 
+	var resources []*sweep.Resource
+
 	err = conn.GetVpcLinksPages(&apigateway.GetVpcLinksInput{}, func(page *apigateway.GetVpcLinksOutput, lastPage bool) bool {
 		for _, item := range page.Items {
-			input := &apigateway.DeleteVpcLinkInput{
-				VpcLinkId: item.Id,
-			}
 			id := aws.StringValue(item.Id)
 
-			log.Printf("[INFO] Deleting API Gateway VPC Link: %s", id)
-			_, err := conn.DeleteVpcLink(input)
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete API Gateway VPC Link %s: %s", id, err)
-				continue
-			}
-
-			if err := waitForApiGatewayVpcLinkDeletion(conn, id); err != nil {
-				log.Printf("[ERROR] Error waiting for API Gateway VPC Link (%s) deletion: %s", id, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:   id,
+				Name: aws.StringValue(item.Name),
+				Delete: func() error {
+					input := &apigateway.DeleteVpcLinkInput{
+						VpcLinkId: aws.String(id),
+					}
+
+					if _, err := conn.DeleteVpcLink(input); err != nil {
+						return err
+					}
+
+					return waitForApiGatewayVpcLinkDeletion(conn, id)
+				},
+			})
 		}
 		return !lastPage
 	})
@@ -55,6 +59,10 @@ func testSweepAPIGatewayVpcLinks(region string) error {
 		return fmt.Errorf("Error retrieving API Gateway VPC Links: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_api_gateway_vpc_link", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
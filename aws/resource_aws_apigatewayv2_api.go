@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsApiGatewayV2Api() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2ApiCreate,
+		Read:   resourceAwsApiGatewayV2ApiRead,
+		Update: resourceAwsApiGatewayV2ApiUpdate,
+		Delete: resourceAwsApiGatewayV2ApiDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"api_key_selection_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "$request.header.x-api-key",
+				ValidateFunc: validation.StringInSlice([]string{
+					"$context.authorizer.usageIdentifierKey",
+					"$request.header.x-api-key",
+				}, false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"disable_schema_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"execution_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"protocol_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					apigatewayv2.ProtocolTypeWebsocket,
+				}, false),
+			},
+			"route_selection_expression": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayV2ApiCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	input := &apigatewayv2.CreateApiInput{
+		ApiKeySelectionExpression: aws.String(d.Get("api_key_selection_expression").(string)),
+		Name:                      aws.String(d.Get("name").(string)),
+		ProtocolType:              aws.String(d.Get("protocol_type").(string)),
+		RouteSelectionExpression:  aws.String(d.Get("route_selection_expression").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("disable_schema_validation"); ok {
+		input.DisableSchemaValidation = aws.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("version"); ok {
+		input.Version = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 API: %s", input)
+	resp, err := conn.CreateApi(input)
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 API: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.ApiId))
+
+	return resourceAwsApiGatewayV2ApiRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2ApiRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	resp, err := conn.GetApi(&apigatewayv2.GetApiInput{
+		ApiId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 API (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 API (%s): %s", d.Id(), err)
+	}
+
+	d.Set("api_endpoint", resp.ApiEndpoint)
+	d.Set("api_key_selection_expression", resp.ApiKeySelectionExpression)
+	apiArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "apigateway",
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("/apis/%s", d.Id()),
+	}.String()
+	d.Set("arn", apiArn)
+	if resp.CreatedDate != nil {
+		d.Set("created_date", aws.TimeValue(resp.CreatedDate).Format(time.RFC3339))
+	}
+	d.Set("description", resp.Description)
+	d.Set("disable_schema_validation", resp.DisableSchemaValidation)
+	d.Set("execution_arn", apiGatewayV2ExecutionArn(meta, d.Id(), ""))
+	d.Set("name", resp.Name)
+	d.Set("protocol_type", resp.ProtocolType)
+	d.Set("route_selection_expression", resp.RouteSelectionExpression)
+	d.Set("version", resp.Version)
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2ApiUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	input := &apigatewayv2.UpdateApiInput{
+		ApiId: aws.String(d.Id()),
+	}
+	if d.HasChange("api_key_selection_expression") {
+		input.ApiKeySelectionExpression = aws.String(d.Get("api_key_selection_expression").(string))
+	}
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+	if d.HasChange("disable_schema_validation") {
+		input.DisableSchemaValidation = aws.Bool(d.Get("disable_schema_validation").(bool))
+	}
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+	if d.HasChange("route_selection_expression") {
+		input.RouteSelectionExpression = aws.String(d.Get("route_selection_expression").(string))
+	}
+	if d.HasChange("version") {
+		input.Version = aws.String(d.Get("version").(string))
+	}
+
+	log.Printf("[DEBUG] Updating API Gateway v2 API: %s", input)
+	_, err := conn.UpdateApi(input)
+	if err != nil {
+		return fmt.Errorf("error updating API Gateway v2 API (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayV2ApiRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2ApiDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 API (%s)", d.Id())
+	_, err := conn.DeleteApi(&apigatewayv2.DeleteApiInput{
+		ApiId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 API (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// apiGatewayV2ExecutionArn returns the execute-api ARN of an API, or of one of
+// its stages when stageName is set, for use in IAM and Lambda permissions.
+func apiGatewayV2ExecutionArn(meta interface{}, apiId, stageName string) string {
+	resource := apiId
+	if stageName != "" {
+		resource = fmt.Sprintf("%s/%s", apiId, stageName)
+	}
+
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "execute-api",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  resource,
+	}.String()
+}
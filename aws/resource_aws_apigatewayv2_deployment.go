@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsApiGatewayV2Deployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2DeploymentCreate,
+		Read:   resourceAwsApiGatewayV2DeploymentRead,
+		Update: resourceAwsApiGatewayV2DeploymentUpdate,
+		Delete: resourceAwsApiGatewayV2DeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected API-ID/DEPLOYMENT-ID", d.Id())
+				}
+				d.Set("api_id", idParts[0])
+				d.SetId(idParts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			// Arbitrary values that force a new deployment when they change,
+			// e.g. a hash of the routes and integrations of the API.
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayV2DeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	input := &apigatewayv2.CreateDeploymentInput{
+		ApiId: aws.String(d.Get("api_id").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 deployment: %s", input)
+	resp, err := conn.CreateDeployment(input)
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 deployment: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.DeploymentId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{apigatewayv2.DeploymentStatusPending},
+		Target:     []string{apigatewayv2.DeploymentStatusDeployed},
+		Refresh:    apiGatewayV2DeploymentRefreshStatusFunc(conn, d.Get("api_id").(string), d.Id()),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for API Gateway v2 deployment (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayV2DeploymentRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2DeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	resp, err := conn.GetDeployment(&apigatewayv2.GetDeploymentInput{
+		ApiId:        aws.String(d.Get("api_id").(string)),
+		DeploymentId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 deployment (%s): %s", d.Id(), err)
+	}
+
+	if resp.CreatedDate != nil {
+		d.Set("created_date", aws.TimeValue(resp.CreatedDate).Format(time.RFC3339))
+	}
+	d.Set("description", resp.Description)
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2DeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	input := &apigatewayv2.UpdateDeploymentInput{
+		ApiId:        aws.String(d.Get("api_id").(string)),
+		DeploymentId: aws.String(d.Id()),
+	}
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	log.Printf("[DEBUG] Updating API Gateway v2 deployment: %s", input)
+	_, err := conn.UpdateDeployment(input)
+	if err != nil {
+		return fmt.Errorf("error updating API Gateway v2 deployment (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayV2DeploymentRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2DeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 deployment (%s)", d.Id())
+	_, err := conn.DeleteDeployment(&apigatewayv2.DeleteDeploymentInput{
+		ApiId:        aws.String(d.Get("api_id").(string)),
+		DeploymentId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 deployment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func apiGatewayV2DeploymentRefreshStatusFunc(conn *apigatewayv2.ApiGatewayV2, apiId, deploymentId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetDeployment(&apigatewayv2.GetDeploymentInput{
+			ApiId:        aws.String(apiId),
+			DeploymentId: aws.String(deploymentId),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if aws.StringValue(resp.DeploymentStatus) == apigatewayv2.DeploymentStatusFailed {
+			return resp, apigatewayv2.DeploymentStatusFailed, fmt.Errorf("API Gateway v2 deployment (%s) failed: %s", deploymentId, aws.StringValue(resp.DeploymentStatusMessage))
+		}
+
+		return resp, aws.StringValue(resp.DeploymentStatus), nil
+	}
+}
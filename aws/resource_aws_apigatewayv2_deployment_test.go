@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2Deployment_basic(t *testing.T) {
+	var v apigatewayv2.GetDeploymentOutput
+	resourceName := "aws_apigatewayv2_deployment.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2DeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2DeploymentConfig_basic(rName, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2DeploymentExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "0"),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayV2DeploymentConfig_basic(rName, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2DeploymentExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2DeploymentImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAPIGatewayV2Deployment_Triggers(t *testing.T) {
+	var deployment1, deployment2, deployment3 apigatewayv2.GetDeploymentOutput
+	resourceName := "aws_apigatewayv2_deployment.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2DeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2DeploymentConfig_triggers(rName, "GETTER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2DeploymentExists(resourceName, &deployment1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayV2DeploymentConfig_triggers(rName, "GETTER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2DeploymentExists(resourceName, &deployment2),
+					testAccCheckAWSAPIGatewayV2DeploymentNotRecreated(&deployment1, &deployment2),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayV2DeploymentConfig_triggers(rName, "SETTER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2DeploymentExists(resourceName, &deployment3),
+					testAccCheckAWSAPIGatewayV2DeploymentRecreated(&deployment2, &deployment3),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSAPIGatewayV2DeploymentImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2DeploymentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_deployment" {
+			continue
+		}
+
+		_, err := conn.GetDeployment(&apigatewayv2.GetDeploymentInput{
+			ApiId:        aws.String(rs.Primary.Attributes["api_id"]),
+			DeploymentId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 deployment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2DeploymentExists(n string, v *apigatewayv2.GetDeploymentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 deployment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+		resp, err := conn.GetDeployment(&apigatewayv2.GetDeploymentInput{
+			ApiId:        aws.String(rs.Primary.Attributes["api_id"]),
+			DeploymentId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAPIGatewayV2DeploymentNotRecreated(i, j *apigatewayv2.GetDeploymentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.DeploymentId) != aws.StringValue(j.DeploymentId) {
+			return fmt.Errorf("API Gateway v2 deployment recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAPIGatewayV2DeploymentRecreated(i, j *apigatewayv2.GetDeploymentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.DeploymentId) == aws.StringValue(j.DeploymentId) {
+			return fmt.Errorf("API Gateway v2 deployment not recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSAPIGatewayV2DeploymentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["api_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccAWSAPIGatewayV2DeploymentConfig_basic(rName, description string) string {
+	return testAccAWSAPIGatewayV2RouteConfig_target(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_deployment" "test" {
+  api_id      = "${aws_apigatewayv2_route.test.api_id}"
+  description = %[1]q
+}
+`, description)
+}
+
+func testAccAWSAPIGatewayV2DeploymentConfig_triggers(rName, operationName string) string {
+	return testAccAWSAPIGatewayV2IntegrationConfig_basic(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_route" "test" {
+  api_id         = "${aws_apigatewayv2_api.test.id}"
+  operation_name = %[1]q
+  route_key      = "$default"
+  target         = "integrations/${aws_apigatewayv2_integration.test.id}"
+}
+
+resource "aws_apigatewayv2_deployment" "test" {
+  api_id = "${aws_apigatewayv2_api.test.id}"
+
+  triggers = {
+    redeployment = "${sha1(join(",", list(
+      jsonencode(aws_apigatewayv2_integration.test),
+      jsonencode(aws_apigatewayv2_route.test),
+    )))}"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, operationName)
+}
@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+)
+
+func init() {
+	addTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
+		Name: "aws_apigatewayv2_domain_name",
+		F:    testSweepAPIGatewayV2DomainNames,
+	})
+}
+
+func testSweepAPIGatewayV2DomainNames(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).apigatewayv2conn
+
+	var resources []*sweep.Resource
+
+	input := &apigatewayv2.GetDomainNamesInput{}
+	for {
+		output, err := conn.GetDomainNames(input)
+		if err != nil {
+			if testSweepSkipSweepError(err) {
+				log.Printf("[WARN] Skipping API Gateway v2 domain name sweep for %s: %s", region, err)
+				return nil
+			}
+			return fmt.Errorf("error retrieving API Gateway v2 domain names: %s", err)
+		}
+
+		for _, domainName := range output.Items {
+			name := aws.StringValue(domainName.DomainName)
+
+			resources = append(resources, &sweep.Resource{
+				ID: name,
+				Delete: func() error {
+					_, err := conn.DeleteDomainName(&apigatewayv2.DeleteDomainNameInput{
+						DomainName: aws.String(name),
+					})
+					if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+						return nil
+					}
+					return err
+				},
+			})
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	filter := sweep.Filter{
+		NamePrefixes: []string{"tf-acc-"},
+	}
+
+	if err := testSweepResources(region, "aws_apigatewayv2_domain_name", filter, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
+	return nil
+}
+
+func testAccAWSAPIGatewayV2DomainNameCertificateArn(t *testing.T) string {
+	// For now, use an environment variable to limit running this test
+	certificateArn := os.Getenv("AWS_API_GATEWAY_DOMAIN_NAME_REGIONAL_CERTIFICATE_ARN")
+	if certificateArn == "" {
+		t.Skip(
+			"Environment variable AWS_API_GATEWAY_DOMAIN_NAME_REGIONAL_CERTIFICATE_ARN is not set. " +
+				"This environment variable must be set to the ARN of " +
+				"an ISSUED ACM certificate in the region where this test " +
+				"is running to enable the test.")
+	}
+
+	return certificateArn
+}
+
+func TestAccAWSAPIGatewayV2DomainName_basic(t *testing.T) {
+	certificateArn := testAccAWSAPIGatewayV2DomainNameCertificateArn(t)
+
+	var v apigatewayv2.GetDomainNameOutput
+	resourceName := "aws_apigatewayv2_domain_name.test"
+	rName := fmt.Sprintf("tf-acc-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2DomainNameDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2DomainNameConfig_basic(rName, certificateArn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2DomainNameExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "api_mapping_selection_expression"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:apigateway:[^:]+::/domainnames/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "domain_name_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name_configuration.0.certificate_arn", certificateArn),
+					resource.TestCheckResourceAttr(resourceName, "domain_name_configuration.0.endpoint_type", apigatewayv2.EndpointTypeRegional),
+					resource.TestMatchResourceAttr(resourceName, "domain_name_configuration.0.hosted_zone_id", regexp.MustCompile(`^Z`)),
+					resource.TestMatchResourceAttr(resourceName, "domain_name_configuration.0.target_domain_name", regexp.MustCompile(`.*\.execute-api\..*`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2DomainNameDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_domain_name" {
+			continue
+		}
+
+		_, err := conn.GetDomainName(&apigatewayv2.GetDomainNameInput{
+			DomainName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 domain name %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2DomainNameExists(n string, v *apigatewayv2.GetDomainNameOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 domain name ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+		resp, err := conn.GetDomainName(&apigatewayv2.GetDomainNameInput{
+			DomainName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccAWSAPIGatewayV2DomainNameConfig_basic(domainName, certificateArn string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_domain_name" "test" {
+  domain_name = %[1]q
+
+  domain_name_configuration {
+    certificate_arn = %[2]q
+    endpoint_type   = "REGIONAL"
+  }
+}
+`, domainName, certificateArn)
+}
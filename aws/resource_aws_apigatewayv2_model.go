@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsApiGatewayV2Model() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2ModelCreate,
+		Read:   resourceAwsApiGatewayV2ModelRead,
+		Update: resourceAwsApiGatewayV2ModelUpdate,
+		Delete: resourceAwsApiGatewayV2ModelDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected API-ID/MODEL-ID", d.Id())
+				}
+				d.Set("api_id", idParts[0])
+				d.SetId(idParts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"schema": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayV2ModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	input := &apigatewayv2.CreateModelInput{
+		ApiId:       aws.String(d.Get("api_id").(string)),
+		ContentType: aws.String(d.Get("content_type").(string)),
+		Name:        aws.String(d.Get("name").(string)),
+		Schema:      aws.String(d.Get("schema").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 model: %s", input)
+	resp, err := conn.CreateModel(input)
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 model: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.ModelId))
+
+	return resourceAwsApiGatewayV2ModelRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2ModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	resp, err := conn.GetModel(&apigatewayv2.GetModelInput{
+		ApiId:   aws.String(d.Get("api_id").(string)),
+		ModelId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 model (%s): %s", d.Id(), err)
+	}
+
+	d.Set("content_type", resp.ContentType)
+	d.Set("description", resp.Description)
+	d.Set("name", resp.Name)
+	d.Set("schema", resp.Schema)
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2ModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	input := &apigatewayv2.UpdateModelInput{
+		ApiId:   aws.String(d.Get("api_id").(string)),
+		ModelId: aws.String(d.Id()),
+	}
+	if d.HasChange("content_type") {
+		input.ContentType = aws.String(d.Get("content_type").(string))
+	}
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+	if d.HasChange("schema") {
+		input.Schema = aws.String(d.Get("schema").(string))
+	}
+
+	log.Printf("[DEBUG] Updating API Gateway v2 model: %s", input)
+	_, err := conn.UpdateModel(input)
+	if err != nil {
+		return fmt.Errorf("error updating API Gateway v2 model (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayV2ModelRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2ModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 model (%s)", d.Id())
+	_, err := conn.DeleteModel(&apigatewayv2.DeleteModelInput{
+		ApiId:   aws.String(d.Get("api_id").(string)),
+		ModelId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 model (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
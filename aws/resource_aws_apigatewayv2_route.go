@@ -0,0 +1,277 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsApiGatewayV2Route() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2RouteCreate,
+		Read:   resourceAwsApiGatewayV2RouteRead,
+		Update: resourceAwsApiGatewayV2RouteUpdate,
+		Delete: resourceAwsApiGatewayV2RouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected API-ID/ROUTE-ID", d.Id())
+				}
+				d.Set("api_id", idParts[0])
+				d.SetId(idParts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_key_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"authorization_scopes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"authorization_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  apigatewayv2.AuthorizationTypeNone,
+				ValidateFunc: validation.StringInSlice([]string{
+					apigatewayv2.AuthorizationTypeNone,
+					apigatewayv2.AuthorizationTypeAwsIam,
+					apigatewayv2.AuthorizationTypeCustom,
+				}, false),
+			},
+			"authorizer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"model_selection_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"operation_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"request_models": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"request_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+			},
+			"route_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"route_response_selection_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayV2RouteCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	input := &apigatewayv2.CreateRouteInput{
+		ApiId:             aws.String(d.Get("api_id").(string)),
+		ApiKeyRequired:    aws.Bool(d.Get("api_key_required").(bool)),
+		AuthorizationType: aws.String(d.Get("authorization_type").(string)),
+		RouteKey:          aws.String(d.Get("route_key").(string)),
+	}
+	if v, ok := d.GetOk("authorization_scopes"); ok {
+		input.AuthorizationScopes = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("authorizer_id"); ok {
+		input.AuthorizerId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("model_selection_expression"); ok {
+		input.ModelSelectionExpression = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("operation_name"); ok {
+		input.OperationName = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("request_models"); ok {
+		input.RequestModels = stringMapToPointers(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("request_parameters"); ok {
+		input.RequestParameters = expandApiGatewayV2ParameterConstraints(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("route_response_selection_expression"); ok {
+		input.RouteResponseSelectionExpression = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("target"); ok {
+		input.Target = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 route: %s", input)
+	resp, err := conn.CreateRoute(input)
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 route: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.RouteId))
+
+	return resourceAwsApiGatewayV2RouteRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2RouteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	resp, err := conn.GetRoute(&apigatewayv2.GetRouteInput{
+		ApiId:   aws.String(d.Get("api_id").(string)),
+		RouteId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 route (%s): %s", d.Id(), err)
+	}
+
+	d.Set("api_key_required", resp.ApiKeyRequired)
+	if err := d.Set("authorization_scopes", flattenStringSet(resp.AuthorizationScopes)); err != nil {
+		return fmt.Errorf("error setting authorization_scopes: %s", err)
+	}
+	d.Set("authorization_type", resp.AuthorizationType)
+	d.Set("authorizer_id", resp.AuthorizerId)
+	d.Set("model_selection_expression", resp.ModelSelectionExpression)
+	d.Set("operation_name", resp.OperationName)
+	if err := d.Set("request_models", aws.StringValueMap(resp.RequestModels)); err != nil {
+		return fmt.Errorf("error setting request_models: %s", err)
+	}
+	if err := d.Set("request_parameters", flattenApiGatewayV2ParameterConstraints(resp.RequestParameters)); err != nil {
+		return fmt.Errorf("error setting request_parameters: %s", err)
+	}
+	d.Set("route_key", resp.RouteKey)
+	d.Set("route_response_selection_expression", resp.RouteResponseSelectionExpression)
+	d.Set("target", resp.Target)
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2RouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	input := &apigatewayv2.UpdateRouteInput{
+		ApiId:   aws.String(d.Get("api_id").(string)),
+		RouteId: aws.String(d.Id()),
+	}
+	if d.HasChange("api_key_required") {
+		input.ApiKeyRequired = aws.Bool(d.Get("api_key_required").(bool))
+	}
+	if d.HasChange("authorization_scopes") {
+		input.AuthorizationScopes = expandStringSet(d.Get("authorization_scopes").(*schema.Set))
+	}
+	if d.HasChange("authorization_type") {
+		input.AuthorizationType = aws.String(d.Get("authorization_type").(string))
+	}
+	if d.HasChange("authorizer_id") {
+		input.AuthorizerId = aws.String(d.Get("authorizer_id").(string))
+	}
+	if d.HasChange("model_selection_expression") {
+		input.ModelSelectionExpression = aws.String(d.Get("model_selection_expression").(string))
+	}
+	if d.HasChange("operation_name") {
+		input.OperationName = aws.String(d.Get("operation_name").(string))
+	}
+	if d.HasChange("request_models") {
+		input.RequestModels = stringMapToPointers(d.Get("request_models").(map[string]interface{}))
+	}
+	if d.HasChange("request_parameters") {
+		input.RequestParameters = expandApiGatewayV2ParameterConstraints(d.Get("request_parameters").(map[string]interface{}))
+	}
+	if d.HasChange("route_key") {
+		input.RouteKey = aws.String(d.Get("route_key").(string))
+	}
+	if d.HasChange("route_response_selection_expression") {
+		input.RouteResponseSelectionExpression = aws.String(d.Get("route_response_selection_expression").(string))
+	}
+	if d.HasChange("target") {
+		input.Target = aws.String(d.Get("target").(string))
+	}
+
+	log.Printf("[DEBUG] Updating API Gateway v2 route: %s", input)
+	_, err := conn.UpdateRoute(input)
+	if err != nil {
+		return fmt.Errorf("error updating API Gateway v2 route (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayV2RouteRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2RouteDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 route (%s)", d.Id())
+	_, err := conn.DeleteRoute(&apigatewayv2.DeleteRouteInput{
+		ApiId:   aws.String(d.Get("api_id").(string)),
+		RouteId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 route (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandApiGatewayV2ParameterConstraints(tfMap map[string]interface{}) map[string]*apigatewayv2.ParameterConstraints {
+	parameters := make(map[string]*apigatewayv2.ParameterConstraints, len(tfMap))
+
+	for k, v := range tfMap {
+		required, ok := v.(bool)
+		if !ok {
+			required, _ = strconv.ParseBool(v.(string))
+		}
+
+		parameters[k] = &apigatewayv2.ParameterConstraints{
+			Required: aws.Bool(required),
+		}
+	}
+
+	return parameters
+}
+
+func flattenApiGatewayV2ParameterConstraints(parameters map[string]*apigatewayv2.ParameterConstraints) map[string]interface{} {
+	tfMap := make(map[string]interface{}, len(parameters))
+
+	for k, v := range parameters {
+		if v == nil {
+			continue
+		}
+
+		tfMap[k] = aws.BoolValue(v.Required)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,468 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsApiGatewayV2Stage() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceAwsApiGatewayV2StageCreate,
+		Read:          resourceAwsApiGatewayV2StageRead,
+		Update:        resourceAwsApiGatewayV2StageUpdate,
+		Delete:        resourceAwsApiGatewayV2StageDelete,
+		CustomizeDiff: resourceAwsApiGatewayV2StageCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected API-ID/STAGE-NAME", d.Id())
+				}
+				d.Set("api_id", idParts[0])
+				d.Set("name", idParts[1])
+				d.SetId(idParts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_log_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+							StateFunc: func(arn interface{}) string {
+								// arns coming from a TF reference to a log group contain a trailing `:*` which is not valid
+								return strings.TrimSuffix(arn.(string), ":*")
+							},
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_certificate_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_route_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: apiGatewayV2RouteSettingsSchema(false),
+				},
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"execution_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invoke_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"route_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: apiGatewayV2RouteSettingsSchema(true),
+				},
+			},
+			"stage_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func apiGatewayV2RouteSettingsSchema(withRouteKey bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"data_trace_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"detailed_metrics_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"logging_level": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				apigatewayv2.LoggingLevelError,
+				apigatewayv2.LoggingLevelInfo,
+				apigatewayv2.LoggingLevelFalse,
+			}, false),
+		},
+		"throttling_burst_limit": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"throttling_rate_limit": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+	}
+
+	if withRouteKey {
+		s["route_key"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		}
+	}
+
+	return s
+}
+
+func resourceAwsApiGatewayV2StageCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	input := &apigatewayv2.CreateStageInput{
+		ApiId:     aws.String(d.Get("api_id").(string)),
+		StageName: aws.String(d.Get("name").(string)),
+	}
+	if v, ok := d.GetOk("access_log_settings"); ok {
+		input.AccessLogSettings = expandApiGatewayV2AccessLogSettings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("client_certificate_id"); ok {
+		input.ClientCertificateId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("default_route_settings"); ok {
+		input.DefaultRouteSettings = expandApiGatewayV2DefaultRouteSettings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("deployment_id"); ok {
+		input.DeploymentId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("route_settings"); ok {
+		input.RouteSettings = expandApiGatewayV2RouteSettings(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("stage_variables"); ok {
+		input.StageVariables = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 stage: %s", input)
+	resp, err := conn.CreateStage(input)
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 stage: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.StageName))
+
+	return resourceAwsApiGatewayV2StageRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2StageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	apiId := d.Get("api_id").(string)
+	resp, err := conn.GetStage(&apigatewayv2.GetStageInput{
+		ApiId:     aws.String(apiId),
+		StageName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 stage (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 stage (%s): %s", d.Id(), err)
+	}
+
+	stageName := aws.StringValue(resp.StageName)
+
+	if err := d.Set("access_log_settings", flattenApiGatewayV2AccessLogSettings(resp.AccessLogSettings)); err != nil {
+		return fmt.Errorf("error setting access_log_settings: %s", err)
+	}
+	stageArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "apigateway",
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("/apis/%s/stages/%s", apiId, stageName),
+	}.String()
+	d.Set("arn", stageArn)
+	d.Set("client_certificate_id", resp.ClientCertificateId)
+	if err := d.Set("default_route_settings", flattenApiGatewayV2DefaultRouteSettings(resp.DefaultRouteSettings)); err != nil {
+		return fmt.Errorf("error setting default_route_settings: %s", err)
+	}
+	d.Set("deployment_id", resp.DeploymentId)
+	d.Set("description", resp.Description)
+	d.Set("execution_arn", apiGatewayV2ExecutionArn(meta, apiId, stageName))
+	d.Set("invoke_url", fmt.Sprintf("wss://%s.execute-api.%s.amazonaws.com/%s", apiId, meta.(*AWSClient).region, stageName))
+	d.Set("name", stageName)
+	if err := d.Set("route_settings", flattenApiGatewayV2RouteSettings(resp.RouteSettings)); err != nil {
+		return fmt.Errorf("error setting route_settings: %s", err)
+	}
+	if err := d.Set("stage_variables", aws.StringValueMap(resp.StageVariables)); err != nil {
+		return fmt.Errorf("error setting stage_variables: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2StageUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	input := &apigatewayv2.UpdateStageInput{
+		ApiId:     aws.String(d.Get("api_id").(string)),
+		StageName: aws.String(d.Id()),
+	}
+	if d.HasChange("access_log_settings") {
+		input.AccessLogSettings = expandApiGatewayV2AccessLogSettings(d.Get("access_log_settings").([]interface{}))
+	}
+	if d.HasChange("client_certificate_id") {
+		input.ClientCertificateId = aws.String(d.Get("client_certificate_id").(string))
+	}
+	if d.HasChange("default_route_settings") {
+		input.DefaultRouteSettings = expandApiGatewayV2DefaultRouteSettings(d.Get("default_route_settings").([]interface{}))
+	}
+	if d.HasChange("deployment_id") {
+		input.DeploymentId = aws.String(d.Get("deployment_id").(string))
+	}
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+	if d.HasChange("route_settings") {
+		input.RouteSettings = expandApiGatewayV2RouteSettings(d.Get("route_settings").(*schema.Set))
+	}
+	if d.HasChange("stage_variables") {
+		input.StageVariables = stringMapToPointers(d.Get("stage_variables").(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating API Gateway v2 stage: %s", input)
+	_, err := conn.UpdateStage(input)
+	if err != nil {
+		return fmt.Errorf("error updating API Gateway v2 stage (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayV2StageRead(d, meta)
+}
+
+// resourceAwsApiGatewayV2StageCustomizeDiff replaces the stage when route
+// settings or throttling limits are removed, as stage updates only merge
+// route settings into the existing ones.
+func resourceAwsApiGatewayV2StageCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if diff.HasChange("default_route_settings") {
+		o, n := diff.GetChange("default_route_settings")
+		oSettings, nSettings := o.([]interface{}), n.([]interface{})
+
+		if len(oSettings) > 0 && oSettings[0] != nil {
+			nSetting := map[string]interface{}{
+				"throttling_burst_limit": 0,
+				"throttling_rate_limit":  0.0,
+			}
+
+			if len(nSettings) > 0 && nSettings[0] != nil {
+				nSetting = nSettings[0].(map[string]interface{})
+			}
+
+			if apiGatewayV2RouteSettingThrottlingRemoved(oSettings[0].(map[string]interface{}), nSetting) {
+				return diff.ForceNew("default_route_settings")
+			}
+		}
+	}
+
+	if diff.HasChange("route_settings") {
+		o, n := diff.GetChange("route_settings")
+		nSettings := map[string]map[string]interface{}{}
+
+		for _, v := range n.(*schema.Set).List() {
+			mSetting := v.(map[string]interface{})
+			nSettings[mSetting["route_key"].(string)] = mSetting
+		}
+
+		for _, v := range o.(*schema.Set).List() {
+			oSetting := v.(map[string]interface{})
+			nSetting, ok := nSettings[oSetting["route_key"].(string)]
+
+			if !ok || apiGatewayV2RouteSettingThrottlingRemoved(oSetting, nSetting) {
+				return diff.ForceNew("route_settings")
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2StageDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 stage (%s)", d.Id())
+	_, err := conn.DeleteStage(&apigatewayv2.DeleteStageInput{
+		ApiId:     aws.String(d.Get("api_id").(string)),
+		StageName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 stage (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandApiGatewayV2AccessLogSettings(vSettings []interface{}) *apigatewayv2.AccessLogSettings {
+	settings := &apigatewayv2.AccessLogSettings{}
+
+	if len(vSettings) == 0 || vSettings[0] == nil {
+		return settings
+	}
+	mSettings := vSettings[0].(map[string]interface{})
+
+	if vDestinationArn, ok := mSettings["destination_arn"].(string); ok && vDestinationArn != "" {
+		// arns coming from a TF reference to a log group contain a trailing `:*` which is not valid
+		settings.DestinationArn = aws.String(strings.TrimSuffix(vDestinationArn, ":*"))
+	}
+	if vFormat, ok := mSettings["format"].(string); ok && vFormat != "" {
+		settings.Format = aws.String(vFormat)
+	}
+
+	return settings
+}
+
+func flattenApiGatewayV2AccessLogSettings(settings *apigatewayv2.AccessLogSettings) []interface{} {
+	if settings == nil || settings.DestinationArn == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"destination_arn": aws.StringValue(settings.DestinationArn),
+		"format":          aws.StringValue(settings.Format),
+	}}
+}
+
+func expandApiGatewayV2DefaultRouteSettings(vSettings []interface{}) *apigatewayv2.RouteSettings {
+	if len(vSettings) == 0 || vSettings[0] == nil {
+		return &apigatewayv2.RouteSettings{}
+	}
+
+	return expandApiGatewayV2RouteSetting(vSettings[0].(map[string]interface{}))
+}
+
+func flattenApiGatewayV2DefaultRouteSettings(settings *apigatewayv2.RouteSettings) []interface{} {
+	if settings == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{flattenApiGatewayV2RouteSetting(settings)}
+}
+
+func expandApiGatewayV2RouteSettings(vSettings *schema.Set) map[string]*apigatewayv2.RouteSettings {
+	settings := map[string]*apigatewayv2.RouteSettings{}
+
+	for _, v := range vSettings.List() {
+		mSetting := v.(map[string]interface{})
+
+		settings[mSetting["route_key"].(string)] = expandApiGatewayV2RouteSetting(mSetting)
+	}
+
+	return settings
+}
+
+func flattenApiGatewayV2RouteSettings(settings map[string]*apigatewayv2.RouteSettings) []interface{} {
+	vSettings := []interface{}{}
+
+	for routeKey, setting := range settings {
+		if setting == nil {
+			continue
+		}
+
+		mSetting := flattenApiGatewayV2RouteSetting(setting)
+		mSetting["route_key"] = routeKey
+
+		vSettings = append(vSettings, mSetting)
+	}
+
+	return vSettings
+}
+
+func expandApiGatewayV2RouteSetting(mSetting map[string]interface{}) *apigatewayv2.RouteSettings {
+	setting := &apigatewayv2.RouteSettings{
+		DataTraceEnabled:       aws.Bool(mSetting["data_trace_enabled"].(bool)),
+		DetailedMetricsEnabled: aws.Bool(mSetting["detailed_metrics_enabled"].(bool)),
+	}
+
+	if v, ok := mSetting["logging_level"].(string); ok && v != "" {
+		setting.LoggingLevel = aws.String(v)
+	}
+
+	// Zero limits reject every request, so only configured limits are sent.
+	if v, ok := mSetting["throttling_burst_limit"].(int); ok && v != 0 {
+		setting.ThrottlingBurstLimit = aws.Int64(int64(v))
+	}
+
+	if v, ok := mSetting["throttling_rate_limit"].(float64); ok && v != 0 {
+		setting.ThrottlingRateLimit = aws.Float64(v)
+	}
+
+	return setting
+}
+
+// apiGatewayV2RouteSettingThrottlingRemoved returns whether a throttling
+// limit was removed from route settings.
+func apiGatewayV2RouteSettingThrottlingRemoved(oSetting, nSetting map[string]interface{}) bool {
+	if oSetting["throttling_burst_limit"].(int) != 0 && nSetting["throttling_burst_limit"].(int) == 0 {
+		return true
+	}
+
+	return oSetting["throttling_rate_limit"].(float64) != 0 && nSetting["throttling_rate_limit"].(float64) == 0
+}
+
+func flattenApiGatewayV2RouteSetting(setting *apigatewayv2.RouteSettings) map[string]interface{} {
+	return map[string]interface{}{
+		"data_trace_enabled":       aws.BoolValue(setting.DataTraceEnabled),
+		"detailed_metrics_enabled": aws.BoolValue(setting.DetailedMetricsEnabled),
+		"logging_level":            aws.StringValue(setting.LoggingLevel),
+		"throttling_burst_limit":   int(aws.Int64Value(setting.ThrottlingBurstLimit)),
+		"throttling_rate_limit":    aws.Float64Value(setting.ThrottlingRateLimit),
+	}
+}
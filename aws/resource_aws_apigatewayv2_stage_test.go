@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2Stage_basic(t *testing.T) {
+	var v apigatewayv2.GetStageOutput
+	resourceName := "aws_apigatewayv2_stage.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2StageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2StageConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2StageExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "access_log_settings.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:apigateway:[^:]+::/apis/.+/stages/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "client_certificate_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_route_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_route_settings.0.data_trace_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_route_settings.0.detailed_metrics_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					testAccMatchResourceAttrRegionalARN(resourceName, "execution_arn", "execute-api", regexp.MustCompile(fmt.Sprintf(".+/%s$", rName))),
+					resource.TestMatchResourceAttr(resourceName, "invoke_url", regexp.MustCompile(fmt.Sprintf("^wss://[a-z0-9]+\\.execute-api\\..+/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "route_settings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stage_variables.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2StageImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAPIGatewayV2Stage_Settings(t *testing.T) {
+	var v apigatewayv2.GetStageOutput
+	resourceName := "aws_apigatewayv2_stage.test"
+	deploymentResourceName := "aws_apigatewayv2_deployment.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2StageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2StageConfig_settings(rName, 1000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2StageExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_route_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_route_settings.0.detailed_metrics_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_route_settings.0.throttling_burst_limit", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "default_route_settings.0.throttling_rate_limit", "500"),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_id", deploymentResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "route_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage_variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage_variables.Var1", "Value1"),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayV2StageConfig_settings(rName, 2000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2StageExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_route_settings.0.throttling_burst_limit", "2000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2StageImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			// Removing the route settings and throttling limits replaces the stage
+			{
+				Config: testAccAWSAPIGatewayV2StageConfig_settingsWithoutThrottling(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2StageExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_route_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_route_settings.0.detailed_metrics_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_route_settings.0.throttling_burst_limit", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_route_settings.0.throttling_rate_limit", "0"),
+					resource.TestCheckResourceAttr(resourceName, "route_settings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandApiGatewayV2RouteSetting(t *testing.T) {
+	setting := expandApiGatewayV2RouteSetting(map[string]interface{}{
+		"data_trace_enabled":       false,
+		"detailed_metrics_enabled": true,
+		"logging_level":            "",
+		"throttling_burst_limit":   0,
+		"throttling_rate_limit":    0.0,
+	})
+
+	if setting.ThrottlingBurstLimit != nil || setting.ThrottlingRateLimit != nil {
+		t.Fatalf("expected no throttling limits, got: %s", setting)
+	}
+	if setting.LoggingLevel != nil {
+		t.Fatalf("expected no logging level, got: %s", setting)
+	}
+
+	setting = expandApiGatewayV2RouteSetting(map[string]interface{}{
+		"data_trace_enabled":       false,
+		"detailed_metrics_enabled": false,
+		"logging_level":            apigatewayv2.LoggingLevelInfo,
+		"throttling_burst_limit":   100,
+		"throttling_rate_limit":    50.0,
+	})
+
+	if aws.Int64Value(setting.ThrottlingBurstLimit) != 100 || aws.Float64Value(setting.ThrottlingRateLimit) != 50 {
+		t.Fatalf("expected throttling limits, got: %s", setting)
+	}
+	if aws.StringValue(setting.LoggingLevel) != apigatewayv2.LoggingLevelInfo {
+		t.Fatalf("expected logging level, got: %s", setting)
+	}
+}
+
+func testAccCheckAWSAPIGatewayV2StageDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_stage" {
+			continue
+		}
+
+		_, err := conn.GetStage(&apigatewayv2.GetStageInput{
+			ApiId:     aws.String(rs.Primary.Attributes["api_id"]),
+			StageName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 stage %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2StageExists(n string, v *apigatewayv2.GetStageOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 stage ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+		resp, err := conn.GetStage(&apigatewayv2.GetStageInput{
+			ApiId:     aws.String(rs.Primary.Attributes["api_id"]),
+			StageName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccAWSAPIGatewayV2StageImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["api_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccAWSAPIGatewayV2StageConfig_basic(rName string) string {
+	return testAccAWSAPIGatewayV2ApiConfig_basic(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_stage" "test" {
+  api_id = "${aws_apigatewayv2_api.test.id}"
+  name   = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAPIGatewayV2StageConfig_settings(rName string, burstLimit int) string {
+	return testAccAWSAPIGatewayV2DeploymentConfig_basic(rName, "test description") + fmt.Sprintf(`
+resource "aws_apigatewayv2_stage" "test" {
+  api_id        = "${aws_apigatewayv2_api.test.id}"
+  deployment_id = "${aws_apigatewayv2_deployment.test.id}"
+  description   = "test description"
+  name          = %[1]q
+
+  default_route_settings {
+    detailed_metrics_enabled = true
+    throttling_burst_limit   = %[2]d
+    throttling_rate_limit    = 500
+  }
+
+  route_settings {
+    route_key              = "${aws_apigatewayv2_route.test.route_key}"
+    throttling_burst_limit = 100
+    throttling_rate_limit  = 50
+  }
+
+  stage_variables = {
+    Var1 = "Value1"
+  }
+}
+`, rName, burstLimit)
+}
+
+func testAccAWSAPIGatewayV2StageConfig_settingsWithoutThrottling(rName string) string {
+	return testAccAWSAPIGatewayV2DeploymentConfig_basic(rName, "test description") + fmt.Sprintf(`
+resource "aws_apigatewayv2_stage" "test" {
+  api_id        = "${aws_apigatewayv2_api.test.id}"
+  deployment_id = "${aws_apigatewayv2_deployment.test.id}"
+  description   = "test description"
+  name          = %[1]q
+
+  default_route_settings {
+    detailed_metrics_enabled = true
+  }
+
+  stage_variables = {
+    Var1 = "Value1"
+  }
+}
+`, rName)
+}
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
+	addTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
 		Name: "aws_appmesh_mesh",
 		F:    testSweepAppmeshMeshes,
 		Dependencies: []string{
@@ -30,6 +31,8 @@ func testSweepAppmeshMeshes(region string) error {
 	}
 	conn := client.(*AWSClient).appmeshconn
 
+	var resources []*sweep.Resource
+
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -38,16 +41,17 @@ func testSweepAppmeshMeshes(region string) error {
 		for _, mesh := range page.Meshes {
 			name := aws.StringValue(mesh.MeshName)
 
-			input := &appmesh.DeleteMeshInput{
-				MeshName: aws.String(name),
-			}
-
-			log.Printf("[INFO] Deleting Appmesh Mesh: %s", name)
-			_, err := conn.DeleteMesh(input)
-
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Appmesh Mesh (%s): %s", name, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID: name,
+				Delete: func() error {
+					input := &appmesh.DeleteMeshInput{
+						MeshName: aws.String(name),
+					}
+
+					_, err := conn.DeleteMesh(input)
+					return err
+				},
+			})
 		}
 
 		return !isLast
@@ -60,6 +64,10 @@ func testSweepAppmeshMeshes(region string) error {
 		return fmt.Errorf("error retrieving Appmesh Meshes: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_appmesh_mesh", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
+	addTestSweepers("aws_appmesh_route", &resource.Sweeper{
 		Name: "aws_appmesh_route",
 		F:    testSweepAppmeshRoutes,
 	})
@@ -27,6 +28,8 @@ func testSweepAppmeshRoutes(region string) error {
 	}
 	conn := client.(*AWSClient).appmeshconn
 
+	var resources []*sweep.Resource
+
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -57,18 +60,20 @@ func testSweepAppmeshRoutes(region string) error {
 
 						for _, route := range page.Routes {
 							input := &appmesh.DeleteRouteInput{
-								MeshName:          mesh.MeshName,
+								MeshName:          aws.String(meshName),
 								RouteName:         route.RouteName,
-								VirtualRouterName: virtualRouter.VirtualRouterName,
+								VirtualRouterName: aws.String(virtualRouterName),
 							}
 							routeName := aws.StringValue(route.RouteName)
 
-							log.Printf("[INFO] Deleting Appmesh Mesh (%s) Virtual Router (%s) Route: %s", meshName, virtualRouterName, routeName)
-							_, err := conn.DeleteRoute(input)
-
-							if err != nil {
-								log.Printf("[ERROR] Error deleting Appmesh Mesh (%s) Virtual Router (%s) Route (%s): %s", meshName, virtualRouterName, routeName, err)
-							}
+							resources = append(resources, &sweep.Resource{
+								ID:   fmt.Sprintf("%s/%s/%s", meshName, virtualRouterName, routeName),
+								Name: routeName,
+								Delete: func() error {
+									_, err := conn.DeleteRoute(input)
+									return err
+								},
+							})
 						}
 
 						return !isLast
@@ -97,6 +102,10 @@ func testSweepAppmeshRoutes(region string) error {
 		return fmt.Errorf("error retrieving Appmesh Meshes: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_appmesh_route", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
+	addTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_router",
 		F:    testSweepAppmeshVirtualRouters,
 		Dependencies: []string{
@@ -30,6 +31,8 @@ func testSweepAppmeshVirtualRouters(region string) error {
 	}
 	conn := client.(*AWSClient).appmeshconn
 
+	var resources []*sweep.Resource
+
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -48,17 +51,19 @@ func testSweepAppmeshVirtualRouters(region string) error {
 
 				for _, virtualRouter := range page.VirtualRouters {
 					input := &appmesh.DeleteVirtualRouterInput{
-						MeshName:          mesh.MeshName,
+						MeshName:          aws.String(meshName),
 						VirtualRouterName: virtualRouter.VirtualRouterName,
 					}
 					virtualRouterName := aws.StringValue(virtualRouter.VirtualRouterName)
 
-					log.Printf("[INFO] Deleting Appmesh Mesh (%s) Virtual Router: %s", meshName, virtualRouterName)
-					_, err := conn.DeleteVirtualRouter(input)
-
-					if err != nil {
-						log.Printf("[ERROR] Error deleting Appmesh Mesh (%s) Virtual Router (%s): %s", meshName, virtualRouterName, err)
-					}
+					resources = append(resources, &sweep.Resource{
+						ID:   fmt.Sprintf("%s/%s", meshName, virtualRouterName),
+						Name: virtualRouterName,
+						Delete: func() error {
+							_, err := conn.DeleteVirtualRouter(input)
+							return err
+						},
+					})
 				}
 
 				return !isLast
@@ -79,6 +84,10 @@ func testSweepAppmeshVirtualRouters(region string) error {
 		return fmt.Errorf("error retrieving Appmesh Virtual Routers: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_appmesh_virtual_router", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -11,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appsync_graphql_api", &resource.Sweeper{
+	addTestSweepers("aws_appsync_graphql_api", &resource.Sweeper{
 		Name: "aws_appsync_graphql_api",
 		F:    testSweepAppsyncGraphqlApis,
 	})
@@ -28,6 +30,7 @@ func testSweepAppsyncGraphqlApis(region string) error {
 	conn := client.(*AWSClient).appsyncconn
 
 	input := &appsync.ListGraphqlApisInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.ListGraphqlApis(input)
@@ -41,17 +44,19 @@ func testSweepAppsyncGraphqlApis(region string) error {
 		}
 
 		for _, graphAPI := range output.GraphqlApis {
-			id := aws.StringValue(graphAPI.ApiId)
 			input := &appsync.DeleteGraphqlApiInput{
 				ApiId: graphAPI.ApiId,
 			}
 
-			log.Printf("[INFO] Deleting AppSync GraphQL API %s", id)
-			_, err := conn.DeleteGraphqlApi(input)
-
-			if err != nil {
-				return fmt.Errorf("error deleting AppSync GraphQL API (%s): %s", id, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:   aws.StringValue(graphAPI.ApiId),
+				Name: aws.StringValue(graphAPI.Name),
+				Tags: keyvaluetags.AppsyncKeyValueTags(graphAPI.Tags),
+				Delete: func() error {
+					_, err := conn.DeleteGraphqlApi(input)
+					return err
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -61,7 +66,7 @@ func testSweepAppsyncGraphqlApis(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return testSweepResources(region, "aws_appsync_graphql_api", sweep.Filter{}, resources)
 }
 
 func TestAccAWSAppsyncGraphqlApi_basic(t *testing.T) {
@@ -73,7 +73,7 @@ func resourceAwsAppsyncResolverCreate(d *schema.ResourceData, meta interface{})
 	awsMutexKV.Lock(mutexKey)
 	defer awsMutexKV.Unlock(mutexKey)
 
-	_, err := retryOnAwsCode(appsync.ErrCodeConcurrentModificationException, func() (interface{}, error) {
+	_, err := retryOnAwsCode(meta, appsync.ErrCodeConcurrentModificationException, func() (interface{}, error) {
 		return conn.CreateResolver(input)
 	})
 
@@ -140,7 +140,7 @@ func resourceAwsAppsyncResolverUpdate(d *schema.ResourceData, meta interface{})
 	awsMutexKV.Lock(mutexKey)
 	defer awsMutexKV.Unlock(mutexKey)
 
-	_, err := retryOnAwsCode(appsync.ErrCodeConcurrentModificationException, func() (interface{}, error) {
+	_, err := retryOnAwsCode(meta, appsync.ErrCodeConcurrentModificationException, func() (interface{}, error) {
 		return conn.UpdateResolver(input)
 	})
 
@@ -170,7 +170,7 @@ func resourceAwsAppsyncResolverDelete(d *schema.ResourceData, meta interface{})
 	awsMutexKV.Lock(mutexKey)
 	defer awsMutexKV.Unlock(mutexKey)
 
-	_, err = retryOnAwsCode(appsync.ErrCodeConcurrentModificationException, func() (interface{}, error) {
+	_, err = retryOnAwsCode(meta, appsync.ErrCodeConcurrentModificationException, func() (interface{}, error) {
 		return conn.DeleteResolver(input)
 	})
 
@@ -18,10 +18,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_autoscaling_group", &resource.Sweeper{
+	addTestSweepers("aws_autoscaling_group", &resource.Sweeper{
 		Name: "aws_autoscaling_group",
 		F:    testSweepAutoscalingGroups,
 	})
@@ -43,10 +45,7 @@ func testSweepAutoscalingGroups(region string) error {
 		return fmt.Errorf("Error retrieving AutoScaling Groups in Sweeper: %s", err)
 	}
 
-	if len(resp.AutoScalingGroups) == 0 {
-		log.Print("[DEBUG] No aws autoscaling groups to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, asg := range resp.AutoScalingGroups {
 		deleteopts := autoscaling.DeleteAutoScalingGroupInput{
@@ -54,29 +53,38 @@ func testSweepAutoscalingGroups(region string) error {
 			ForceDelete:          aws.Bool(true),
 		}
 
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			if _, err := conn.DeleteAutoScalingGroup(&deleteopts); err != nil {
-				if awserr, ok := err.(awserr.Error); ok {
-					switch awserr.Code() {
-					case "InvalidGroup.NotFound":
-						return nil
-					case "ResourceInUse", "ScalingActivityInProgress":
-						return resource.RetryableError(awserr)
-					}
-				}
+		tags := keyvaluetags.KeyValueTags{}
+		for _, tag := range asg.Tags {
+			tags[aws.StringValue(tag.Key)] = tag.Value
+		}
 
-				// Didn't recognize the error, so shouldn't retry.
-				return resource.NonRetryableError(err)
-			}
-			// Successful delete
-			return nil
+		resources = append(resources, &sweep.Resource{
+			ID:        aws.StringValue(asg.AutoScalingGroupName),
+			Tags:      tags,
+			CreatedAt: aws.TimeValue(asg.CreatedTime),
+			Delete: func() error {
+				return resource.Retry(5*time.Minute, func() *resource.RetryError {
+					if _, err := conn.DeleteAutoScalingGroup(&deleteopts); err != nil {
+						if awserr, ok := err.(awserr.Error); ok {
+							switch awserr.Code() {
+							case "InvalidGroup.NotFound":
+								return nil
+							case "ResourceInUse", "ScalingActivityInProgress":
+								return resource.RetryableError(awserr)
+							}
+						}
+
+						// Didn't recognize the error, so shouldn't retry.
+						return resource.NonRetryableError(err)
+					}
+					// Successful delete
+					return nil
+				})
+			},
 		})
-		if err != nil {
-			return err
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_autoscaling_group", sweep.Filter{}, resources)
 }
 
 func TestAccAWSAutoScalingGroup_basic(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsBackupPlan() *schema.Resource {
@@ -106,7 +107,7 @@ func resourceAwsBackupPlanCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if v, ok := d.GetOk("tags"); ok {
-		input.BackupPlanTags = tagsFromMapGeneric(v.(map[string]interface{}))
+		input.BackupPlanTags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().BackupTags()
 	}
 
 	resp, err := conn.CreateBackupPlan(input)
@@ -169,7 +170,7 @@ func resourceAwsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error listing tags AWS Backup plan %s: %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapGeneric(tagsOutput.Tags)); err != nil {
+	if err := d.Set("tags", keyvaluetags.BackupKeyValueTags(tagsOutput.Tags).IgnoreAws().Map()); err != nil {
 		return fmt.Errorf("error setting tags on AWS Backup plan %s: %s", d.Id(), err)
 	}
 
@@ -200,45 +201,11 @@ func resourceAwsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error updating Backup Plan: %s", err)
 	}
 
-	if d.HasChange("tags") {
-		resourceArn := d.Get("arn").(string)
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
-
-			_, err := conn.UntagResource(&backup.UntagResourceInput{
-				ResourceArn: aws.String(resourceArn),
-				TagKeyList:  keys,
-			})
-			if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
-				log.Printf("[WARN] Backup Plan %s not found, removing from state", d.Id())
-				d.SetId("")
-				return nil
-			}
-			if err != nil {
-				return fmt.Errorf("Error removing tags for (%s): %s", d.Id(), err)
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.TagResource(&backup.TagResourceInput{
-				ResourceArn: aws.String(resourceArn),
-				Tags:        create,
-			})
-			if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
-				log.Printf("[WARN] Backup Plan %s not found, removing from state", d.Id())
-				d.SetId("")
-				return nil
-			}
-			if err != nil {
-				return fmt.Errorf("Error setting tags for (%s): %s", d.Id(), err)
-			}
+		if err := keyvaluetags.BackupUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Backup Plan (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -288,7 +255,7 @@ func expandBackupPlanRules(l []interface{}) []*backup.RuleInput {
 		}
 
 		if item["recovery_point_tags"] != nil {
-			rule.RecoveryPointTags = tagsFromMapGeneric(item["recovery_point_tags"].(map[string]interface{}))
+			rule.RecoveryPointTags = keyvaluetags.New(item["recovery_point_tags"].(map[string]interface{})).IgnoreAws().BackupTags()
 		}
 
 		var lifecycle map[string]interface{}
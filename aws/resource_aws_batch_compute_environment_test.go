@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
+	addTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
 		Name: "aws_batch_compute_environment",
 		Dependencies: []string{
 			"aws_batch_job_queue",
@@ -39,25 +40,30 @@ func testSweepBatchComputeEnvironments(region string) error {
 		}
 		return fmt.Errorf("Error retrieving Batch Compute Environments: %s", err)
 	}
-	for _, computeEnvironment := range out.ComputeEnvironments {
-		name := aws.StringValue(computeEnvironment.ComputeEnvironmentName)
 
-		if aws.StringValue(computeEnvironment.State) == batch.CEStateEnabled {
-			log.Printf("[INFO] Disabling Batch Compute Environment: %s", name)
-			err := disableBatchComputeEnvironment(name, 20*time.Minute, conn)
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to disable Batch Compute Environment %s: %s", name, err)
-				continue
-			}
-		}
+	var resources []*sweep.Resource
 
-		log.Printf("[INFO] Deleting Batch Compute Environment: %s", name)
-		err := deleteBatchComputeEnvironment(name, 20*time.Minute, conn)
+	for _, computeEnvironment := range out.ComputeEnvironments {
+		name := aws.StringValue(computeEnvironment.ComputeEnvironmentName)
+		enabled := aws.StringValue(computeEnvironment.State) == batch.CEStateEnabled
+
+		resources = append(resources, &sweep.Resource{
+			ID: name,
+			Delete: func() error {
+				if enabled {
+					log.Printf("[INFO] Disabling Batch Compute Environment: %s", name)
+					if err := disableBatchComputeEnvironment(name, 20*time.Minute, conn); err != nil {
+						return fmt.Errorf("error disabling: %s", err)
+					}
+				}
+
+				return deleteBatchComputeEnvironment(name, 20*time.Minute, conn)
+			},
+		})
+	}
 
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete Batch Compute Environment %s: %s", name, err)
-		}
+	if err := testSweepResources(region, "aws_batch_compute_environment", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
 	}
 
 	return nil
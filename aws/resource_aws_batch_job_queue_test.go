@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
+	addTestSweepers("aws_batch_job_queue", &resource.Sweeper{
 		Name: "aws_batch_job_queue",
 		F:    testSweepBatchJobQueues,
 	})
@@ -28,10 +29,6 @@ func testSweepBatchJobQueues(region string) error {
 	}
 	conn := client.(*AWSClient).batchconn
 
-	prefixes := []string{
-		"tf_acc",
-	}
-
 	out, err := conn.DescribeJobQueues(&batch.DescribeJobQueuesInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
@@ -40,32 +37,33 @@ func testSweepBatchJobQueues(region string) error {
 		}
 		return fmt.Errorf("Error retrieving Batch Job Queues: %s", err)
 	}
+
+	var resources []*sweep.Resource
+
 	for _, jobQueue := range out.JobQueues {
-		name := jobQueue.JobQueueName
-		skip := true
-		for _, prefix := range prefixes {
-			if strings.HasPrefix(*name, prefix) {
-				skip = false
-				break
-			}
-		}
-		if skip {
-			log.Printf("[INFO] Skipping Batch Job Queue: %s", *name)
-			continue
-		}
+		name := aws.StringValue(jobQueue.JobQueueName)
 
-		log.Printf("[INFO] Disabling Batch Job Queue: %s", *name)
-		err := disableBatchJobQueue(*name, conn)
-		if err != nil {
-			log.Printf("[ERROR] Failed to disable Batch Job Queue %s: %s", *name, err)
-			continue
-		}
+		resources = append(resources, &sweep.Resource{
+			ID: name,
+			Delete: func() error {
+				log.Printf("[INFO] Disabling Batch Job Queue: %s", name)
+				if err := disableBatchJobQueue(name, conn); err != nil {
+					return fmt.Errorf("error disabling: %s", err)
+				}
 
-		log.Printf("[INFO] Deleting Batch Job Queue: %s", *name)
-		err = deleteBatchJobQueue(*name, conn)
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete Batch Job Queue %s: %s", *name, err)
-		}
+				return deleteBatchJobQueue(name, conn)
+			},
+		})
+	}
+
+	filter := sweep.Filter{
+		NamePrefixes: []string{
+			"tf_acc",
+		},
+	}
+
+	if err := testSweepResources(region, "aws_batch_job_queue", filter, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
 	}
 
 	return nil
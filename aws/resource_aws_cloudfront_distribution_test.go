@@ -14,10 +14,11 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
+	addTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
 		Name: "aws_cloudfront_distribution",
 		F:    testSweepCloudFrontDistributions,
 	})
@@ -45,10 +46,7 @@ func testSweepCloudFrontDistributions(region string) error {
 		return fmt.Errorf("Error listing CloudFront Distributions: %s", err)
 	}
 
-	if len(distributionSummaries) == 0 {
-		log.Print("[DEBUG] No CloudFront Distributions to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, distributionSummary := range distributionSummaries {
 		distributionID := *distributionSummary.Id
@@ -58,23 +56,26 @@ func testSweepCloudFrontDistributions(region string) error {
 			continue
 		}
 
-		output, err := conn.GetDistribution(&cloudfront.GetDistributionInput{
-			Id: aws.String(distributionID),
-		})
-		if err != nil {
-			return fmt.Errorf("Error reading CloudFront Distribution %s: %s", distributionID, err)
-		}
-
-		_, err = conn.DeleteDistribution(&cloudfront.DeleteDistributionInput{
-			Id:      aws.String(distributionID),
-			IfMatch: output.ETag,
+		resources = append(resources, &sweep.Resource{
+			ID: distributionID,
+			Delete: func() error {
+				output, err := conn.GetDistribution(&cloudfront.GetDistributionInput{
+					Id: aws.String(distributionID),
+				})
+				if err != nil {
+					return fmt.Errorf("error reading: %s", err)
+				}
+
+				_, err = conn.DeleteDistribution(&cloudfront.DeleteDistributionInput{
+					Id:      aws.String(distributionID),
+					IfMatch: output.ETag,
+				})
+				return err
+			},
 		})
-		if err != nil {
-			return fmt.Errorf("Error deleting CloudFront Distribution %s: %s", distributionID, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_cloudfront_distribution", sweep.Filter{}, resources)
 }
 
 func TestAccAWSCloudFrontDistribution_disappears(t *testing.T) {
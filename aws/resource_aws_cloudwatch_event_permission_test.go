@@ -14,10 +14,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
+	addTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_permission",
 		F:    testSweepCloudWatchEventPermissions,
 	})
@@ -52,19 +53,23 @@ func testSweepCloudWatchEventPermissions(region string) error {
 		return fmt.Errorf("Parsing CloudWatch Event Permissions policy %q failed: %s", policy, err)
 	}
 
+	var resources []*sweep.Resource
+
 	for _, statement := range policyDoc.Statements {
 		sid := statement.Sid
 
-		log.Printf("[INFO] Deleting CloudWatch Event Permission %s", sid)
-		_, err := conn.RemovePermission(&events.RemovePermissionInput{
-			StatementId: aws.String(sid),
+		resources = append(resources, &sweep.Resource{
+			ID: sid,
+			Delete: func() error {
+				_, err := conn.RemovePermission(&events.RemovePermissionInput{
+					StatementId: aws.String(sid),
+				})
+				return err
+			},
 		})
-		if err != nil {
-			return fmt.Errorf("Error deleting CloudWatch Event Permission %s: %s", sid, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_cloudwatch_event_permission", sweep.Filter{}, resources)
 }
 
 func TestAccAWSCloudWatchEventPermission_Basic(t *testing.T) {
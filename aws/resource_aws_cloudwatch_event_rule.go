@@ -224,7 +224,7 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 		log.Printf("[DEBUG] CloudWatch Event Rule (%q) disabled", d.Id())
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsCloudWatchEvents(conn, d, d.Get("arn").(string)); err != nil {
 			return fmt.Errorf("Error updating tags for %s: %s", d.Id(), err)
 		}
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
+	addTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_rule",
 		F:    testSweepCloudWatchEventRules,
 		Dependencies: []string{
@@ -31,6 +32,7 @@ func testSweepCloudWatchEventRules(region string) error {
 	conn := client.(*AWSClient).cloudwatcheventsconn
 
 	input := &events.ListRulesInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.ListRules(input)
@@ -42,21 +44,18 @@ func testSweepCloudWatchEventRules(region string) error {
 			return fmt.Errorf("Error retrieving CloudWatch Event Rules: %s", err)
 		}
 
-		if len(output.Rules) == 0 {
-			log.Print("[DEBUG] No CloudWatch Event Rules to sweep")
-			return nil
-		}
-
 		for _, rule := range output.Rules {
 			name := aws.StringValue(rule.Name)
 
-			log.Printf("[INFO] Deleting CloudWatch Event Rule %s", name)
-			_, err := conn.DeleteRule(&events.DeleteRuleInput{
-				Name: aws.String(name),
+			resources = append(resources, &sweep.Resource{
+				ID: name,
+				Delete: func() error {
+					_, err := conn.DeleteRule(&events.DeleteRuleInput{
+						Name: aws.String(name),
+					})
+					return err
+				},
 			})
-			if err != nil {
-				return fmt.Errorf("Error deleting CloudWatch Event Rule %s: %s", name, err)
-			}
 		}
 
 		if output.NextToken == nil {
@@ -65,7 +64,7 @@ func testSweepCloudWatchEventRules(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return testSweepResources(region, "aws_cloudwatch_event_rule", sweep.Filter{}, resources)
 }
 
 func TestAccAWSCloudWatchEventRule_importBasic(t *testing.T) {
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
+	addTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_target",
 		F:    testSweepCloudWatchEventTargets,
 	})
@@ -28,6 +29,7 @@ func testSweepCloudWatchEventTargets(region string) error {
 	conn := client.(*AWSClient).cloudwatcheventsconn
 
 	input := &events.ListRulesInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.ListRules(input)
@@ -58,16 +60,18 @@ func testSweepCloudWatchEventTargets(region string) error {
 				for _, target := range listTargetsByRuleOutput.Targets {
 					removeTargetsInput := &events.RemoveTargetsInput{
 						Ids:  []*string{target.Id},
-						Rule: rule.Name,
+						Rule: aws.String(ruleName),
 					}
 					targetID := aws.StringValue(target.Id)
 
-					log.Printf("[INFO] Deleting CloudWatch Event Rule (%s) Target: %s", ruleName, targetID)
-					_, err := conn.RemoveTargets(removeTargetsInput)
-
-					if err != nil {
-						return fmt.Errorf("Error deleting CloudWatch Event Rule (%s) Target %s: %s", ruleName, targetID, err)
-					}
+					resources = append(resources, &sweep.Resource{
+						ID:   fmt.Sprintf("%s-%s", ruleName, targetID),
+						Name: ruleName,
+						Delete: func() error {
+							_, err := conn.RemoveTargets(removeTargetsInput)
+							return err
+						},
+					})
 				}
 
 				if aws.StringValue(listTargetsByRuleOutput.NextToken) == "" {
@@ -85,7 +89,7 @@ func testSweepCloudWatchEventTargets(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return testSweepResources(region, "aws_cloudwatch_event_target", sweep.Filter{}, resources)
 }
 
 func TestAccAWSCloudWatchEventTarget_basic(t *testing.T) {
@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
+	addTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
 		Name: "aws_cognito_user_pool",
 		F:    testSweepCognitoUserPools,
 	})
@@ -32,6 +33,7 @@ func testSweepCognitoUserPools(region string) error {
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(int64(50)),
 	}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.ListUserPools(input)
@@ -43,21 +45,20 @@ func testSweepCognitoUserPools(region string) error {
 			return fmt.Errorf("Error retrieving Cognito User Pools: %s", err)
 		}
 
-		if len(output.UserPools) == 0 {
-			log.Print("[DEBUG] No Cognito User Pools to sweep")
-			return nil
-		}
-
 		for _, userPool := range output.UserPools {
-			name := aws.StringValue(userPool.Name)
-
-			log.Printf("[INFO] Deleting Cognito User Pool %s", name)
-			_, err := conn.DeleteUserPool(&cognitoidentityprovider.DeleteUserPoolInput{
-				UserPoolId: userPool.Id,
+			id := aws.StringValue(userPool.Id)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				Name:      aws.StringValue(userPool.Name),
+				CreatedAt: aws.TimeValue(userPool.CreationDate),
+				Delete: func() error {
+					_, err := conn.DeleteUserPool(&cognitoidentityprovider.DeleteUserPoolInput{
+						UserPoolId: aws.String(id),
+					})
+					return err
+				},
 			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Cognito User Pool %s: %s", name, err)
-			}
 		}
 
 		if output.NextToken == nil {
@@ -66,7 +67,7 @@ func testSweepCognitoUserPools(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return testSweepResources(region, "aws_cognito_user_pool", sweep.Filter{}, resources)
 }
 
 func TestAccAWSCognitoUserPool_importBasic(t *testing.T) {
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
+	addTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
 		Name: "aws_config_aggregate_authorization",
 		F:    testSweepConfigAggregateAuthorizations,
 	})
@@ -36,25 +37,25 @@ func testSweepConfigAggregateAuthorizations(region string) error {
 		return fmt.Errorf("Error retrieving config aggregate authorizations: %s", err)
 	}
 
-	if len(aggregateAuthorizations) == 0 {
-		log.Print("[DEBUG] No config aggregate authorizations to sweep")
-		return nil
-	}
-
-	log.Printf("[INFO] Found %d config aggregate authorizations", len(aggregateAuthorizations))
+	var resources []*sweep.Resource
 
 	for _, auth := range aggregateAuthorizations {
-		log.Printf("[INFO] Deleting config authorization %s", *auth.AggregationAuthorizationArn)
-		_, err := conn.DeleteAggregationAuthorization(&configservice.DeleteAggregationAuthorizationInput{
+		input := &configservice.DeleteAggregationAuthorizationInput{
 			AuthorizedAccountId: auth.AuthorizedAccountId,
 			AuthorizedAwsRegion: auth.AuthorizedAwsRegion,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting config aggregate authorization %s: %s", *auth.AggregationAuthorizationArn, err)
 		}
+
+		resources = append(resources, &sweep.Resource{
+			ID:        aws.StringValue(auth.AggregationAuthorizationArn),
+			CreatedAt: aws.TimeValue(auth.CreationTime),
+			Delete: func() error {
+				_, err := conn.DeleteAggregationAuthorization(input)
+				return err
+			},
+		})
 	}
 
-	return nil
+	return testSweepResources(region, "aws_config_aggregate_authorization", sweep.Filter{}, resources)
 }
 
 func TestAccAWSConfigAggregateAuthorization_basic(t *testing.T) {
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
+	addTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
 		Name: "aws_config_configuration_aggregator",
 		F:    testSweepConfigConfigurationAggregators,
 	})
@@ -36,25 +37,24 @@ func testSweepConfigConfigurationAggregators(region string) error {
 		return fmt.Errorf("Error retrieving config configuration aggregators: %s", err)
 	}
 
-	if len(resp.ConfigurationAggregators) == 0 {
-		log.Print("[DEBUG] No config configuration aggregators to sweep")
-		return nil
-	}
-
-	log.Printf("[INFO] Found %d config configuration aggregators", len(resp.ConfigurationAggregators))
+	var resources []*sweep.Resource
 
 	for _, agg := range resp.ConfigurationAggregators {
-		log.Printf("[INFO] Deleting config configuration aggregator %s", *agg.ConfigurationAggregatorName)
-		_, err := conn.DeleteConfigurationAggregator(&configservice.DeleteConfigurationAggregatorInput{
-			ConfigurationAggregatorName: agg.ConfigurationAggregatorName,
+		name := aws.StringValue(agg.ConfigurationAggregatorName)
+
+		resources = append(resources, &sweep.Resource{
+			ID:        name,
+			CreatedAt: aws.TimeValue(agg.CreationTime),
+			Delete: func() error {
+				_, err := conn.DeleteConfigurationAggregator(&configservice.DeleteConfigurationAggregatorInput{
+					ConfigurationAggregatorName: aws.String(name),
+				})
+				return err
+			},
 		})
-
-		if err != nil {
-			return fmt.Errorf("Error deleting config configuration aggregator %s: %s", *agg.ConfigurationAggregatorName, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_config_configuration_aggregator", sweep.Filter{}, resources)
 }
 
 func TestAccAWSConfigConfigurationAggregator_account(t *testing.T) {
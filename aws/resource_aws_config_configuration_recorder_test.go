@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
+	addTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
 		Name: "aws_config_configuration_recorder",
 		F:    testSweepConfigConfigurationRecorder,
 	})
@@ -37,30 +38,30 @@ func testSweepConfigConfigurationRecorder(region string) error {
 		return fmt.Errorf("Error describing Configuration Recorders: %s", err)
 	}
 
-	if len(resp.ConfigurationRecorders) == 0 {
-		log.Print("[DEBUG] No AWS Config Configuration Recorder to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, cr := range resp.ConfigurationRecorders {
-		_, err := conn.StopConfigurationRecorder(&configservice.StopConfigurationRecorderInput{
-			ConfigurationRecorderName: cr.Name,
-		})
-		if err != nil {
-			return err
-		}
-
-		_, err = conn.DeleteConfigurationRecorder(&configservice.DeleteConfigurationRecorderInput{
-			ConfigurationRecorderName: cr.Name,
+		name := aws.StringValue(cr.Name)
+
+		resources = append(resources, &sweep.Resource{
+			ID: name,
+			Delete: func() error {
+				_, err := conn.StopConfigurationRecorder(&configservice.StopConfigurationRecorderInput{
+					ConfigurationRecorderName: aws.String(name),
+				})
+				if err != nil {
+					return fmt.Errorf("error stopping: %s", err)
+				}
+
+				_, err = conn.DeleteConfigurationRecorder(&configservice.DeleteConfigurationRecorderInput{
+					ConfigurationRecorderName: aws.String(name),
+				})
+				return err
+			},
 		})
-		if err != nil {
-			return fmt.Errorf(
-				"Error deleting Configuration Recorder (%s): %s",
-				*cr.Name, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_config_configuration_recorder", sweep.Filter{}, resources)
 }
 
 func testAccConfigConfigurationRecorder_basic(t *testing.T) {
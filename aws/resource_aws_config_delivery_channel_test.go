@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
+	addTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
 		Name: "aws_config_delivery_channel",
 		Dependencies: []string{
 			"aws_config_configuration_recorder",
@@ -53,23 +54,23 @@ func testSweepConfigDeliveryChannels(region string) error {
 		return fmt.Errorf("Error describing Delivery Channels: %s", err)
 	}
 
-	if len(resp.DeliveryChannels) == 0 {
-		log.Print("[DEBUG] No AWS Config Delivery Channel to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, dc := range resp.DeliveryChannels {
-		_, err := conn.DeleteDeliveryChannel(&configservice.DeleteDeliveryChannelInput{
-			DeliveryChannelName: dc.Name,
+		name := aws.StringValue(dc.Name)
+
+		resources = append(resources, &sweep.Resource{
+			ID: name,
+			Delete: func() error {
+				_, err := conn.DeleteDeliveryChannel(&configservice.DeleteDeliveryChannelInput{
+					DeliveryChannelName: aws.String(name),
+				})
+				return err
+			},
 		})
-		if err != nil {
-			return fmt.Errorf(
-				"Error deleting Delivery Channel (%s): %s",
-				*dc.Name, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_config_delivery_channel", sweep.Filter{}, resources)
 }
 
 func testAccConfigDeliveryChannel_basic(t *testing.T) {
@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
+	addTestSweepers("aws_datasync_agent", &resource.Sweeper{
 		Name: "aws_datasync_agent",
 		F:    testSweepDataSyncAgents,
 	})
@@ -29,6 +30,8 @@ func testSweepDataSyncAgents(region string) error {
 	conn := client.(*AWSClient).datasyncconn
 
 	input := &datasync.ListAgentsInput{}
+	var resources []*sweep.Resource
+
 	for {
 		output, err := conn.ListAgents(input)
 
@@ -41,28 +44,22 @@ func testSweepDataSyncAgents(region string) error {
 			return fmt.Errorf("Error retrieving DataSync Agents: %s", err)
 		}
 
-		if len(output.Agents) == 0 {
-			log.Print("[DEBUG] No DataSync Agents to sweep")
-			return nil
-		}
-
 		for _, agent := range output.Agents {
-			name := aws.StringValue(agent.Name)
-
-			log.Printf("[INFO] Deleting DataSync Agent: %s", name)
 			input := &datasync.DeleteAgentInput{
 				AgentArn: agent.AgentArn,
 			}
 
-			_, err := conn.DeleteAgent(input)
-
-			if isAWSErr(err, "InvalidRequestException", "not found") {
-				continue
-			}
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Agent (%s): %s", name, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:   aws.StringValue(agent.AgentArn),
+				Name: aws.StringValue(agent.Name),
+				Delete: func() error {
+					_, err := conn.DeleteAgent(input)
+					if isAWSErr(err, "InvalidRequestException", "not found") {
+						return nil
+					}
+					return err
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -72,6 +69,10 @@ func testSweepDataSyncAgents(region string) error {
 		input.NextToken = output.NextToken
 	}
 
+	if err := testSweepResources(region, "aws_datasync_agent", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
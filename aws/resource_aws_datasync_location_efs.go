@@ -161,8 +161,8 @@ func resourceAwsDataSyncLocationEfsRead(d *schema.ResourceData, meta interface{}
 func resourceAwsDataSyncLocationEfsUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).datasyncconn
 
-	if d.HasChange("tags") {
-		oldRaw, newRaw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldRaw, newRaw := d.GetChange("tags_all")
 		createTags, removeTags := dataSyncTagsDiff(expandDataSyncTagListEntry(oldRaw.(map[string]interface{})), expandDataSyncTagListEntry(newRaw.(map[string]interface{})))
 
 		if len(removeTags) > 0 {
@@ -12,10 +12,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_location_efs", &resource.Sweeper{
+	addTestSweepers("aws_datasync_location_efs", &resource.Sweeper{
 		Name: "aws_datasync_location_efs",
 		F:    testSweepDataSyncLocationEfss,
 	})
@@ -29,6 +30,8 @@ func testSweepDataSyncLocationEfss(region string) error {
 	conn := client.(*AWSClient).datasyncconn
 
 	input := &datasync.ListLocationsInput{}
+	var resources []*sweep.Resource
+
 	for {
 		output, err := conn.ListLocations(input)
 
@@ -41,31 +44,27 @@ func testSweepDataSyncLocationEfss(region string) error {
 			return fmt.Errorf("Error retrieving DataSync Location EFSs: %s", err)
 		}
 
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location EFSs to sweep")
-			return nil
-		}
-
 		for _, location := range output.Locations {
 			uri := aws.StringValue(location.LocationUri)
 			if !strings.HasPrefix(uri, "efs://") {
-				log.Printf("[INFO] Skipping DataSync Location EFS: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location EFS: %s", uri)
+
 			input := &datasync.DeleteLocationInput{
 				LocationArn: location.LocationArn,
 			}
 
-			_, err := conn.DeleteLocation(input)
-
-			if isAWSErr(err, "InvalidRequestException", "not found") {
-				continue
-			}
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location EFS (%s): %s", uri, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:   aws.StringValue(location.LocationArn),
+				Name: uri,
+				Delete: func() error {
+					_, err := conn.DeleteLocation(input)
+					if isAWSErr(err, "InvalidRequestException", "not found") {
+						return nil
+					}
+					return err
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -75,6 +74,10 @@ func testSweepDataSyncLocationEfss(region string) error {
 		input.NextToken = output.NextToken
 	}
 
+	if err := testSweepResources(region, "aws_datasync_location_efs", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_location_nfs", &resource.Sweeper{
+	addTestSweepers("aws_datasync_location_nfs", &resource.Sweeper{
 		Name: "aws_datasync_location_nfs",
 		F:    testSweepDataSyncLocationNfss,
 	})
@@ -30,43 +31,41 @@ func testSweepDataSyncLocationNfss(region string) error {
 	conn := client.(*AWSClient).datasyncconn
 
 	input := &datasync.ListLocationsInput{}
+	var resources []*sweep.Resource
+
 	for {
 		output, err := conn.ListLocations(input)
 
 		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping DataSync Location EFS sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping DataSync Location NFS sweep for %s: %s", region, err)
 			return nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Location EFSs: %s", err)
-		}
-
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location EFSs to sweep")
-			return nil
+			return fmt.Errorf("Error retrieving DataSync Location NFSs: %s", err)
 		}
 
 		for _, location := range output.Locations {
 			uri := aws.StringValue(location.LocationUri)
 			if !strings.HasPrefix(uri, "nfs://") {
-				log.Printf("[INFO] Skipping DataSync Location EFS: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location EFS: %s", uri)
+
 			input := &datasync.DeleteLocationInput{
 				LocationArn: location.LocationArn,
 			}
 
-			_, err := conn.DeleteLocation(input)
-
-			if isAWSErr(err, "InvalidRequestException", "not found") {
-				continue
-			}
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location EFS (%s): %s", uri, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:   aws.StringValue(location.LocationArn),
+				Name: uri,
+				Delete: func() error {
+					_, err := conn.DeleteLocation(input)
+					if isAWSErr(err, "InvalidRequestException", "not found") {
+						return nil
+					}
+					return err
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -76,6 +75,10 @@ func testSweepDataSyncLocationNfss(region string) error {
 		input.NextToken = output.NextToken
 	}
 
+	if err := testSweepResources(region, "aws_datasync_location_nfs", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_location_s3", &resource.Sweeper{
+	addTestSweepers("aws_datasync_location_s3", &resource.Sweeper{
 		Name: "aws_datasync_location_s3",
 		F:    testSweepDataSyncLocationS3s,
 	})
@@ -30,6 +31,8 @@ func testSweepDataSyncLocationS3s(region string) error {
 	conn := client.(*AWSClient).datasyncconn
 
 	input := &datasync.ListLocationsInput{}
+	var resources []*sweep.Resource
+
 	for {
 		output, err := conn.ListLocations(input)
 
@@ -42,31 +45,27 @@ func testSweepDataSyncLocationS3s(region string) error {
 			return fmt.Errorf("Error retrieving DataSync Location S3s: %s", err)
 		}
 
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location S3s to sweep")
-			return nil
-		}
-
 		for _, location := range output.Locations {
 			uri := aws.StringValue(location.LocationUri)
 			if !strings.HasPrefix(uri, "s3://") {
-				log.Printf("[INFO] Skipping DataSync Location S3: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location S3: %s", uri)
+
 			input := &datasync.DeleteLocationInput{
 				LocationArn: location.LocationArn,
 			}
 
-			_, err := conn.DeleteLocation(input)
-
-			if isAWSErr(err, "InvalidRequestException", "not found") {
-				continue
-			}
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location S3 (%s): %s", uri, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:   aws.StringValue(location.LocationArn),
+				Name: uri,
+				Delete: func() error {
+					_, err := conn.DeleteLocation(input)
+					if isAWSErr(err, "InvalidRequestException", "not found") {
+						return nil
+					}
+					return err
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -76,6 +75,10 @@ func testSweepDataSyncLocationS3s(region string) error {
 		input.NextToken = output.NextToken
 	}
 
+	if err := testSweepResources(region, "aws_datasync_location_s3", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
+	addTestSweepers("aws_datasync_task", &resource.Sweeper{
 		Name: "aws_datasync_task",
 		F:    testSweepDataSyncTasks,
 	})
@@ -29,6 +30,8 @@ func testSweepDataSyncTasks(region string) error {
 	conn := client.(*AWSClient).datasyncconn
 
 	input := &datasync.ListTasksInput{}
+	var resources []*sweep.Resource
+
 	for {
 		output, err := conn.ListTasks(input)
 
@@ -41,28 +44,22 @@ func testSweepDataSyncTasks(region string) error {
 			return fmt.Errorf("Error retrieving DataSync Tasks: %s", err)
 		}
 
-		if len(output.Tasks) == 0 {
-			log.Print("[DEBUG] No DataSync Tasks to sweep")
-			return nil
-		}
-
 		for _, task := range output.Tasks {
-			name := aws.StringValue(task.Name)
-
-			log.Printf("[INFO] Deleting DataSync Task: %s", name)
 			input := &datasync.DeleteTaskInput{
 				TaskArn: task.TaskArn,
 			}
 
-			_, err := conn.DeleteTask(input)
-
-			if isAWSErr(err, "InvalidRequestException", "not found") {
-				continue
-			}
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Task (%s): %s", name, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:   aws.StringValue(task.TaskArn),
+				Name: aws.StringValue(task.Name),
+				Delete: func() error {
+					_, err := conn.DeleteTask(input)
+					if isAWSErr(err, "InvalidRequestException", "not found") {
+						return nil
+					}
+					return err
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -72,6 +69,10 @@ func testSweepDataSyncTasks(region string) error {
 		input.NextToken = output.NextToken
 	}
 
+	if err := testSweepResources(region, "aws_datasync_task", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
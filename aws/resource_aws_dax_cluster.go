@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsDaxCluster() *schema.Resource {
@@ -174,7 +175,7 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 	securityIdSet := d.Get("security_group_ids").(*schema.Set)
 
 	securityIds := expandStringList(securityIdSet.List())
-	tags := tagsFromMapDax(d.Get("tags").(map[string]interface{}))
+	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().DaxTags()
 
 	req := &dax.CreateClusterInput{
 		ClusterName:       aws.String(clusterName),
@@ -331,7 +332,7 @@ func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
 	if len(resp.Tags) > 0 {
 		dt = resp.Tags
 	}
-	d.Set("tags", tagsToMapDax(dt))
+	d.Set("tags", keyvaluetags.DaxKeyValueTags(dt).IgnoreAws().Map())
 
 	return nil
 }
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dax_cluster", &resource.Sweeper{
+	addTestSweepers("aws_dax_cluster", &resource.Sweeper{
 		Name: "aws_dax_cluster",
 		F:    testSweepDAXClusters,
 	})
@@ -38,24 +39,23 @@ func testSweepDAXClusters(region string) error {
 		return fmt.Errorf("Error retrieving DAX clusters: %s", err)
 	}
 
-	if len(resp.Clusters) == 0 {
-		log.Print("[DEBUG] No DAX clusters to sweep")
-		return nil
-	}
-
-	log.Printf("[INFO] Found %d DAX clusters", len(resp.Clusters))
+	var resources []*sweep.Resource
 
 	for _, cluster := range resp.Clusters {
-		log.Printf("[INFO] Deleting DAX cluster %s", *cluster.ClusterName)
-		_, err := conn.DeleteCluster(&dax.DeleteClusterInput{
-			ClusterName: cluster.ClusterName,
+		name := aws.StringValue(cluster.ClusterName)
+
+		resources = append(resources, &sweep.Resource{
+			ID: name,
+			Delete: func() error {
+				_, err := conn.DeleteCluster(&dax.DeleteClusterInput{
+					ClusterName: aws.String(name),
+				})
+				return err
+			},
 		})
-		if err != nil {
-			return fmt.Errorf("Error deleting DAX cluster %s: %s", *cluster.ClusterName, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_dax_cluster", sweep.Filter{}, resources)
 }
 
 func TestAccAWSDAXCluster_importBasic(t *testing.T) {
@@ -14,10 +14,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_instance", &resource.Sweeper{
+	addTestSweepers("aws_db_instance", &resource.Sweeper{
 		Name: "aws_db_instance",
 		F:    testSweepDbInstances,
 	})
@@ -30,25 +31,27 @@ func testSweepDbInstances(region string) error {
 	}
 	conn := client.(*AWSClient).rdsconn
 
+	var resources []*sweep.Resource
+
 	err = conn.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{}, func(out *rds.DescribeDBInstancesOutput, lastPage bool) bool {
 		for _, dbi := range out.DBInstances {
-			log.Printf("[INFO] Deleting DB instance: %s", *dbi.DBInstanceIdentifier)
+			id := aws.StringValue(dbi.DBInstanceIdentifier)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				CreatedAt: aws.TimeValue(dbi.InstanceCreateTime),
+				Delete: func() error {
+					_, err := conn.DeleteDBInstance(&rds.DeleteDBInstanceInput{
+						DBInstanceIdentifier: aws.String(id),
+						SkipFinalSnapshot:    aws.Bool(true),
+					})
+					if err != nil {
+						return err
+					}
 
-			_, err := conn.DeleteDBInstance(&rds.DeleteDBInstanceInput{
-				DBInstanceIdentifier: dbi.DBInstanceIdentifier,
-				SkipFinalSnapshot:    aws.Bool(true),
+					return waitUntilAwsDbInstanceIsDeleted(id, conn, 40*time.Minute)
+				},
 			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DB instance %s: %s",
-					*dbi.DBInstanceIdentifier, err)
-				continue
-			}
-
-			err = waitUntilAwsDbInstanceIsDeleted(*dbi.DBInstanceIdentifier, conn, 40*time.Minute)
-			if err != nil {
-				log.Printf("[ERROR] Failure while waiting for DB instance %s to be deleted: %s",
-					*dbi.DBInstanceIdentifier, err)
-			}
 		}
 		return !lastPage
 	})
@@ -60,6 +63,10 @@ func testSweepDbInstances(region string) error {
 		return fmt.Errorf("Error retrieving DB instances: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_db_instance", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -14,10 +14,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
+	addTestSweepers("aws_db_option_group", &resource.Sweeper{
 		Name: "aws_db_option_group",
 		F:    testSweepDbOptionGroups,
 	})
@@ -41,34 +42,36 @@ func testSweepDbOptionGroups(region string) error {
 		return fmt.Errorf("error describing DB Option Groups in Sweeper: %s", err)
 	}
 
+	var resources []*sweep.Resource
+
 	for _, og := range resp.OptionGroupsList {
 		if strings.HasPrefix(aws.StringValue(og.OptionGroupName), "default") {
 			continue
 		}
 
-		log.Printf("[INFO] Deleting RDS Option Group: %s", aws.StringValue(og.OptionGroupName))
-
 		deleteOpts := &rds.DeleteOptionGroupInput{
 			OptionGroupName: og.OptionGroupName,
 		}
 
-		ret := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.DeleteOptionGroup(deleteOpts)
-			if err != nil {
-				if isAWSErr(err, rds.ErrCodeInvalidOptionGroupStateFault, "") {
-					log.Printf("[DEBUG] AWS believes the RDS Option Group is still in use, retrying")
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
+		resources = append(resources, &sweep.Resource{
+			ID: aws.StringValue(og.OptionGroupName),
+			Delete: func() error {
+				return resource.Retry(1*time.Minute, func() *resource.RetryError {
+					_, err := conn.DeleteOptionGroup(deleteOpts)
+					if err != nil {
+						if isAWSErr(err, rds.ErrCodeInvalidOptionGroupStateFault, "") {
+							log.Printf("[DEBUG] AWS believes the RDS Option Group is still in use, retrying")
+							return resource.RetryableError(err)
+						}
+						return resource.NonRetryableError(err)
+					}
+					return nil
+				})
+			},
 		})
-		if ret != nil {
-			return fmt.Errorf("Error Deleting DB Option Group (%s) in Sweeper: %s", *og.OptionGroupName, ret)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_db_option_group", sweep.Filter{}, resources)
 }
 
 func TestAccAWSDBOptionGroup_basic(t *testing.T) {
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsDbSnapshot() *schema.Resource {
@@ -110,7 +111,7 @@ func resourceAwsDbSnapshot() *schema.Resource {
 
 func resourceAwsDbSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().RdsTags()
 
 	params := &rds.CreateDBSnapshotInput{
 		DBInstanceIdentifier: aws.String(d.Get("db_instance_identifier").(string)),
@@ -191,39 +192,11 @@ func resourceAwsDbSnapshotDelete(d *schema.ResourceData, meta interface{}) error
 func resourceAwsDbSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
 	arn := d.Get("db_snapshot_arn").(string)
-	if d.HasChange("tags") {
-		oldTagsRaw, newTagsRaw := d.GetChange("tags")
-		oldTagsMap := oldTagsRaw.(map[string]interface{})
-		newTagsMap := newTagsRaw.(map[string]interface{})
-		createTags, removeTags := diffTagsRDS(tagsFromMapRDS(oldTagsMap), tagsFromMapRDS(newTagsMap))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		if len(removeTags) > 0 {
-			removeTagKeys := make([]*string, len(removeTags))
-			for i, removeTag := range removeTags {
-				removeTagKeys[i] = removeTag.Key
-			}
-
-			input := &rds.RemoveTagsFromResourceInput{
-				ResourceName: aws.String(arn),
-				TagKeys:      removeTagKeys,
-			}
-
-			log.Printf("[DEBUG] Untagging RDS Cluster: %s", input)
-			if _, err := conn.RemoveTagsFromResource(input); err != nil {
-				return fmt.Errorf("error untagging RDS Cluster (%s): %s", d.Id(), err)
-			}
-		}
-
-		if len(createTags) > 0 {
-			input := &rds.AddTagsToResourceInput{
-				ResourceName: aws.String(arn),
-				Tags:         createTags,
-			}
-
-			log.Printf("[DEBUG] Tagging RDS Cluster: %s", input)
-			if _, err := conn.AddTagsToResource(input); err != nil {
-				return fmt.Errorf("error tagging RDS Cluster (%s): %s", d.Id(), err)
-			}
+		if err := keyvaluetags.RdsUpdateTags(conn, arn, o, n); err != nil {
+			return fmt.Errorf("error updating RDS DB Snapshot (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -13,10 +13,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
+	addTestSweepers("aws_db_subnet_group", &resource.Sweeper{
 		Name: "aws_db_subnet_group",
 		F:    testSweepRdsDbSubnetGroups,
 		Dependencies: []string{
@@ -34,21 +35,21 @@ func testSweepRdsDbSubnetGroups(region string) error {
 
 	conn := client.(*AWSClient).rdsconn
 	input := &rds.DescribeDBSubnetGroupsInput{}
+	var resources []*sweep.Resource
 
 	err = conn.DescribeDBSubnetGroupsPages(input, func(out *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
 		for _, dbSubnetGroup := range out.DBSubnetGroups {
-			name := aws.StringValue(dbSubnetGroup.DBSubnetGroupName)
 			input := &rds.DeleteDBSubnetGroupInput{
 				DBSubnetGroupName: dbSubnetGroup.DBSubnetGroupName,
 			}
 
-			log.Printf("[INFO] Deleting RDS DB Subnet Group: %s", name)
-
-			_, err := conn.DeleteDBSubnetGroup(input)
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete RDS DB Subnet Group (%s): %s", name, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID: aws.StringValue(dbSubnetGroup.DBSubnetGroupName),
+				Delete: func() error {
+					_, err := conn.DeleteDBSubnetGroup(input)
+					return err
+				},
+			})
 		}
 		return !lastPage
 	})
@@ -62,6 +63,10 @@ func testSweepRdsDbSubnetGroups(region string) error {
 		return fmt.Errorf("error retrieving RDS DB Subnet Groups: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_db_subnet_group", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
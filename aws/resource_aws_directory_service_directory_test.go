@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
+	addTestSweepers("aws_directory_service_directory", &resource.Sweeper{
 		Name: "aws_directory_service_directory",
 		F:    testSweepDirectoryServiceDirectories,
 	})
@@ -30,6 +30,8 @@ func testSweepDirectoryServiceDirectories(region string) error {
 	conn := client.(*AWSClient).dsconn
 
 	input := &directoryservice.DescribeDirectoriesInput{}
+	var resources []*sweep.Resource
+
 	for {
 		resp, err := conn.DescribeDirectories(input)
 
@@ -45,21 +47,22 @@ func testSweepDirectoryServiceDirectories(region string) error {
 		for _, directory := range resp.DirectoryDescriptions {
 			id := aws.StringValue(directory.DirectoryId)
 
-			deleteDirectoryInput := directoryservice.DeleteDirectoryInput{
-				DirectoryId: directory.DirectoryId,
-			}
-
-			log.Printf("[INFO] Deleting Directory Service Directory: %s", deleteDirectoryInput)
-			_, err := conn.DeleteDirectory(&deleteDirectoryInput)
-			if err != nil {
-				return fmt.Errorf("error deleting Directory Service Directory (%s): %s", id, err)
-			}
-
-			log.Printf("[INFO] Waiting for Directory Service Directory (%q) to be deleted", id)
-			err = waitForDirectoryServiceDirectoryDeletion(conn, id)
-			if err != nil {
-				return fmt.Errorf("error waiting for Directory Service (%s) to be deleted: %s", id, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				Name:      aws.StringValue(directory.Name),
+				CreatedAt: aws.TimeValue(directory.LaunchTime),
+				Delete: func() error {
+					_, err := conn.DeleteDirectory(&directoryservice.DeleteDirectoryInput{
+						DirectoryId: aws.String(id),
+					})
+					if err != nil {
+						return err
+					}
+
+					log.Printf("[INFO] Waiting for Directory Service Directory (%q) to be deleted", id)
+					return waitForDirectoryServiceDirectoryDeletion(conn, id)
+				},
+			})
 		}
 
 		if resp.NextToken == nil {
@@ -69,58 +72,7 @@ func testSweepDirectoryServiceDirectories(region string) error {
 		input.NextToken = resp.NextToken
 	}
 
-	return nil
-}
-
-func TestDiffTagsDirectoryService(t *testing.T) {
-	cases := []struct {
-		Old, New       map[string]interface{}
-		Create, Remove map[string]string
-	}{
-		// Basic add/remove
-		{
-			Old: map[string]interface{}{
-				"foo": "bar",
-			},
-			New: map[string]interface{}{
-				"bar": "baz",
-			},
-			Create: map[string]string{
-				"bar": "baz",
-			},
-			Remove: map[string]string{
-				"foo": "bar",
-			},
-		},
-
-		// Modify
-		{
-			Old: map[string]interface{}{
-				"foo": "bar",
-			},
-			New: map[string]interface{}{
-				"foo": "baz",
-			},
-			Create: map[string]string{
-				"foo": "baz",
-			},
-			Remove: map[string]string{
-				"foo": "bar",
-			},
-		},
-	}
-
-	for i, tc := range cases {
-		c, r := diffTagsDS(tagsFromMapDS(tc.Old), tagsFromMapDS(tc.New))
-		cm := tagsToMapDS(c)
-		rm := tagsToMapDS(r)
-		if !reflect.DeepEqual(cm, tc.Create) {
-			t.Fatalf("%d: bad create: %#v", i, cm)
-		}
-		if !reflect.DeepEqual(rm, tc.Remove) {
-			t.Fatalf("%d: bad remove: %#v", i, rm)
-		}
-	}
+	return testSweepResources(region, "aws_directory_service_directory", sweep.Filter{}, resources)
 }
 
 func TestAccAWSDirectoryServiceDirectory_importBasic(t *testing.T) {
@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
+	addTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
 		Name: "aws_dx_gateway_association",
 		F:    testSweepDirectConnectGatewayAssociations,
 	})
@@ -28,6 +29,7 @@ func testSweepDirectConnectGatewayAssociations(region string) error {
 	}
 	conn := client.(*AWSClient).dxconn
 	gatewayInput := &directconnect.DescribeDirectConnectGatewaysInput{}
+	var resources []*sweep.Resource
 
 	for {
 		gatewayOutput, err := conn.DescribeDirectConnectGateways(gatewayInput)
@@ -63,24 +65,29 @@ func testSweepDirectConnectGatewayAssociations(region string) error {
 						continue
 					}
 
-					input := &directconnect.DeleteDirectConnectGatewayAssociationInput{
-						AssociationId: association.AssociationId,
-					}
+					associationID := aws.StringValue(association.AssociationId)
 
-					log.Printf("[INFO] Deleting Direct Connect Gateway (%s) Association: %s", directConnectGatewayID, gatewayID)
-					_, err := conn.DeleteDirectConnectGatewayAssociation(input)
+					resources = append(resources, &sweep.Resource{
+						ID:   associationID,
+						Name: fmt.Sprintf("%s/%s", directConnectGatewayID, gatewayID),
+						Delete: func() error {
+							input := &directconnect.DeleteDirectConnectGatewayAssociationInput{
+								AssociationId: aws.String(associationID),
+							}
 
-					if isAWSErr(err, directconnect.ErrCodeClientException, "No association exists") {
-						continue
-					}
+							_, err := conn.DeleteDirectConnectGatewayAssociation(input)
 
-					if err != nil {
-						return fmt.Errorf("error deleting Direct Connect Gateway (%s) Association (%s): %s", directConnectGatewayID, gatewayID, err)
-					}
+							if isAWSErr(err, directconnect.ErrCodeClientException, "No association exists") {
+								return nil
+							}
 
-					if err := waitForDirectConnectGatewayAssociationDeletion(conn, aws.StringValue(association.AssociationId), 20*time.Minute); err != nil {
-						return fmt.Errorf("error waiting for Direct Connect Gateway (%s) Association (%s) to be deleted: %s", directConnectGatewayID, gatewayID, err)
-					}
+							if err != nil {
+								return err
+							}
+
+							return waitForDirectConnectGatewayAssociationDeletion(conn, associationID, 20*time.Minute)
+						},
+					})
 				}
 
 				if aws.StringValue(associationOutput.NextToken) == "" {
@@ -98,7 +105,7 @@ func testSweepDirectConnectGatewayAssociations(region string) error {
 		gatewayInput.NextToken = gatewayOutput.NextToken
 	}
 
-	return nil
+	return testSweepResources(region, "aws_dx_gateway_association", sweep.Filter{}, resources)
 }
 
 func TestAccAwsDxGatewayAssociation_deprecatedSingleAccount(t *testing.T) {
@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	addTestSweepers("aws_dx_gateway", &resource.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    testSweepDirectConnectGateways,
 		Dependencies: []string{
@@ -31,6 +32,7 @@ func testSweepDirectConnectGateways(region string) error {
 	}
 	conn := client.(*AWSClient).dxconn
 	input := &directconnect.DescribeDirectConnectGatewaysInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.DescribeDirectConnectGateways(input)
@@ -52,24 +54,27 @@ func testSweepDirectConnectGateways(region string) error {
 				continue
 			}
 
-			input := &directconnect.DeleteDirectConnectGatewayInput{
-				DirectConnectGatewayId: aws.String(id),
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:   id,
+				Name: aws.StringValue(gateway.DirectConnectGatewayName),
+				Delete: func() error {
+					input := &directconnect.DeleteDirectConnectGatewayInput{
+						DirectConnectGatewayId: aws.String(id),
+					}
 
-			log.Printf("[INFO] Deleting Direct Connect Gateway: %s", id)
-			_, err := conn.DeleteDirectConnectGateway(input)
+					_, err := conn.DeleteDirectConnectGateway(input)
 
-			if isAWSErr(err, directconnect.ErrCodeClientException, "does not exist") {
-				continue
-			}
+					if isAWSErr(err, directconnect.ErrCodeClientException, "does not exist") {
+						return nil
+					}
 
-			if err != nil {
-				return fmt.Errorf("error deleting Direct Connect Gateway (%s): %s", id, err)
-			}
+					if err != nil {
+						return err
+					}
 
-			if err := waitForDirectConnectGatewayDeletion(conn, id, 20*time.Minute); err != nil {
-				return fmt.Errorf("error waiting for Direct Connect Gateway (%s) to be deleted: %s", id, err)
-			}
+					return waitForDirectConnectGatewayDeletion(conn, id, 20*time.Minute)
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -79,7 +84,7 @@ func testSweepDirectConnectGateways(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return testSweepResources(region, "aws_dx_gateway", sweep.Filter{}, resources)
 }
 
 func TestAccAwsDxGateway_importBasic(t *testing.T) {
@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
+	addTestSweepers("aws_dynamodb_table", &resource.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    testSweepDynamoDbTables,
 	})
@@ -29,15 +30,18 @@ func testSweepDynamoDbTables(region string) error {
 	}
 	conn := client.(*AWSClient).dynamodbconn
 
+	var resources []*sweep.Resource
+
 	err = conn.ListTablesPages(&dynamodb.ListTablesInput{}, func(out *dynamodb.ListTablesOutput, lastPage bool) bool {
 		for _, tableName := range out.TableNames {
-			log.Printf("[INFO] Deleting DynamoDB Table: %s", *tableName)
+			name := aws.StringValue(tableName)
 
-			err := deleteAwsDynamoDbTable(*tableName, conn)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DynamoDB Table %s: %s", *tableName, err)
-				continue
-			}
+			resources = append(resources, &sweep.Resource{
+				ID: name,
+				Delete: func() error {
+					return deleteAwsDynamoDbTable(name, conn)
+				},
+			})
 		}
 		return !lastPage
 	})
@@ -49,6 +53,10 @@ func testSweepDynamoDbTables(region string) error {
 		return fmt.Errorf("Error retrieving DynamoDB Tables: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_dynamodb_table", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
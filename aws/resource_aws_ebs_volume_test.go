@@ -11,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
+	addTestSweepers("aws_ebs_volume", &resource.Sweeper{
 		Name: "aws_ebs_volume",
 		Dependencies: []string{
 			"aws_instance",
@@ -30,6 +32,8 @@ func testSweepEbsVolumes(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 
+	var resources []*sweep.Resource
+
 	err = conn.DescribeVolumesPages(&ec2.DescribeVolumesInput{}, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
 		for _, volume := range page.Volumes {
 			id := aws.StringValue(volume.VolumeId)
@@ -39,16 +43,19 @@ func testSweepEbsVolumes(region string) error {
 				continue
 			}
 
-			input := &ec2.DeleteVolumeInput{
-				VolumeId: aws.String(id),
-			}
-
-			log.Printf("[INFO] Deleting EC2 EBS Volume: %s", id)
-			_, err := conn.DeleteVolume(input)
-
-			if err != nil {
-				log.Printf("[ERROR] Error deleting EC2 EBS Volume (%s): %s", id, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				Tags:      keyvaluetags.Ec2KeyValueTags(volume.Tags),
+				CreatedAt: aws.TimeValue(volume.CreateTime),
+				Delete: func() error {
+					input := &ec2.DeleteVolumeInput{
+						VolumeId: aws.String(id),
+					}
+
+					_, err := conn.DeleteVolume(input)
+					return err
+				},
+			})
 		}
 
 		return !lastPage
@@ -63,6 +70,10 @@ func testSweepEbsVolumes(region string) error {
 		return fmt.Errorf("Error retrieving EC2 EBS Volumes: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_ebs_volume", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -10,10 +10,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
+	addTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
 		Name: "aws_ec2_capacity_reservation",
 		F:    testSweepEc2CapacityReservations,
 	})
@@ -37,27 +39,32 @@ func testSweepEc2CapacityReservations(region string) error {
 		return fmt.Errorf("Error retrieving EC2 Capacity Reservations: %s", err)
 	}
 
-	if len(resp.CapacityReservations) == 0 {
-		log.Print("[DEBUG] No EC2 Capacity Reservations to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, r := range resp.CapacityReservations {
-		if aws.StringValue(r.State) != ec2.CapacityReservationStateCancelled && aws.StringValue(r.State) != ec2.CapacityReservationStateExpired {
-			id := aws.StringValue(r.CapacityReservationId)
+		if aws.StringValue(r.State) == ec2.CapacityReservationStateCancelled || aws.StringValue(r.State) == ec2.CapacityReservationStateExpired {
+			continue
+		}
 
-			log.Printf("[INFO] Cancelling EC2 Capacity Reservation EC2 Instance: %s", id)
+		id := aws.StringValue(r.CapacityReservationId)
 
-			opts := &ec2.CancelCapacityReservationInput{
-				CapacityReservationId: aws.String(id),
-			}
+		resources = append(resources, &sweep.Resource{
+			ID:        id,
+			Tags:      keyvaluetags.Ec2KeyValueTags(r.Tags),
+			CreatedAt: aws.TimeValue(r.CreateDate),
+			Delete: func() error {
+				opts := &ec2.CancelCapacityReservationInput{
+					CapacityReservationId: aws.String(id),
+				}
 
-			_, err := conn.CancelCapacityReservation(opts)
+				_, err := conn.CancelCapacityReservation(opts)
+				return err
+			},
+		})
+	}
 
-			if err != nil {
-				log.Printf("[ERROR] Error cancelling EC2 Capacity Reservation (%s): %s", id, err)
-			}
-		}
+	if err := testSweepResources(region, "aws_ec2_capacity_reservation", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
 	}
 
 	return nil
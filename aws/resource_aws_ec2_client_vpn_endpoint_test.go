@@ -10,10 +10,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	addTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    testSweepEc2ClientVpnEndpoints,
 		Dependencies: []string{
@@ -31,6 +33,7 @@ func testSweepEc2ClientVpnEndpoints(region string) error {
 
 	conn := client.(*AWSClient).ec2conn
 	input := &ec2.DescribeClientVpnEndpointsInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.DescribeClientVpnEndpoints(input)
@@ -49,17 +52,18 @@ func testSweepEc2ClientVpnEndpoints(region string) error {
 				continue
 			}
 
-			id := aws.StringValue(clientVpnEndpoint.ClientVpnEndpointId)
 			input := &ec2.DeleteClientVpnEndpointInput{
 				ClientVpnEndpointId: clientVpnEndpoint.ClientVpnEndpointId,
 			}
 
-			log.Printf("[INFO] Deleting Client VPN Endpoint: %s", id)
-			_, err := conn.DeleteClientVpnEndpoint(input)
-
-			if err != nil {
-				return fmt.Errorf("error deleting Client VPN Endpoint (%s): %s", id, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:   aws.StringValue(clientVpnEndpoint.ClientVpnEndpointId),
+				Tags: keyvaluetags.Ec2KeyValueTags(clientVpnEndpoint.Tags),
+				Delete: func() error {
+					_, err := conn.DeleteClientVpnEndpoint(input)
+					return err
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -69,7 +73,7 @@ func testSweepEc2ClientVpnEndpoints(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return testSweepResources(region, "aws_ec2_client_vpn_endpoint", sweep.Filter{}, resources)
 }
 
 func TestAccAwsEc2ClientVpnEndpoint_basic(t *testing.T) {
@@ -10,10 +10,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	addTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    testSweepEc2TransitGateways,
 		Dependencies: []string{
@@ -29,6 +31,7 @@ func testSweepEc2TransitGateways(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 	input := &ec2.DescribeTransitGatewaysInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.DescribeTransitGateways(input)
@@ -49,24 +52,28 @@ func testSweepEc2TransitGateways(region string) error {
 
 			id := aws.StringValue(transitGateway.TransitGatewayId)
 
-			input := &ec2.DeleteTransitGatewayInput{
-				TransitGatewayId: aws.String(id),
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				Tags:      keyvaluetags.Ec2KeyValueTags(transitGateway.Tags),
+				CreatedAt: aws.TimeValue(transitGateway.CreationTime),
+				Delete: func() error {
+					input := &ec2.DeleteTransitGatewayInput{
+						TransitGatewayId: aws.String(id),
+					}
 
-			log.Printf("[INFO] Deleting EC2 Transit Gateway: %s", id)
-			_, err := conn.DeleteTransitGateway(input)
+					_, err := conn.DeleteTransitGateway(input)
 
-			if isAWSErr(err, "InvalidTransitGatewayID.NotFound", "") {
-				continue
-			}
+					if isAWSErr(err, "InvalidTransitGatewayID.NotFound", "") {
+						return nil
+					}
 
-			if err != nil {
-				return fmt.Errorf("error deleting EC2 Transit Gateway (%s): %s", id, err)
-			}
+					if err != nil {
+						return err
+					}
 
-			if err := waitForEc2TransitGatewayDeletion(conn, id); err != nil {
-				return fmt.Errorf("error waiting for EC2 Transit Gateway (%s) deletion: %s", id, err)
-			}
+					return waitForEc2TransitGatewayDeletion(conn, id)
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -76,7 +83,7 @@ func testSweepEc2TransitGateways(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return testSweepResources(region, "aws_ec2_transit_gateway", sweep.Filter{}, resources)
 }
 
 func TestAccAWSEc2TransitGateway_basic(t *testing.T) {
@@ -13,10 +13,12 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	addTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    testSweepEc2TransitGatewayVpcAttachments,
 	})
@@ -29,6 +31,7 @@ func testSweepEc2TransitGatewayVpcAttachments(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 	input := &ec2.DescribeTransitGatewayAttachmentsInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.DescribeTransitGatewayAttachments(input)
@@ -53,24 +56,28 @@ func testSweepEc2TransitGatewayVpcAttachments(region string) error {
 
 			id := aws.StringValue(attachment.TransitGatewayAttachmentId)
 
-			input := &ec2.DeleteTransitGatewayVpcAttachmentInput{
-				TransitGatewayAttachmentId: aws.String(id),
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				Tags:      keyvaluetags.Ec2KeyValueTags(attachment.Tags),
+				CreatedAt: aws.TimeValue(attachment.CreationTime),
+				Delete: func() error {
+					input := &ec2.DeleteTransitGatewayVpcAttachmentInput{
+						TransitGatewayAttachmentId: aws.String(id),
+					}
 
-			log.Printf("[INFO] Deleting EC2 Transit Gateway VPC Attachment: %s", id)
-			_, err := conn.DeleteTransitGatewayVpcAttachment(input)
+					_, err := conn.DeleteTransitGatewayVpcAttachment(input)
 
-			if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
-				continue
-			}
+					if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+						return nil
+					}
 
-			if err != nil {
-				return fmt.Errorf("error deleting EC2 Transit Gateway VPC Attachment (%s): %s", id, err)
-			}
+					if err != nil {
+						return err
+					}
 
-			if err := waitForEc2TransitGatewayRouteTableAttachmentDeletion(conn, id); err != nil {
-				return fmt.Errorf("error waiting for EC2 Transit Gateway VPC Attachment (%s) deletion: %s", id, err)
-			}
+					return waitForEc2TransitGatewayRouteTableAttachmentDeletion(conn, id)
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -80,7 +87,7 @@ func testSweepEc2TransitGatewayVpcAttachments(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return testSweepResources(region, "aws_ec2_transit_gateway_vpc_attachment", sweep.Filter{}, resources)
 }
 
 func TestAccAWSEc2TransitGatewayVpcAttachment_basic(t *testing.T) {
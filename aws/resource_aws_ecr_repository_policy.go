@@ -31,6 +31,7 @@ func resourceAwsEcrRepositoryPolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				ValidateFunc:     validateIAMResourcePolicy(0),
 			},
 			"registry_id": {
 				Type:     schema.TypeString,
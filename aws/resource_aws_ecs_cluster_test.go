@@ -10,10 +10,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
+	addTestSweepers("aws_ecs_cluster", &resource.Sweeper{
 		Name: "aws_ecs_cluster",
 		F:    testSweepEcsClusters,
 		Dependencies: []string{
@@ -29,6 +30,8 @@ func testSweepEcsClusters(region string) error {
 	}
 	conn := client.(*AWSClient).ecsconn
 
+	var resources []*sweep.Resource
+
 	err = conn.ListClustersPages(&ecs.ListClustersInput{}, func(page *ecs.ListClustersOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -38,14 +41,14 @@ func testSweepEcsClusters(region string) error {
 			input := &ecs.DeleteClusterInput{
 				Cluster: clusterARNPtr,
 			}
-			clusterARN := aws.StringValue(clusterARNPtr)
-
-			log.Printf("[INFO] Deleting ECS Cluster: %s", clusterARN)
-			_, err = conn.DeleteCluster(input)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting ECS Cluster (%s): %s", clusterARN, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID: aws.StringValue(clusterARNPtr),
+				Delete: func() error {
+					_, err := conn.DeleteCluster(input)
+					return err
+				},
+			})
 		}
 
 		return !isLast
@@ -58,6 +61,10 @@ func testSweepEcsClusters(region string) error {
 		return fmt.Errorf("error retrieving ECS Clusters: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_ecs_cluster", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
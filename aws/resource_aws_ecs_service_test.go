@@ -13,10 +13,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
+	addTestSweepers("aws_ecs_service", &resource.Sweeper{
 		Name: "aws_ecs_service",
 		F:    testSweepEcsServices,
 	})
@@ -29,6 +31,8 @@ func testSweepEcsServices(region string) error {
 	}
 	conn := client.(*AWSClient).ecsconn
 
+	var resources []*sweep.Resource
+
 	err = conn.ListClustersPages(&ecs.ListClustersInput{}, func(page *ecs.ListClustersOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -79,12 +83,16 @@ func testSweepEcsServices(region string) error {
 						Service: service.ServiceArn,
 					}
 
-					log.Printf("[INFO] Deleting ECS Service: %s", serviceARN)
-					_, err = conn.DeleteService(deleteServiceInput)
-
-					if err != nil {
-						log.Printf("[ERROR] Error deleting ECS Service (%s): %s", serviceARN, err)
-					}
+					resources = append(resources, &sweep.Resource{
+						ID:        serviceARN,
+						Name:      aws.StringValue(service.ServiceName),
+						Tags:      keyvaluetags.EcsKeyValueTags(service.Tags),
+						CreatedAt: aws.TimeValue(service.CreatedAt),
+						Delete: func() error {
+							_, err := conn.DeleteService(deleteServiceInput)
+							return err
+						},
+					})
 				}
 
 				return !isLast
@@ -101,6 +109,10 @@ func testSweepEcsServices(region string) error {
 		return fmt.Errorf("error retrieving ECS Services: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_ecs_service", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func TestResourceAWSEFSFileSystem_hasEmptyFileSystems(t *testing.T) {
@@ -348,7 +349,7 @@ func testAccCheckEfsFileSystemTags(resourceID string, expectedTags map[string]st
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
 
-		if !reflect.DeepEqual(expectedTags, tagsToMapEFS(resp.Tags)) {
+		if !reflect.DeepEqual(expectedTags, keyvaluetags.EfsKeyValueTags(resp.Tags).IgnoreAws().Map()) {
 			return fmt.Errorf("Tags mismatch.\nExpected: %#v\nGiven: %#v",
 				expectedTags, resp.Tags)
 		}
@@ -14,10 +14,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_eks_cluster", &resource.Sweeper{
+	addTestSweepers("aws_eks_cluster", &resource.Sweeper{
 		Name: "aws_eks_cluster",
 		F:    testSweepEksClusters,
 	})
@@ -31,6 +32,8 @@ func testSweepEksClusters(region string) error {
 	conn := client.(*AWSClient).eksconn
 
 	input := &eks.ListClustersInput{}
+	var resources []*sweep.Resource
+
 	for {
 		out, err := conn.ListClusters(input)
 		if err != nil {
@@ -41,24 +44,19 @@ func testSweepEksClusters(region string) error {
 			return fmt.Errorf("Error retrieving EKS Clusters: %s", err)
 		}
 
-		if out == nil || len(out.Clusters) == 0 {
-			log.Printf("[INFO] No EKS Clusters to sweep")
-			return nil
-		}
-
 		for _, cluster := range out.Clusters {
 			name := aws.StringValue(cluster)
 
-			log.Printf("[INFO] Deleting EKS Cluster: %s", name)
-			err := deleteEksCluster(conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete EKS Cluster %s: %s", name, err)
-				continue
-			}
-			err = waitForDeleteEksCluster(conn, name, 15*time.Minute)
-			if err != nil {
-				log.Printf("[ERROR] Failed to wait for EKS Cluster %s deletion: %s", name, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID: name,
+				Delete: func() error {
+					if err := deleteEksCluster(conn, name); err != nil {
+						return err
+					}
+
+					return waitForDeleteEksCluster(conn, name, 15*time.Minute)
+				},
+			})
 		}
 
 		if out.NextToken == nil {
@@ -68,6 +66,10 @@ func testSweepEksClusters(region string) error {
 		input.NextToken = out.NextToken
 	}
 
+	if err := testSweepResources(region, "aws_eks_cluster", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
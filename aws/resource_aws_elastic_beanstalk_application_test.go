@@ -11,13 +11,14 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // initialize sweeper
 func init() {
-	resource.AddTestSweepers("aws_beanstalk_application", &resource.Sweeper{
-		Name:         "aws_beanstalk_application",
-		Dependencies: []string{"aws_beanstalk_environment"},
+	addTestSweepers("aws_elastic_beanstalk_application", &resource.Sweeper{
+		Name:         "aws_elastic_beanstalk_application",
+		Dependencies: []string{"aws_elastic_beanstalk_environment"},
 		F:            testSweepBeanstalkApplications,
 	})
 }
@@ -38,28 +39,35 @@ func testSweepBeanstalkApplications(region string) error {
 		return fmt.Errorf("Error retrieving beanstalk application: %s", err)
 	}
 
-	if len(resp.Applications) == 0 {
-		log.Print("[DEBUG] No aws beanstalk applications to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, bsa := range resp.Applications {
-		_, err := beanstalkconn.DeleteApplication(
-			&elasticbeanstalk.DeleteApplicationInput{
-				ApplicationName: bsa.ApplicationName,
-			})
-		if err != nil {
-			elasticbeanstalkerr, ok := err.(awserr.Error)
-			if ok && (elasticbeanstalkerr.Code() == "InvalidConfiguration.NotFound" || elasticbeanstalkerr.Code() == "ValidationError") {
-				log.Printf("[DEBUG] beanstalk application (%s) not found", *bsa.ApplicationName)
-				return nil
-			}
+		name := aws.StringValue(bsa.ApplicationName)
+
+		resources = append(resources, &sweep.Resource{
+			ID:        name,
+			CreatedAt: aws.TimeValue(bsa.DateCreated),
+			Delete: func() error {
+				_, err := beanstalkconn.DeleteApplication(
+					&elasticbeanstalk.DeleteApplicationInput{
+						ApplicationName: aws.String(name),
+					})
+				if err != nil {
+					elasticbeanstalkerr, ok := err.(awserr.Error)
+					if ok && (elasticbeanstalkerr.Code() == "InvalidConfiguration.NotFound" || elasticbeanstalkerr.Code() == "ValidationError") {
+						log.Printf("[DEBUG] beanstalk application (%s) not found", name)
+						return nil
+					}
+
+					return err
+				}
 
-			return err
-		}
+				return nil
+			},
+		})
 	}
 
-	return nil
+	return testSweepResources(region, "aws_elastic_beanstalk_application", sweep.Filter{}, resources)
 }
 
 func TestAWSElasticBeanstalkApplication_importBasic(t *testing.T) {
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsElasticBeanstalkOptionSetting() *schema.Resource {
@@ -222,12 +223,12 @@ func resourceAwsElasticBeanstalkEnvironmentCreate(d *schema.ResourceData, meta i
 
 	// TODO set tags
 	// Note: at time of writing, you cannot view or edit Tags after creation
-	// d.Set("tags", tagsToMap(instance.Tags))
+	// d.Set("tags", keyvaluetags.Ec2KeyValueTags(instance.Tags).IgnoreAws().Map())
 	createOpts := elasticbeanstalk.CreateEnvironmentInput{
 		EnvironmentName: aws.String(name),
 		ApplicationName: aws.String(app),
 		OptionSettings:  extractOptionSettings(settings),
-		Tags:            tagsFromMapBeanstalk(d.Get("tags").(map[string]interface{})),
+		Tags:            keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().ElasticbeanstalkTags(),
 	}
 
 	if desc != "" {
@@ -475,60 +476,11 @@ func resourceAwsElasticBeanstalkEnvironmentUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
-		oldTags := tagsFromMapBeanstalk(o.(map[string]interface{}))
-		newTags := tagsFromMapBeanstalk(n.(map[string]interface{}))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		tagsToAdd, tagNamesToRemove := diffTagsBeanstalk(oldTags, newTags)
-
-		updateTags := elasticbeanstalk.UpdateTagsForResourceInput{
-			ResourceArn:  aws.String(d.Get("arn").(string)),
-			TagsToAdd:    tagsToAdd,
-			TagsToRemove: tagNamesToRemove,
-		}
-
-		// Get the current time to filter getBeanstalkEnvironmentErrors messages
-		t := time.Now()
-		log.Printf("[DEBUG] Elastic Beanstalk Environment update tags: %s", updateTags)
-		_, err := conn.UpdateTagsForResource(&updateTags)
-		if err != nil {
-			return err
-		}
-
-		waitForReadyTimeOut, err := time.ParseDuration(d.Get("wait_for_ready_timeout").(string))
-		if err != nil {
-			return err
-		}
-		pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
-		if err != nil {
-			pollInterval = 0
-			log.Printf("[WARN] Error parsing poll_interval, using default backoff")
-		}
-
-		stateConf := &resource.StateChangeConf{
-			Pending:      []string{"Launching", "Updating"},
-			Target:       []string{"Ready"},
-			Refresh:      environmentStateRefreshFunc(conn, d.Id(), t),
-			Timeout:      waitForReadyTimeOut,
-			Delay:        10 * time.Second,
-			PollInterval: pollInterval,
-			MinTimeout:   3 * time.Second,
-		}
-
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for Elastic Beanstalk Environment (%s) to become ready: %s",
-				d.Id(), err)
-		}
-
-		envErrors, err := getBeanstalkEnvironmentErrors(conn, d.Id(), t)
-		if err != nil {
-			return err
-		}
-		if envErrors != nil {
-			return envErrors
+		if err := keyvaluetags.ElasticbeanstalkUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Elastic Beanstalk Environment (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -658,7 +610,7 @@ func resourceAwsElasticBeanstalkEnvironmentRead(d *schema.ResourceData, meta int
 		return err
 	}
 
-	if err := d.Set("tags", tagsToMapBeanstalk(tags.ResourceTags)); err != nil {
+	if err := d.Set("tags", keyvaluetags.ElasticbeanstalkKeyValueTags(tags.ResourceTags).IgnoreAws().Map()); err != nil {
 		return err
 	}
 
@@ -15,12 +15,14 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // initialize sweeper
 func init() {
-	resource.AddTestSweepers("aws_beanstalk_environment", &resource.Sweeper{
-		Name: "aws_beanstalk_environment",
+	addTestSweepers("aws_elastic_beanstalk_environment", &resource.Sweeper{
+		Name: "aws_elastic_beanstalk_environment",
 		F:    testSweepBeanstalkEnvironments,
 	})
 }
@@ -44,55 +46,58 @@ func testSweepBeanstalkEnvironments(region string) error {
 		return fmt.Errorf("Error retrieving beanstalk environment: %s", err)
 	}
 
-	if len(resp.Environments) == 0 {
-		log.Print("[DEBUG] No aws beanstalk environments to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, bse := range resp.Environments {
-		log.Printf("Trying to terminate (%s) (%s)", *bse.EnvironmentName, *bse.EnvironmentId)
-
-		_, err := beanstalkconn.TerminateEnvironment(
-			&elasticbeanstalk.TerminateEnvironmentInput{
-				EnvironmentId:      bse.EnvironmentId,
-				TerminateResources: aws.Bool(true),
-			})
-
-		if err != nil {
-			elasticbeanstalkerr, ok := err.(awserr.Error)
-			if ok && (elasticbeanstalkerr.Code() == "InvalidConfiguration.NotFound" || elasticbeanstalkerr.Code() == "ValidationError") {
-				log.Printf("[DEBUG] beanstalk environment (%s) not found", *bse.EnvironmentName)
-				return nil
-			}
+		id := aws.StringValue(bse.EnvironmentId)
+		name := aws.StringValue(bse.EnvironmentName)
+
+		resources = append(resources, &sweep.Resource{
+			ID:        id,
+			Name:      name,
+			CreatedAt: aws.TimeValue(bse.DateCreated),
+			Delete: func() error {
+				_, err := beanstalkconn.TerminateEnvironment(
+					&elasticbeanstalk.TerminateEnvironmentInput{
+						EnvironmentId:      aws.String(id),
+						TerminateResources: aws.Bool(true),
+					})
+
+				if err != nil {
+					elasticbeanstalkerr, ok := err.(awserr.Error)
+					if ok && (elasticbeanstalkerr.Code() == "InvalidConfiguration.NotFound" || elasticbeanstalkerr.Code() == "ValidationError") {
+						log.Printf("[DEBUG] beanstalk environment (%s) not found", name)
+						return nil
+					}
+
+					return err
+				}
 
-			return err
-		}
+				waitForReadyTimeOut, _ := time.ParseDuration("5m")
+				pollInterval, _ := time.ParseDuration("10s")
+
+				// poll for deletion
+				t := time.Now()
+				stateConf := &resource.StateChangeConf{
+					Pending:      []string{"Terminating"},
+					Target:       []string{"Terminated"},
+					Refresh:      environmentStateRefreshFunc(beanstalkconn, id, t),
+					Timeout:      waitForReadyTimeOut,
+					Delay:        10 * time.Second,
+					PollInterval: pollInterval,
+					MinTimeout:   3 * time.Second,
+				}
 
-		waitForReadyTimeOut, _ := time.ParseDuration("5m")
-		pollInterval, _ := time.ParseDuration("10s")
-
-		// poll for deletion
-		t := time.Now()
-		stateConf := &resource.StateChangeConf{
-			Pending:      []string{"Terminating"},
-			Target:       []string{"Terminated"},
-			Refresh:      environmentStateRefreshFunc(beanstalkconn, *bse.EnvironmentId, t),
-			Timeout:      waitForReadyTimeOut,
-			Delay:        10 * time.Second,
-			PollInterval: pollInterval,
-			MinTimeout:   3 * time.Second,
-		}
+				if _, err := stateConf.WaitForState(); err != nil {
+					return fmt.Errorf("error waiting for termination: %s", err)
+				}
 
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for Elastic Beanstalk Environment (%s) to become terminated: %s",
-				*bse.EnvironmentId, err)
-		}
-		log.Printf("> Terminated (%s) (%s)", *bse.EnvironmentName, *bse.EnvironmentId)
+				return nil
+			},
+		})
 	}
 
-	return nil
+	return testSweepResources(region, "aws_elastic_beanstalk_environment", sweep.Filter{}, resources)
 }
 
 func TestAWSElasticBeanstalkEnvironment_importBasic(t *testing.T) {
@@ -742,7 +747,7 @@ func testAccCheckBeanstalkEnvTagsMatch(env *elasticbeanstalk.EnvironmentDescript
 			return err
 		}
 
-		foundTags := tagsToMapBeanstalk(tags.ResourceTags)
+		foundTags := keyvaluetags.ElasticbeanstalkKeyValueTags(tags.ResourceTags).IgnoreAws().Map()
 
 		if !reflect.DeepEqual(foundTags, expectedValue) {
 			return fmt.Errorf("Tag value: %s.  Expected %s", foundTags, expectedValue)
@@ -17,10 +17,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
+	addTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
 		Name: "aws_elasticache_cluster",
 		F:    testSweepElasticacheClusters,
 		Dependencies: []string{
@@ -36,24 +37,23 @@ func testSweepElasticacheClusters(region string) error {
 	}
 	conn := client.(*AWSClient).elasticacheconn
 
-	err = conn.DescribeCacheClustersPages(&elasticache.DescribeCacheClustersInput{}, func(page *elasticache.DescribeCacheClustersOutput, isLast bool) bool {
-		if len(page.CacheClusters) == 0 {
-			log.Print("[DEBUG] No Elasticache Replicaton Groups to sweep")
-			return false
-		}
+	var resources []*sweep.Resource
 
+	err = conn.DescribeCacheClustersPages(&elasticache.DescribeCacheClustersInput{}, func(page *elasticache.DescribeCacheClustersOutput, isLast bool) bool {
 		for _, cluster := range page.CacheClusters {
 			id := aws.StringValue(cluster.CacheClusterId)
 
-			log.Printf("[INFO] Deleting Elasticache Cluster: %s", id)
-			err := deleteElasticacheCacheCluster(conn, id)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Elasticache Cache Cluster (%s): %s", id, err)
-			}
-			err = waitForDeleteElasticacheCacheCluster(conn, id, 40*time.Minute)
-			if err != nil {
-				log.Printf("[ERROR] Failed waiting for Elasticache Cache Cluster (%s) to be deleted: %s", id, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				CreatedAt: aws.TimeValue(cluster.CacheClusterCreateTime),
+				Delete: func() error {
+					if err := deleteElasticacheCacheCluster(conn, id); err != nil {
+						log.Printf("[ERROR] Failed to delete Elasticache Cache Cluster (%s): %s", id, err)
+					}
+
+					return waitForDeleteElasticacheCacheCluster(conn, id, 40*time.Minute)
+				},
+			})
 		}
 		return !isLast
 	})
@@ -64,6 +64,11 @@ func testSweepElasticacheClusters(region string) error {
 		}
 		return fmt.Errorf("Error retrieving Elasticache Clusters: %s", err)
 	}
+
+	if err := testSweepResources(region, "aws_elasticache_cluster", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
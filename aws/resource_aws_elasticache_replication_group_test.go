@@ -14,10 +14,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
+	addTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_replication_group",
 		F:    testSweepElasticacheReplicationGroups,
 	})
@@ -30,20 +31,18 @@ func testSweepElasticacheReplicationGroups(region string) error {
 	}
 	conn := client.(*AWSClient).elasticacheconn
 
-	err = conn.DescribeReplicationGroupsPages(&elasticache.DescribeReplicationGroupsInput{}, func(page *elasticache.DescribeReplicationGroupsOutput, isLast bool) bool {
-		if len(page.ReplicationGroups) == 0 {
-			log.Print("[DEBUG] No Elasticache Replicaton Groups to sweep")
-			return false
-		}
+	var resources []*sweep.Resource
 
+	err = conn.DescribeReplicationGroupsPages(&elasticache.DescribeReplicationGroupsInput{}, func(page *elasticache.DescribeReplicationGroupsOutput, isLast bool) bool {
 		for _, replicationGroup := range page.ReplicationGroups {
 			id := aws.StringValue(replicationGroup.ReplicationGroupId)
 
-			log.Printf("[INFO] Deleting Elasticache Replication Group: %s", id)
-			err := deleteElasticacheReplicationGroup(id, conn)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Elasticache Replication Group (%s): %s", id, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID: id,
+				Delete: func() error {
+					return deleteElasticacheReplicationGroup(id, conn)
+				},
+			})
 		}
 		return !isLast
 	})
@@ -54,6 +53,11 @@ func testSweepElasticacheReplicationGroups(region string) error {
 		}
 		return fmt.Errorf("Error retrieving Elasticache Replication Groups: %s", err)
 	}
+
+	if err := testSweepResources(region, "aws_elasticache_replication_group", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
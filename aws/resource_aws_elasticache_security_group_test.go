@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_security_group", &resource.Sweeper{
+	addTestSweepers("aws_elasticache_security_group", &resource.Sweeper{
 		Name: "aws_elasticache_security_group",
 		F:    testSweepElasticacheCacheSecurityGroups,
 		Dependencies: []string{
@@ -32,12 +33,9 @@ func testSweepElasticacheCacheSecurityGroups(region string) error {
 	}
 	conn := client.(*AWSClient).elasticacheconn
 
-	err = conn.DescribeCacheSecurityGroupsPages(&elasticache.DescribeCacheSecurityGroupsInput{}, func(page *elasticache.DescribeCacheSecurityGroupsOutput, isLast bool) bool {
-		if len(page.CacheSecurityGroups) == 0 {
-			log.Print("[DEBUG] No Elasticache Cache Security Groups to sweep")
-			return false
-		}
+	var resources []*sweep.Resource
 
+	err = conn.DescribeCacheSecurityGroupsPages(&elasticache.DescribeCacheSecurityGroupsInput{}, func(page *elasticache.DescribeCacheSecurityGroupsOutput, isLast bool) bool {
 		for _, securityGroup := range page.CacheSecurityGroups {
 			name := aws.StringValue(securityGroup.CacheSecurityGroupName)
 
@@ -46,13 +44,15 @@ func testSweepElasticacheCacheSecurityGroups(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Elasticache Cache Security Group: %s", name)
-			_, err := conn.DeleteCacheSecurityGroup(&elasticache.DeleteCacheSecurityGroupInput{
-				CacheSecurityGroupName: aws.String(name),
+			resources = append(resources, &sweep.Resource{
+				ID: name,
+				Delete: func() error {
+					_, err := conn.DeleteCacheSecurityGroup(&elasticache.DeleteCacheSecurityGroupInput{
+						CacheSecurityGroupName: aws.String(name),
+					})
+					return err
+				},
 			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Elasticache Cache Security Group (%s): %s", name, err)
-			}
 		}
 		return !isLast
 	})
@@ -63,6 +63,11 @@ func testSweepElasticacheCacheSecurityGroups(region string) error {
 		}
 		return fmt.Errorf("Error retrieving Elasticache Cache Security Groups: %s", err)
 	}
+
+	if err := testSweepResources(region, "aws_elasticache_security_group", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
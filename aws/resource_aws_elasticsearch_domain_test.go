@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticsearch_domain", &resource.Sweeper{
+	addTestSweepers("aws_elasticsearch_domain", &resource.Sweeper{
 		Name: "aws_elasticsearch_domain",
 		F:    testSweepElasticSearchDomains,
 	})
@@ -36,20 +37,29 @@ func testSweepElasticSearchDomains(region string) error {
 		}
 		return fmt.Errorf("Error retrieving Elasticsearch Domains: %s", err)
 	}
-	for _, domain := range out.DomainNames {
-		log.Printf("[INFO] Deleting Elasticsearch Domain: %s", *domain.DomainName)
 
-		_, err := conn.DeleteElasticsearchDomain(&elasticsearch.DeleteElasticsearchDomainInput{
-			DomainName: domain.DomainName,
+	var resources []*sweep.Resource
+
+	for _, domain := range out.DomainNames {
+		name := aws.StringValue(domain.DomainName)
+
+		resources = append(resources, &sweep.Resource{
+			ID: name,
+			Delete: func() error {
+				_, err := conn.DeleteElasticsearchDomain(&elasticsearch.DeleteElasticsearchDomainInput{
+					DomainName: aws.String(name),
+				})
+				if err != nil {
+					return err
+				}
+
+				return resourceAwsElasticSearchDomainDeleteWaiter(name, conn)
+			},
 		})
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete Elasticsearch Domain %s: %s", *domain.DomainName, err)
-			continue
-		}
-		err = resourceAwsElasticSearchDomainDeleteWaiter(*domain.DomainName, conn)
-		if err != nil {
-			log.Printf("[ERROR] Failed to wait for deletion of Elasticsearch Domain %s: %s", *domain.DomainName, err)
-		}
+	}
+
+	if err := testSweepResources(region, "aws_elasticsearch_domain", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
 	}
 
 	return nil
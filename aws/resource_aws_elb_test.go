@@ -16,10 +16,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elb", &resource.Sweeper{
+	addTestSweepers("aws_elb", &resource.Sweeper{
 		Name: "aws_elb",
 		F:    testSweepELBs,
 	})
@@ -32,26 +33,30 @@ func testSweepELBs(region string) error {
 	}
 	conn := client.(*AWSClient).elbconn
 
-	err = conn.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{}, func(out *elb.DescribeLoadBalancersOutput, isLast bool) bool {
-		if len(out.LoadBalancerDescriptions) == 0 {
-			log.Println("[INFO] No ELBs found for sweeping")
-			return false
-		}
+	var resources []*sweep.Resource
 
+	err = conn.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{}, func(out *elb.DescribeLoadBalancersOutput, isLast bool) bool {
 		for _, lb := range out.LoadBalancerDescriptions {
-			log.Printf("[INFO] Deleting ELB: %s", *lb.LoadBalancerName)
+			name := aws.StringValue(lb.LoadBalancerName)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        name,
+				CreatedAt: aws.TimeValue(lb.CreatedTime),
+				Delete: func() error {
+					_, err := conn.DeleteLoadBalancer(&elb.DeleteLoadBalancerInput{
+						LoadBalancerName: aws.String(name),
+					})
+					if err != nil {
+						return err
+					}
+
+					if err := cleanupELBNetworkInterfaces(client.(*AWSClient).ec2conn, name); err != nil {
+						log.Printf("[WARN] Failed to cleanup ENIs for ELB %q: %s", name, err)
+					}
 
-			_, err := conn.DeleteLoadBalancer(&elb.DeleteLoadBalancerInput{
-				LoadBalancerName: lb.LoadBalancerName,
+					return nil
+				},
 			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ELB %s: %s", *lb.LoadBalancerName, err)
-				continue
-			}
-			err = cleanupELBNetworkInterfaces(client.(*AWSClient).ec2conn, *lb.LoadBalancerName)
-			if err != nil {
-				log.Printf("[WARN] Failed to cleanup ENIs for ELB %q: %s", *lb.LoadBalancerName, err)
-			}
 		}
 		return !isLast
 	})
@@ -62,6 +67,11 @@ func testSweepELBs(region string) error {
 		}
 		return fmt.Errorf("Error retrieving ELBs: %s", err)
 	}
+
+	if err := testSweepResources(region, "aws_elb", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
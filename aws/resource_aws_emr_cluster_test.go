@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	addTestSweepers("aws_emr_cluster", &resource.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    testSweepEmrClusters,
 	})
@@ -37,6 +38,8 @@ func testSweepEmrClusters(region string) error {
 			aws.String(emr.ClusterStateWaiting),
 		},
 	}
+	var resources []*sweep.Resource
+
 	err = conn.ListClustersPages(input, func(page *emr.ListClustersOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -49,18 +52,24 @@ func testSweepEmrClusters(region string) error {
 			terminateJobFlowsInput := &emr.TerminateJobFlowsInput{
 				JobFlowIds: []*string{cluster.Id},
 			}
-			id := aws.StringValue(cluster.Id)
 
-			log.Printf("[INFO] Deleting EMR Cluster: %s", id)
-			_, err = conn.TerminateJobFlows(terminateJobFlowsInput)
+			r := &sweep.Resource{
+				ID:   aws.StringValue(cluster.Id),
+				Name: aws.StringValue(cluster.Name),
+				Delete: func() error {
+					if _, err := conn.TerminateJobFlows(terminateJobFlowsInput); err != nil {
+						return err
+					}
 
-			if err != nil {
-				log.Printf("[ERROR] Error terminating EMR Cluster (%s): %s", id, err)
+					return conn.WaitUntilClusterTerminated(describeClusterInput)
+				},
 			}
 
-			if err := conn.WaitUntilClusterTerminated(describeClusterInput); err != nil {
-				log.Printf("[ERROR] Error waiting for EMR Cluster (%s) termination: %s", id, err)
+			if cluster.Status != nil && cluster.Status.Timeline != nil {
+				r.CreatedAt = aws.TimeValue(cluster.Status.Timeline.CreationDateTime)
 			}
+
+			resources = append(resources, r)
 		}
 
 		return !isLast
@@ -73,6 +82,10 @@ func testSweepEmrClusters(region string) error {
 		return fmt.Errorf("error retrieving EMR Clusters: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_emr_cluster", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,263 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+)
+
+func init() {
+	addTestSweepers("aws_fsx_backup", &resource.Sweeper{
+		Name: "aws_fsx_backup",
+		F:    testSweepFSXBackups,
+		Dependencies: []string{
+			"aws_fsx_windows_file_system",
+		},
+	})
+}
+
+func testSweepFSXBackups(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).fsxconn
+
+	var resources []*sweep.Resource
+
+	err = conn.DescribeBackupsPages(&fsx.DescribeBackupsInput{}, func(page *fsx.DescribeBackupsOutput, lastPage bool) bool {
+		for _, backup := range page.Backups {
+			// Automatic backups are deleted along with their file system.
+			if aws.StringValue(backup.Type) != fsx.BackupTypeUserInitiated {
+				continue
+			}
+
+			id := aws.StringValue(backup.BackupId)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				Tags:      keyvaluetags.FsxKeyValueTags(backup.Tags),
+				CreatedAt: aws.TimeValue(backup.CreationTime),
+				Delete: func() error {
+					_, err := conn.DeleteBackup(&fsx.DeleteBackupInput{
+						BackupId: aws.String(id),
+					})
+					if isAWSErr(err, fsx.ErrCodeBackupNotFound, "") {
+						return nil
+					}
+					if err != nil {
+						return err
+					}
+					return waitForFsxBackupDeletion(conn, id, 10*time.Minute)
+				},
+			})
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping FSx Backup sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing FSx Backups: %s", err)
+	}
+
+	if err := testSweepResources(region, "aws_fsx_backup", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSFsxBackup_basic(t *testing.T) {
+	var backup fsx.Backup
+	resourceName := "aws_fsx_backup.test"
+	fileSystemResourceName := "aws_fsx_windows_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSFsx(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxBackupConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxBackupExists(resourceName, &backup),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "fsx", regexp.MustCompile(`backup/backup-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "file_system_id", fileSystemResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", fileSystemResourceName, "kms_key_id"),
+					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", fsx.BackupTypeUserInitiated),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxBackup_Tags(t *testing.T) {
+	var backup1, backup2, backup3 fsx.Backup
+	resourceName := "aws_fsx_backup.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSFsx(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxBackupConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxBackupExists(resourceName, &backup1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsFsxBackupConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxBackupExists(resourceName, &backup2),
+					testAccCheckFsxBackupNotRecreated(&backup1, &backup2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsFsxBackupConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxBackupExists(resourceName, &backup3),
+					testAccCheckFsxBackupNotRecreated(&backup2, &backup3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFsxBackupExists(resourceName string, b *fsx.Backup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fsxconn
+
+		backup, err := describeFsxBackup(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if backup == nil {
+			return fmt.Errorf("FSx Backup (%s) not found", rs.Primary.ID)
+		}
+
+		*b = *backup
+
+		return nil
+	}
+}
+
+func testAccCheckFsxBackupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fsxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fsx_backup" {
+			continue
+		}
+
+		backup, err := describeFsxBackup(conn, rs.Primary.ID)
+
+		if isAWSErr(err, fsx.ErrCodeBackupNotFound, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if backup != nil && aws.StringValue(backup.Lifecycle) != fsx.BackupLifecycleDeleted {
+			return fmt.Errorf("FSx Backup (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckFsxBackupNotRecreated(i, j *fsx.Backup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.BackupId) != aws.StringValue(j.BackupId) {
+			return fmt.Errorf("FSx Backup (%s) recreated", aws.StringValue(i.BackupId))
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsFsxBackupConfigBase() string {
+	return testAccAwsFsxWindowsFileSystemConfigBase() + `
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id = "${aws_directory_service_directory.test.id}"
+  skip_final_backup   = true
+  storage_capacity    = 32
+  subnet_ids          = ["${aws_subnet.test1.id}"]
+  throughput_capacity = 8
+}
+`
+}
+
+func testAccAwsFsxBackupConfigBasic() string {
+	return testAccAwsFsxBackupConfigBase() + `
+resource "aws_fsx_backup" "test" {
+  file_system_id = "${aws_fsx_windows_file_system.test.id}"
+}
+`
+}
+
+func testAccAwsFsxBackupConfigTags1(tagKey1, tagValue1 string) string {
+	return testAccAwsFsxBackupConfigBase() + fmt.Sprintf(`
+resource "aws_fsx_backup" "test" {
+  file_system_id = "${aws_fsx_windows_file_system.test.id}"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAwsFsxBackupConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAwsFsxBackupConfigBase() + fmt.Sprintf(`
+resource "aws_fsx_backup" "test" {
+  file_system_id = "${aws_fsx_windows_file_system.test.id}"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -10,10 +10,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
+	addTestSweepers("aws_gamelift_alias", &resource.Sweeper{
 		Name: "aws_gamelift_alias",
 		Dependencies: []string{
 			"aws_gamelift_fleet",
@@ -29,23 +30,23 @@ func testSweepGameliftAliases(region string) error {
 	}
 	conn := client.(*AWSClient).gameliftconn
 
-	err = listGameliftAliases(&gamelift.ListAliasesInput{}, conn, func(resp *gamelift.ListAliasesOutput) error {
-		if len(resp.Aliases) == 0 {
-			log.Print("[DEBUG] No Gamelift Aliases to sweep")
-			return nil
-		}
-
-		log.Printf("[INFO] Found %d Gamelift Aliases", len(resp.Aliases))
+	var resources []*sweep.Resource
 
+	err = listGameliftAliases(&gamelift.ListAliasesInput{}, conn, func(resp *gamelift.ListAliasesOutput) error {
 		for _, alias := range resp.Aliases {
-			log.Printf("[INFO] Deleting Gamelift Alias %q", *alias.AliasId)
-			_, err := conn.DeleteAlias(&gamelift.DeleteAliasInput{
-				AliasId: alias.AliasId,
+			id := aws.StringValue(alias.AliasId)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				Name:      aws.StringValue(alias.Name),
+				CreatedAt: aws.TimeValue(alias.CreationTime),
+				Delete: func() error {
+					_, err := conn.DeleteAlias(&gamelift.DeleteAliasInput{
+						AliasId: aws.String(id),
+					})
+					return err
+				},
 			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Gamelift Alias (%s): %s",
-					*alias.AliasId, err)
-			}
 		}
 		return nil
 	})
@@ -57,7 +58,7 @@ func testSweepGameliftAliases(region string) error {
 		return fmt.Errorf("Error listing Gamelift Aliases: %s", err)
 	}
 
-	return nil
+	return testSweepResources(region, "aws_gamelift_alias", sweep.Filter{}, resources)
 }
 
 func listGameliftAliases(input *gamelift.ListAliasesInput, conn *gamelift.GameLift, f func(*gamelift.ListAliasesOutput) error) error {
@@ -10,12 +10,13 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 const testAccGameliftBuildPrefix = "tf_acc_build_"
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
+	addTestSweepers("aws_gamelift_build", &resource.Sweeper{
 		Name: "aws_gamelift_build",
 		F:    testSweepGameliftBuilds,
 	})
@@ -37,25 +38,25 @@ func testSweepGameliftBuilds(region string) error {
 		return fmt.Errorf("Error listing Gamelift Builds: %s", err)
 	}
 
-	if len(resp.Builds) == 0 {
-		log.Print("[DEBUG] No Gamelift Builds to sweep")
-		return nil
-	}
-
-	log.Printf("[INFO] Found %d Gamelift Builds", len(resp.Builds))
+	var resources []*sweep.Resource
 
 	for _, build := range resp.Builds {
-		log.Printf("[INFO] Deleting Gamelift Build %q", *build.BuildId)
-		_, err := conn.DeleteBuild(&gamelift.DeleteBuildInput{
-			BuildId: build.BuildId,
+		id := aws.StringValue(build.BuildId)
+
+		resources = append(resources, &sweep.Resource{
+			ID:        id,
+			Name:      aws.StringValue(build.Name),
+			CreatedAt: aws.TimeValue(build.CreationTime),
+			Delete: func() error {
+				_, err := conn.DeleteBuild(&gamelift.DeleteBuildInput{
+					BuildId: aws.String(id),
+				})
+				return err
+			},
 		})
-		if err != nil {
-			return fmt.Errorf("Error deleting Gamelift Build (%s): %s",
-				*build.BuildId, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_gamelift_build", sweep.Filter{}, resources)
 }
 
 func TestAccAWSGameliftBuild_basic(t *testing.T) {
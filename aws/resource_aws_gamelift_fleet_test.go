@@ -12,12 +12,13 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 const testAccGameliftFleetPrefix = "tf_acc_fleet_"
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
+	addTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
 			"aws_gamelift_build",
@@ -33,9 +34,10 @@ func testSweepGameliftFleets(region string) error {
 	}
 	conn := client.(*AWSClient).gameliftconn
 
-	return testAccGameliftListFleets(conn, nil, region, func(fleetIds []*string) error {
+	var resources []*sweep.Resource
+
+	err = testAccGameliftListFleets(conn, nil, region, func(fleetIds []*string) error {
 		if len(fleetIds) == 0 {
-			log.Print("[DEBUG] No Gamelift Fleets to sweep")
 			return nil
 		}
 
@@ -46,36 +48,42 @@ func testSweepGameliftFleets(region string) error {
 			return fmt.Errorf("Error describing Gamelift Fleet attributes: %s", err)
 		}
 
-		log.Printf("[INFO] Found %d Gamelift Fleets", len(out.FleetAttributes))
-
 		for _, attr := range out.FleetAttributes {
-			log.Printf("[INFO] Deleting Gamelift Fleet %q", *attr.FleetId)
-			err := resource.Retry(60*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteFleet(&gamelift.DeleteFleetInput{
-					FleetId: attr.FleetId,
-				})
-				if err != nil {
-					msg := fmt.Sprintf("Cannot delete fleet %s that is in status of ", *attr.FleetId)
-					if isAWSErr(err, gamelift.ErrCodeInvalidRequestException, msg) {
-						return resource.RetryableError(err)
+			id := aws.StringValue(attr.FleetId)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				Name:      aws.StringValue(attr.Name),
+				CreatedAt: aws.TimeValue(attr.CreationTime),
+				Delete: func() error {
+					err := resource.Retry(60*time.Minute, func() *resource.RetryError {
+						_, err := conn.DeleteFleet(&gamelift.DeleteFleetInput{
+							FleetId: aws.String(id),
+						})
+						if err != nil {
+							msg := fmt.Sprintf("Cannot delete fleet %s that is in status of ", id)
+							if isAWSErr(err, gamelift.ErrCodeInvalidRequestException, msg) {
+								return resource.RetryableError(err)
+							}
+							return resource.NonRetryableError(err)
+						}
+						return nil
+					})
+					if err != nil {
+						return err
 					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
+
+					return waitForGameliftFleetToBeDeleted(conn, id, 5*time.Minute)
+				},
 			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Gamelift Fleet (%s): %s",
-					*attr.FleetId, err)
-			}
-
-			err = waitForGameliftFleetToBeDeleted(conn, *attr.FleetId, 5*time.Minute)
-			if err != nil {
-				return fmt.Errorf("Error waiting for Gamelift Fleet (%s) to be deleted: %s",
-					*attr.FleetId, err)
-			}
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	return testSweepResources(region, "aws_gamelift_fleet", sweep.Filter{}, resources)
 }
 
 func testAccGameliftListFleets(conn *gamelift.GameLift, nextToken *string, region string, f func([]*string) error) error {
@@ -11,12 +11,13 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 const testAccGameliftGameSessionQueuePrefix = "tfAccQueue-"
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
+	addTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
 		Name: "aws_gamelift_game_session_queue",
 		F:    testSweepGameliftGameSessionQueue,
 	})
@@ -40,25 +41,23 @@ func testSweepGameliftGameSessionQueue(region string) error {
 		return fmt.Errorf("error listing Gamelift Session Queue: %s", err)
 	}
 
-	if len(out.GameSessionQueues) == 0 {
-		log.Print("[DEBUG] No Gamelift Session Queue to sweep")
-		return nil
-	}
-
-	log.Printf("[INFO] Found %d Gamelift Session Queue", len(out.GameSessionQueues))
+	var resources []*sweep.Resource
 
 	for _, queue := range out.GameSessionQueues {
-		log.Printf("[INFO] Deleting Gamelift Session Queue %q", *queue.Name)
-		_, err := conn.DeleteGameSessionQueue(&gamelift.DeleteGameSessionQueueInput{
-			Name: aws.String(*queue.Name),
+		name := aws.StringValue(queue.Name)
+
+		resources = append(resources, &sweep.Resource{
+			ID: name,
+			Delete: func() error {
+				_, err := conn.DeleteGameSessionQueue(&gamelift.DeleteGameSessionQueueInput{
+					Name: aws.String(name),
+				})
+				return err
+			},
 		})
-		if err != nil {
-			return fmt.Errorf("error deleting Gamelift Session Queue (%s): %s",
-				*queue.Name, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_gamelift_game_session_queue", sweep.Filter{}, resources)
 }
 
 func TestAccAWSGameliftGameSessionQueue_basic(t *testing.T) {
@@ -39,7 +39,7 @@ func resourceAwsGlacierVaultLock() *schema.Resource {
 				Required:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
-				ValidateFunc:     validateIAMPolicyJson,
+				ValidateFunc:     validateIAMResourcePolicy(0),
 			},
 			"vault_name": {
 				Type:         schema.TypeString,
@@ -4,16 +4,18 @@ import (
 	"fmt"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_classifier", &resource.Sweeper{
+	addTestSweepers("aws_glue_classifier", &resource.Sweeper{
 		Name: "aws_glue_classifier",
 		F:    testSweepGlueClassifiers,
 	})
@@ -26,31 +28,35 @@ func testSweepGlueClassifiers(region string) error {
 	}
 	conn := client.(*AWSClient).glueconn
 
+	var resources []*sweep.Resource
+
 	input := &glue.GetClassifiersInput{}
 	err = conn.GetClassifiersPages(input, func(page *glue.GetClassifiersOutput, lastPage bool) bool {
-		if len(page.Classifiers) == 0 {
-			log.Printf("[INFO] No Glue Classifiers to sweep")
-			return false
-		}
 		for _, classifier := range page.Classifiers {
 			var name string
+			var createdAt time.Time
 			if classifier.GrokClassifier != nil {
 				name = aws.StringValue(classifier.GrokClassifier.Name)
+				createdAt = aws.TimeValue(classifier.GrokClassifier.CreationTime)
 			} else if classifier.JsonClassifier != nil {
 				name = aws.StringValue(classifier.JsonClassifier.Name)
+				createdAt = aws.TimeValue(classifier.JsonClassifier.CreationTime)
 			} else if classifier.XMLClassifier != nil {
 				name = aws.StringValue(classifier.XMLClassifier.Name)
+				createdAt = aws.TimeValue(classifier.XMLClassifier.CreationTime)
 			}
 			if name == "" {
 				log.Printf("[WARN] Unable to determine Glue Classifier name: %#v", classifier)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Glue Classifier: %s", name)
-			err := deleteGlueClassifier(conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Classifier %s: %s", name, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        name,
+				CreatedAt: createdAt,
+				Delete: func() error {
+					return deleteGlueClassifier(conn, name)
+				},
+			})
 		}
 		return !lastPage
 	})
@@ -62,6 +68,10 @@ func testSweepGlueClassifiers(region string) error {
 		return fmt.Errorf("Error retrieving Glue Classifiers: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_glue_classifier", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
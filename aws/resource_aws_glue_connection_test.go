@@ -10,10 +10,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_connection", &resource.Sweeper{
+	addTestSweepers("aws_glue_connection", &resource.Sweeper{
 		Name: "aws_glue_connection",
 		F:    testSweepGlueConnections,
 	})
@@ -27,22 +28,22 @@ func testSweepGlueConnections(region string) error {
 	conn := client.(*AWSClient).glueconn
 	catalogID := client.(*AWSClient).accountid
 
+	var resources []*sweep.Resource
+
 	input := &glue.GetConnectionsInput{
 		CatalogId: aws.String(catalogID),
 	}
 	err = conn.GetConnectionsPages(input, func(page *glue.GetConnectionsOutput, lastPage bool) bool {
-		if len(page.ConnectionList) == 0 {
-			log.Printf("[INFO] No Glue Connections to sweep")
-			return false
-		}
 		for _, connection := range page.ConnectionList {
 			name := aws.StringValue(connection.Name)
 
-			log.Printf("[INFO] Deleting Glue Connection: %s", name)
-			err := deleteGlueConnection(conn, catalogID, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Connection %s: %s", name, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        name,
+				CreatedAt: aws.TimeValue(connection.CreationTime),
+				Delete: func() error {
+					return deleteGlueConnection(conn, catalogID, name)
+				},
+			})
 		}
 		return !lastPage
 	})
@@ -54,6 +55,10 @@ func testSweepGlueConnections(region string) error {
 		return fmt.Errorf("Error retrieving Glue Connections: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_glue_connection", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
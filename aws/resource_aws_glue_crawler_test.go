@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_crawler", &resource.Sweeper{
+	addTestSweepers("aws_glue_crawler", &resource.Sweeper{
 		Name: "aws_glue_crawler",
 		F:    testSweepGlueCrawlers,
 	})
@@ -29,22 +30,23 @@ func testSweepGlueCrawlers(region string) error {
 	}
 	conn := client.(*AWSClient).glueconn
 
+	var resources []*sweep.Resource
+
 	input := &glue.GetCrawlersInput{}
 	err = conn.GetCrawlersPages(input, func(page *glue.GetCrawlersOutput, lastPage bool) bool {
-		if len(page.Crawlers) == 0 {
-			log.Printf("[INFO] No Glue Crawlers to sweep")
-			return false
-		}
 		for _, crawler := range page.Crawlers {
 			name := aws.StringValue(crawler.Name)
 
-			log.Printf("[INFO] Deleting Glue Crawler: %s", name)
-			_, err := conn.DeleteCrawler(&glue.DeleteCrawlerInput{
-				Name: aws.String(name),
+			resources = append(resources, &sweep.Resource{
+				ID:        name,
+				CreatedAt: aws.TimeValue(crawler.CreationTime),
+				Delete: func() error {
+					_, err := conn.DeleteCrawler(&glue.DeleteCrawlerInput{
+						Name: aws.String(name),
+					})
+					return err
+				},
 			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Crawler %s: %s", name, err)
-			}
 		}
 		return !lastPage
 	})
@@ -56,6 +58,10 @@ func testSweepGlueCrawlers(region string) error {
 		return fmt.Errorf("Error retrieving Glue Crawlers: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_glue_crawler", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
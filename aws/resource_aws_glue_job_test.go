@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_job", &resource.Sweeper{
+	addTestSweepers("aws_glue_job", &resource.Sweeper{
 		Name: "aws_glue_job",
 		F:    testSweepGlueJobs,
 	})
@@ -27,20 +28,20 @@ func testSweepGlueJobs(region string) error {
 	}
 	conn := client.(*AWSClient).glueconn
 
+	var resources []*sweep.Resource
+
 	input := &glue.GetJobsInput{}
 	err = conn.GetJobsPages(input, func(page *glue.GetJobsOutput, lastPage bool) bool {
-		if len(page.Jobs) == 0 {
-			log.Printf("[INFO] No Glue Jobs to sweep")
-			return false
-		}
 		for _, job := range page.Jobs {
 			name := aws.StringValue(job.Name)
 
-			log.Printf("[INFO] Deleting Glue Job: %s", name)
-			err := deleteGlueJob(conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Job %s: %s", name, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        name,
+				CreatedAt: aws.TimeValue(job.CreatedOn),
+				Delete: func() error {
+					return deleteGlueJob(conn, name)
+				},
+			})
 		}
 		return !lastPage
 	})
@@ -52,6 +53,10 @@ func testSweepGlueJobs(region string) error {
 		return fmt.Errorf("Error retrieving Glue Jobs: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_glue_job", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -10,10 +10,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
+	addTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
 		Name: "aws_glue_security_configuration",
 		F:    testSweepGlueSecurityConfigurations,
 	})
@@ -26,6 +27,8 @@ func testSweepGlueSecurityConfigurations(region string) error {
 	}
 	conn := client.(*AWSClient).glueconn
 
+	var resources []*sweep.Resource
+
 	input := &glue.GetSecurityConfigurationsInput{}
 
 	for {
@@ -43,11 +46,13 @@ func testSweepGlueSecurityConfigurations(region string) error {
 		for _, securityConfiguration := range output.SecurityConfigurations {
 			name := aws.StringValue(securityConfiguration.Name)
 
-			log.Printf("[INFO] Deleting Glue Security Configuration: %s", name)
-			err := deleteGlueSecurityConfiguration(conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Security Configuration %s: %s", name, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        name,
+				CreatedAt: aws.TimeValue(securityConfiguration.CreatedTimeStamp),
+				Delete: func() error {
+					return deleteGlueSecurityConfiguration(conn, name)
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -57,6 +62,10 @@ func testSweepGlueSecurityConfigurations(region string) error {
 		input.NextToken = output.NextToken
 	}
 
+	if err := testSweepResources(region, "aws_glue_security_configuration", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -10,10 +10,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_trigger", &resource.Sweeper{
+	addTestSweepers("aws_glue_trigger", &resource.Sweeper{
 		Name: "aws_glue_trigger",
 		F:    testSweepGlueTriggers,
 	})
@@ -26,20 +27,22 @@ func testSweepGlueTriggers(region string) error {
 	}
 	conn := client.(*AWSClient).glueconn
 
+	var resources []*sweep.Resource
+
 	input := &glue.GetTriggersInput{}
 	err = conn.GetTriggersPages(input, func(page *glue.GetTriggersOutput, lastPage bool) bool {
-		if page == nil || len(page.Triggers) == 0 {
-			log.Printf("[INFO] No Glue Triggers to sweep")
+		if page == nil {
 			return false
 		}
 		for _, trigger := range page.Triggers {
 			name := aws.StringValue(trigger.Name)
 
-			log.Printf("[INFO] Deleting Glue Trigger: %s", name)
-			err := deleteGlueJob(conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Trigger %s: %s", name, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID: name,
+				Delete: func() error {
+					return deleteGlueTrigger(conn, name)
+				},
+			})
 		}
 		return !lastPage
 	})
@@ -51,6 +54,10 @@ func testSweepGlueTriggers(region string) error {
 		return fmt.Errorf("Error retrieving Glue Triggers: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_glue_trigger", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
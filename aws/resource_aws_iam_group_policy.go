@@ -24,8 +24,9 @@ func resourceAwsIamGroupPolicy() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"policy": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIAMIdentityPolicy(5120),
 			},
 			"name": {
 				Type:          schema.TypeString,
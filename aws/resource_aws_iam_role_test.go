@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	addTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -49,25 +50,51 @@ func testSweepIamRoles(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*AWSClient).iamconn
-	prefixes := []string{
-		"ecs_instance_role",
-		"ecs_tf",
-		"EMR_AutoScaling_DefaultRole",
-		"iam_emr",
-		"terraform-",
-		"test_role",
-		"tf",
+	filter := sweep.Filter{
+		NamePrefixes: []string{
+			"ecs_instance_role",
+			"ecs_tf",
+			"EMR_AutoScaling_DefaultRole",
+			"iam_emr",
+			"terraform-",
+			"test_role",
+			"tf",
+		},
 	}
-	roles := make([]*iam.Role, 0)
+
+	var resources []*sweep.Resource
 
 	err = conn.ListRolesPages(&iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
 		for _, role := range page.Roles {
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(aws.StringValue(role.RoleName), prefix) {
-					roles = append(roles, role)
-					break
-				}
-			}
+			rolename := aws.StringValue(role.RoleName)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        rolename,
+				CreatedAt: aws.TimeValue(role.CreateDate),
+				Delete: func() error {
+					if err := deleteAwsIamRoleInstanceProfiles(conn, rolename); err != nil {
+						return fmt.Errorf("error deleting instance profiles: %s", err)
+					}
+
+					if err := deleteAwsIamRolePolicyAttachments(conn, rolename); err != nil {
+						return fmt.Errorf("error deleting policy attachments: %s", err)
+					}
+
+					if err := deleteAwsIamRolePolicies(conn, rolename); err != nil {
+						return fmt.Errorf("error deleting policies: %s", err)
+					}
+
+					_, err := conn.DeleteRole(&iam.DeleteRoleInput{
+						RoleName: aws.String(rolename),
+					})
+
+					if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+						return nil
+					}
+
+					return err
+				},
+			})
 		}
 
 		return !lastPage
@@ -82,43 +109,7 @@ func testSweepIamRoles(region string) error {
 		return fmt.Errorf("Error retrieving IAM Roles: %s", err)
 	}
 
-	if len(roles) == 0 {
-		log.Print("[DEBUG] No IAM Roles to sweep")
-		return nil
-	}
-
-	for _, role := range roles {
-		rolename := aws.StringValue(role.RoleName)
-		log.Printf("[DEBUG] Deleting IAM Role: %s", rolename)
-
-		if err := deleteAwsIamRoleInstanceProfiles(conn, rolename); err != nil {
-			return fmt.Errorf("error deleting IAM Role (%s) instance profiles: %s", rolename, err)
-		}
-
-		if err := deleteAwsIamRolePolicyAttachments(conn, rolename); err != nil {
-			return fmt.Errorf("error deleting IAM Role (%s) policy attachments: %s", rolename, err)
-		}
-
-		if err := deleteAwsIamRolePolicies(conn, rolename); err != nil {
-			return fmt.Errorf("error deleting IAM Role (%s) policies: %s", rolename, err)
-		}
-
-		input := &iam.DeleteRoleInput{
-			RoleName: aws.String(rolename),
-		}
-
-		_, err := conn.DeleteRole(input)
-
-		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
-			continue
-		}
-
-		if err != nil {
-			return fmt.Errorf("Error deleting IAM Role (%s): %s", rolename, err)
-		}
-	}
-
-	return nil
+	return testSweepResources(region, "aws_iam_role", filter, resources)
 }
 
 func TestAccAWSIAMRole_importBasic(t *testing.T) {
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	addTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    testSweepIamServerCertificates,
 	})
@@ -27,18 +28,22 @@ func testSweepIamServerCertificates(region string) error {
 	}
 	conn := client.(*AWSClient).iamconn
 
+	var resources []*sweep.Resource
+
 	err = conn.ListServerCertificatesPages(&iam.ListServerCertificatesInput{}, func(out *iam.ListServerCertificatesOutput, lastPage bool) bool {
 		for _, sc := range out.ServerCertificateMetadataList {
-			log.Printf("[INFO] Deleting IAM Server Certificate: %s", *sc.ServerCertificateName)
-
-			_, err := conn.DeleteServerCertificate(&iam.DeleteServerCertificateInput{
-				ServerCertificateName: sc.ServerCertificateName,
+			name := aws.StringValue(sc.ServerCertificateName)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        name,
+				CreatedAt: aws.TimeValue(sc.UploadDate),
+				Delete: func() error {
+					_, err := conn.DeleteServerCertificate(&iam.DeleteServerCertificateInput{
+						ServerCertificateName: aws.String(name),
+					})
+					return err
+				},
 			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete IAM Server Certificate %s: %s",
-					*sc.ServerCertificateName, err)
-				continue
-			}
 		}
 		return !lastPage
 	})
@@ -50,6 +55,10 @@ func testSweepIamServerCertificates(region string) error {
 		return fmt.Errorf("Error retrieving IAM Server Certificates: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_iam_server_certificate", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
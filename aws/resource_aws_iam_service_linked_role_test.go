@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
+	addTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
 		Name: "aws_iam_service_linked_role",
 		F:    testSweepIamServiceLinkedRoles,
 	})
@@ -27,38 +28,35 @@ func testSweepIamServiceLinkedRoles(region string) error {
 	}
 	conn := client.(*AWSClient).iamconn
 
+	filter := sweep.Filter{
+		NamePatterns: []*regexp.Regexp{regexp.MustCompile(`_tf-acc-test-\d+$`)},
+	}
+
+	var resources []*sweep.Resource
+
 	input := &iam.ListRolesInput{
 		PathPrefix: aws.String("/aws-service-role/"),
 	}
-	customSuffixRegex := regexp.MustCompile(`_tf-acc-test-\d+$`)
 	err = conn.ListRolesPages(input, func(page *iam.ListRolesOutput, lastPage bool) bool {
-		if len(page.Roles) == 0 {
-			log.Printf("[INFO] No IAM Service Roles to sweep")
-			return true
-		}
 		for _, role := range page.Roles {
 			roleName := aws.StringValue(role.RoleName)
 
-			if !customSuffixRegex.MatchString(roleName) {
-				log.Printf("[INFO] Skipping IAM Service Role: %s", roleName)
-				continue
-			}
-
-			log.Printf("[INFO] Deleting IAM Service Role: %s", roleName)
-			deletionTaskID, err := deleteIamServiceLinkedRole(conn, roleName)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete IAM Service Role %s: %s", roleName, err)
-				continue
-			}
-			if deletionTaskID == "" {
-				continue
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        roleName,
+				CreatedAt: aws.TimeValue(role.CreateDate),
+				Delete: func() error {
+					deletionTaskID, err := deleteIamServiceLinkedRole(conn, roleName)
+					if err != nil {
+						return err
+					}
+					if deletionTaskID == "" {
+						return nil
+					}
 
-			log.Printf("[INFO] Waiting for deletion of IAM Service Role: %s", roleName)
-			err = deleteIamServiceLinkedRoleWaiter(conn, deletionTaskID)
-			if err != nil {
-				log.Printf("[ERROR] Failed to wait for deletion of IAM Service Role %s: %s", roleName, err)
-			}
+					log.Printf("[INFO] Waiting for deletion of IAM Service Role: %s", roleName)
+					return deleteIamServiceLinkedRoleWaiter(conn, deletionTaskID)
+				},
+			})
 		}
 		return !lastPage
 	})
@@ -70,6 +68,10 @@ func testSweepIamServiceLinkedRoles(region string) error {
 		return fmt.Errorf("Error retrieving IAM Service Roles: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_iam_service_linked_role", filter, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
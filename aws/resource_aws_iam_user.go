@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsIamUser() *schema.Resource {
@@ -82,7 +83,7 @@ func resourceAwsIamUserCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("tags"); ok {
-		tags := tagsFromMapIAM(v.(map[string]interface{}))
+		tags := keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().IamTags()
 		request.Tags = tags
 	}
 
@@ -127,7 +128,7 @@ func resourceAwsIamUserRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("permissions_boundary", output.User.PermissionsBoundary.PermissionsBoundaryArn)
 	}
 	d.Set("unique_id", output.User.UserId)
-	if err := d.Set("tags", tagsToMapIAM(output.User.Tags)); err != nil {
+	if err := d.Set("tags", keyvaluetags.IamKeyValueTags(output.User.Tags).IgnoreAws().Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -183,32 +184,11 @@ func resourceAwsIamUserUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("tags") {
-		// Reset all tags to empty set
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		c, r := diffTagsIAM(tagsFromMapIAM(o), tagsFromMapIAM(n))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		if len(r) > 0 {
-			_, err := iamconn.UntagUser(&iam.UntagUserInput{
-				UserName: aws.String(d.Id()),
-				TagKeys:  tagKeysIam(r),
-			})
-			if err != nil {
-				return fmt.Errorf("error deleting IAM user tags: %s", err)
-			}
-		}
-
-		if len(c) > 0 {
-			input := &iam.TagUserInput{
-				UserName: aws.String(d.Id()),
-				Tags:     c,
-			}
-			_, err := iamconn.TagUser(input)
-			if err != nil {
-				return fmt.Errorf("error update IAM user tags: %s", err)
-			}
+		if err := keyvaluetags.IamUserUpdateTags(iamconn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating IAM User (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsInstance() *schema.Resource {
@@ -552,7 +553,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	tagsSpec := make([]*ec2.TagSpecification, 0)
 
 	if v, ok := d.GetOk("tags"); ok {
-		tags := tagsFromMap(v.(map[string]interface{}))
+		tags := keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().Ec2Tags()
 
 		spec := &ec2.TagSpecification{
 			ResourceType: aws.String("instance"),
@@ -563,7 +564,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("volume_tags"); ok {
-		tags := tagsFromMap(v.(map[string]interface{}))
+		tags := keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().Ec2Tags()
 
 		spec := &ec2.TagSpecification{
 			ResourceType: aws.String("volume"),
@@ -802,7 +803,7 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("monitoring", monitoringState == "enabled" || monitoringState == "pending")
 	}
 
-	d.Set("tags", tagsToMap(instance.Tags))
+	d.Set("tags", keyvaluetags.Ec2KeyValueTags(instance.Tags).IgnoreAws().Map())
 
 	if err := readVolumeTags(conn, d); err != nil {
 		return err
@@ -898,7 +899,7 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	d.Partial(true)
 
-	if d.HasChange("tags") && !d.IsNewResource() {
+	if d.HasChange("tags_all") && !d.IsNewResource() {
 		if err := setTags(conn, d); err != nil {
 			return err
 		}
@@ -1623,7 +1624,7 @@ func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 		tags = append(tags, tag)
 	}
 
-	d.Set("volume_tags", tagsToMap(tags))
+	d.Set("volume_tags", keyvaluetags.Ec2KeyValueTags(tags).IgnoreAws().Map())
 
 	return nil
 }
@@ -18,10 +18,12 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	addTestSweepers("aws_instance", &resource.Sweeper{
 		Name: "aws_instance",
 		F:    testSweepInstances,
 	})
@@ -34,12 +36,9 @@ func testSweepInstances(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 
-	err = conn.DescribeInstancesPages(&ec2.DescribeInstancesInput{}, func(page *ec2.DescribeInstancesOutput, isLast bool) bool {
-		if len(page.Reservations) == 0 {
-			log.Print("[DEBUG] No EC2 Instances to sweep")
-			return false
-		}
+	var resources []*sweep.Resource
 
+	err = conn.DescribeInstancesPages(&ec2.DescribeInstancesInput{}, func(page *ec2.DescribeInstancesOutput, isLast bool) bool {
 		for _, reservation := range page.Reservations {
 			for _, instance := range reservation.Instances {
 				id := aws.StringValue(instance.InstanceId)
@@ -49,11 +48,14 @@ func testSweepInstances(region string) error {
 					continue
 				}
 
-				log.Printf("[INFO] Terminating EC2 Instance: %s", id)
-				err := awsTerminateInstance(conn, id, 5*time.Minute)
-				if err != nil {
-					log.Printf("[ERROR] Error terminating EC2 Instance (%s): %s", id, err)
-				}
+				resources = append(resources, &sweep.Resource{
+					ID:        id,
+					Tags:      keyvaluetags.Ec2KeyValueTags(instance.Tags),
+					CreatedAt: aws.TimeValue(instance.LaunchTime),
+					Delete: func() error {
+						return awsTerminateInstance(conn, id, 5*time.Minute)
+					},
+				})
 			}
 		}
 		return !isLast
@@ -66,6 +68,10 @@ func testSweepInstances(region string) error {
 		return fmt.Errorf("Error retrieving EC2 Instances: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_instance", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
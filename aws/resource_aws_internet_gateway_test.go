@@ -11,10 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	addTestSweepers("aws_internet_gateway", &resource.Sweeper{
 		Name: "aws_internet_gateway",
 		Dependencies: []string{
 			"aws_subnet",
@@ -40,11 +42,6 @@ func testSweepInternetGateways(region string) error {
 		return fmt.Errorf("Error describing Internet Gateways: %s", err)
 	}
 
-	if len(resp.InternetGateways) == 0 {
-		log.Print("[DEBUG] No AWS Internet Gateways to sweep")
-		return nil
-	}
-
 	defaultVPCID := ""
 	describeVpcsInput := &ec2.DescribeVpcsInput{
 		Filters: []*ec2.Filter{
@@ -65,57 +62,68 @@ func testSweepInternetGateways(region string) error {
 		defaultVPCID = aws.StringValue(describeVpcsOutput.Vpcs[0].VpcId)
 	}
 
+	var resources []*sweep.Resource
+
 	for _, internetGateway := range resp.InternetGateways {
+		id := aws.StringValue(internetGateway.InternetGatewayId)
+		attachments := internetGateway.Attachments
 		isDefaultVPCInternetGateway := false
 
-		for _, attachment := range internetGateway.Attachments {
+		for _, attachment := range attachments {
 			if aws.StringValue(attachment.VpcId) == defaultVPCID {
 				isDefaultVPCInternetGateway = true
 				break
 			}
-
-			input := &ec2.DetachInternetGatewayInput{
-				InternetGatewayId: internetGateway.InternetGatewayId,
-				VpcId:             attachment.VpcId,
-			}
-
-			log.Printf("[DEBUG] Detaching Internet Gateway: %s", input)
-			_, err := conn.DetachInternetGateway(input)
-			if err != nil {
-				return fmt.Errorf("error detaching Internet Gateway (%s) from VPC (%s): %s", aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId), err)
-			}
-
-			stateConf := &resource.StateChangeConf{
-				Pending: []string{"detaching"},
-				Target:  []string{"detached"},
-				Refresh: detachIGStateRefreshFunc(conn, aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId)),
-				Timeout: 10 * time.Minute,
-				Delay:   10 * time.Second,
-			}
-
-			log.Printf("[DEBUG] Waiting for Internet Gateway (%s) to detach from VPC (%s)", aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId))
-			if _, err = stateConf.WaitForState(); err != nil {
-				return fmt.Errorf("error waiting for VPN Gateway (%s) to detach from VPC (%s): %s", aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId), err)
-			}
 		}
 
 		if isDefaultVPCInternetGateway {
-			log.Printf("[DEBUG] Skipping Default VPC Internet Gateway: %s", aws.StringValue(internetGateway.InternetGatewayId))
+			log.Printf("[DEBUG] Skipping Default VPC Internet Gateway: %s", id)
 			continue
 		}
 
-		input := &ec2.DeleteInternetGatewayInput{
-			InternetGatewayId: internetGateway.InternetGatewayId,
-		}
-
-		log.Printf("[DEBUG] Deleting Internet Gateway: %s", input)
-		_, err := conn.DeleteInternetGateway(input)
-		if err != nil {
-			return fmt.Errorf("error deleting Internet Gateway (%s): %s", aws.StringValue(internetGateway.InternetGatewayId), err)
-		}
+		resources = append(resources, &sweep.Resource{
+			ID:   id,
+			Tags: keyvaluetags.Ec2KeyValueTags(internetGateway.Tags),
+			Delete: func() error {
+				for _, attachment := range attachments {
+					vpcID := aws.StringValue(attachment.VpcId)
+
+					input := &ec2.DetachInternetGatewayInput{
+						InternetGatewayId: aws.String(id),
+						VpcId:             aws.String(vpcID),
+					}
+
+					log.Printf("[DEBUG] Detaching Internet Gateway: %s", input)
+					_, err := conn.DetachInternetGateway(input)
+					if err != nil {
+						return fmt.Errorf("error detaching from VPC (%s): %s", vpcID, err)
+					}
+
+					stateConf := &resource.StateChangeConf{
+						Pending: []string{"detaching"},
+						Target:  []string{"detached"},
+						Refresh: detachIGStateRefreshFunc(conn, id, vpcID),
+						Timeout: 10 * time.Minute,
+						Delay:   10 * time.Second,
+					}
+
+					log.Printf("[DEBUG] Waiting for Internet Gateway (%s) to detach from VPC (%s)", id, vpcID)
+					if _, err = stateConf.WaitForState(); err != nil {
+						return fmt.Errorf("error waiting for detachment from VPC (%s): %s", vpcID, err)
+					}
+				}
+
+				input := &ec2.DeleteInternetGatewayInput{
+					InternetGatewayId: aws.String(id),
+				}
+
+				_, err := conn.DeleteInternetGateway(input)
+				return err
+			},
+		})
 	}
 
-	return nil
+	return testSweepResources(region, "aws_internet_gateway", sweep.Filter{}, resources)
 }
 
 func TestAccAWSInternetGateway_importBasic(t *testing.T) {
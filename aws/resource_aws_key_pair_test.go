@@ -12,10 +12,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	addTestSweepers("aws_key_pair", &resource.Sweeper{
 		Name: "aws_key_pair",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
@@ -33,8 +34,6 @@ func testSweepKeyPairs(region string) error {
 	}
 	ec2conn := client.(*AWSClient).ec2conn
 
-	log.Printf("Destroying the tmp keys in (%s)", client.(*AWSClient).region)
-
 	resp, err := ec2conn.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
@@ -44,17 +43,23 @@ func testSweepKeyPairs(region string) error {
 		return fmt.Errorf("Error describing key pairs in Sweeper: %s", err)
 	}
 
-	keyPairs := resp.KeyPairs
-	for _, d := range keyPairs {
-		_, err := ec2conn.DeleteKeyPair(&ec2.DeleteKeyPairInput{
-			KeyName: d.KeyName,
-		})
+	var resources []*sweep.Resource
 
-		if err != nil {
-			return fmt.Errorf("Error deleting key pairs in Sweeper: %s", err)
-		}
+	for _, d := range resp.KeyPairs {
+		name := aws.StringValue(d.KeyName)
+
+		resources = append(resources, &sweep.Resource{
+			ID: name,
+			Delete: func() error {
+				_, err := ec2conn.DeleteKeyPair(&ec2.DeleteKeyPairInput{
+					KeyName: aws.String(name),
+				})
+				return err
+			},
+		})
 	}
-	return nil
+
+	return testSweepResources(region, "aws_key_pair", sweep.Filter{}, resources)
 }
 
 func TestAccAWSKeyPair_basic(t *testing.T) {
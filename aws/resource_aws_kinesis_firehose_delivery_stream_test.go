@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kinesis_firehose_delivery_stream", &resource.Sweeper{
+	addTestSweepers("aws_kinesis_firehose_delivery_stream", &resource.Sweeper{
 		Name: "aws_kinesis_firehose_delivery_stream",
 		F:    testSweepKinesisFirehoseDeliveryStreams,
 	})
@@ -30,6 +31,7 @@ func testSweepKinesisFirehoseDeliveryStreams(region string) error {
 
 	conn := client.(*AWSClient).firehoseconn
 	input := &firehose.ListDeliveryStreamsInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.ListDeliveryStreams(input)
@@ -43,39 +45,43 @@ func testSweepKinesisFirehoseDeliveryStreams(region string) error {
 			return fmt.Errorf("error listing Kinesis Firehose Delivery Streams: %s", err)
 		}
 
-		if len(output.DeliveryStreamNames) == 0 {
-			log.Print("[DEBUG] No Kinesis Firehose Delivery Streams to sweep")
-			return nil
-		}
-
 		for _, deliveryStreamNamePtr := range output.DeliveryStreamNames {
-			input := &firehose.DeleteDeliveryStreamInput{
-				DeliveryStreamName: deliveryStreamNamePtr,
-			}
 			name := aws.StringValue(deliveryStreamNamePtr)
 
-			log.Printf("[INFO] Deleting Kinesis Firehose Delivery Stream: %s", name)
-			_, err := conn.DeleteDeliveryStream(input)
+			resources = append(resources, &sweep.Resource{
+				ID: name,
+				Delete: func() error {
+					input := &firehose.DeleteDeliveryStreamInput{
+						DeliveryStreamName: aws.String(name),
+					}
 
-			if isAWSErr(err, firehose.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
+					_, err := conn.DeleteDeliveryStream(input)
 
-			if err != nil {
-				return fmt.Errorf("error deleting Kinesis Firehose Delivery Stream (%s): %s", name, err)
-			}
+					if isAWSErr(err, firehose.ErrCodeResourceNotFoundException, "") {
+						return nil
+					}
 
-			if err := waitForKinesisFirehoseDeliveryStreamDeletion(conn, name); err != nil {
-				return fmt.Errorf("error waiting for Kinesis Firehose Delivery Stream (%s) deletion: %s", name, err)
-			}
+					if err != nil {
+						return err
+					}
+
+					if err := waitForKinesisFirehoseDeliveryStreamDeletion(conn, name); err != nil {
+						return fmt.Errorf("error waiting for deletion: %s", err)
+					}
+
+					return nil
+				},
+			})
 		}
 
-		if !aws.BoolValue(output.HasMoreDeliveryStreams) {
+		if !aws.BoolValue(output.HasMoreDeliveryStreams) || len(output.DeliveryStreamNames) == 0 {
 			break
 		}
+
+		input.ExclusiveStartDeliveryStreamName = output.DeliveryStreamNames[len(output.DeliveryStreamNames)-1]
 	}
 
-	return nil
+	return testSweepResources(region, "aws_kinesis_firehose_delivery_stream", sweep.Filter{}, resources)
 }
 
 func TestAccAWSKinesisFirehoseDeliveryStream_importBasic(t *testing.T) {
@@ -0,0 +1,337 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsKinesisVideoStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisVideoStreamCreate,
+		Read:   resourceAwsKinesisVideoStreamRead,
+		Update: resourceAwsKinesisVideoStreamUpdate,
+		Delete: resourceAwsKinesisVideoStreamDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_retention_in_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 87600),
+			},
+			"device_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"media_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[\w\-\.\+]+/[\w\-\.\+]+(,[\w\-\.\+]+/[\w\-\.\+]+)*$`), "must be one or more comma-separated MIME types"),
+				),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must contain only alphanumeric characters, underscores, periods and hyphens"),
+				),
+			},
+			"tags": tagsSchema(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsKinesisVideoStreamCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+	name := d.Get("name").(string)
+
+	input := &kinesisvideo.CreateStreamInput{
+		DataRetentionInHours: aws.Int64(int64(d.Get("data_retention_in_hours").(int))),
+		StreamName:           aws.String(name),
+	}
+
+	if v, ok := d.GetOk("device_name"); ok {
+		input.DeviceName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("media_type"); ok {
+		input.MediaType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().KinesisvideoTags()
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Video Stream: %s", input)
+	output, err := conn.CreateStream(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Video Stream (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.StreamARN))
+
+	if err := waitForKinesisVideoStreamActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Video Stream (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsKinesisVideoStreamRead(d, meta)
+}
+
+func resourceAwsKinesisVideoStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+
+	stream, err := describeKinesisVideoStream(conn, d.Id())
+
+	if isAWSErr(err, kinesisvideo.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Kinesis Video Stream (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Video Stream (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", stream.StreamARN)
+	d.Set("creation_time", aws.TimeValue(stream.CreationTime).Format(time.RFC3339))
+	d.Set("data_retention_in_hours", stream.DataRetentionInHours)
+	d.Set("device_name", stream.DeviceName)
+	d.Set("kms_key_id", stream.KmsKeyId)
+	d.Set("media_type", stream.MediaType)
+	d.Set("name", stream.StreamName)
+	d.Set("version", stream.Version)
+
+	tags, err := conn.ListTagsForStream(&kinesisvideo.ListTagsForStreamInput{
+		StreamARN: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kinesis Video Stream (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.KinesisvideoKeyValueTags(tags.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisVideoStreamUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+
+	// Every stream update must name the current stream version, which
+	// changes with each update, so updates are applied one at a time.
+	if d.HasChange("data_retention_in_hours") {
+		o, n := d.GetChange("data_retention_in_hours")
+		change := n.(int) - o.(int)
+
+		input := &kinesisvideo.UpdateDataRetentionInput{
+			CurrentVersion:             aws.String(d.Get("version").(string)),
+			DataRetentionChangeInHours: aws.Int64(int64(change)),
+			Operation:                  aws.String(kinesisvideo.UpdateDataRetentionOperationIncreaseDataRetention),
+			StreamARN:                  aws.String(d.Id()),
+		}
+
+		if change < 0 {
+			input.DataRetentionChangeInHours = aws.Int64(int64(-change))
+			input.Operation = aws.String(kinesisvideo.UpdateDataRetentionOperationDecreaseDataRetention)
+		}
+
+		log.Printf("[DEBUG] Updating Kinesis Video Stream data retention: %s", input)
+		if _, err := conn.UpdateDataRetention(input); err != nil {
+			return fmt.Errorf("error updating Kinesis Video Stream (%s) data retention: %s", d.Id(), err)
+		}
+
+		if err := resourceAwsKinesisVideoStreamWaitForUpdate(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("device_name") || d.HasChange("media_type") {
+		input := &kinesisvideo.UpdateStreamInput{
+			CurrentVersion: aws.String(d.Get("version").(string)),
+			StreamARN:      aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("device_name"); ok {
+			input.DeviceName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("media_type"); ok {
+			input.MediaType = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Kinesis Video Stream: %s", input)
+		if _, err := conn.UpdateStream(input); err != nil {
+			return fmt.Errorf("error updating Kinesis Video Stream (%s): %s", d.Id(), err)
+		}
+
+		if err := resourceAwsKinesisVideoStreamWaitForUpdate(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.KinesisvideoUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Kinesis Video Stream (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsKinesisVideoStreamRead(d, meta)
+}
+
+func resourceAwsKinesisVideoStreamDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+
+	input := &kinesisvideo.DeleteStreamInput{
+		StreamARN: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Kinesis Video Stream: %s", input)
+	_, err := conn.DeleteStream(input)
+
+	if isAWSErr(err, kinesisvideo.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Video Stream (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForKinesisVideoStreamDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Video Stream (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsKinesisVideoStreamWaitForUpdate waits for an update to finish
+// and records the new stream version for the next update.
+func resourceAwsKinesisVideoStreamWaitForUpdate(conn *kinesisvideo.KinesisVideo, d *schema.ResourceData) error {
+	if err := waitForKinesisVideoStreamActive(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Video Stream (%s) update: %s", d.Id(), err)
+	}
+
+	stream, err := describeKinesisVideoStream(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Video Stream (%s): %s", d.Id(), err)
+	}
+
+	d.Set("version", stream.Version)
+
+	return nil
+}
+
+func describeKinesisVideoStream(conn *kinesisvideo.KinesisVideo, arn string) (*kinesisvideo.StreamInfo, error) {
+	output, err := conn.DescribeStream(&kinesisvideo.DescribeStreamInput{
+		StreamARN: aws.String(arn),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StreamInfo == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	return output.StreamInfo, nil
+}
+
+func refreshKinesisVideoStreamStatus(conn *kinesisvideo.KinesisVideo, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		stream, err := describeKinesisVideoStream(conn, arn)
+
+		if isAWSErr(err, kinesisvideo.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return stream, aws.StringValue(stream.Status), nil
+	}
+}
+
+func waitForKinesisVideoStreamActive(conn *kinesisvideo.KinesisVideo, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{kinesisvideo.StatusCreating, kinesisvideo.StatusUpdating},
+		Target:     []string{kinesisvideo.StatusActive},
+		Refresh:    refreshKinesisVideoStreamStatus(conn, arn),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForKinesisVideoStreamDeletion(conn *kinesisvideo.KinesisVideo, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{kinesisvideo.StatusActive, kinesisvideo.StatusDeleting},
+		Target:     []string{},
+		Refresh:    refreshKinesisVideoStreamStatus(conn, arn),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
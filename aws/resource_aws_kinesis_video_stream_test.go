@@ -0,0 +1,398 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+)
+
+func init() {
+	addTestSweepers("aws_kinesis_video_stream", &resource.Sweeper{
+		Name: "aws_kinesis_video_stream",
+		F:    testSweepKinesisVideoStreams,
+	})
+}
+
+func testSweepKinesisVideoStreams(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).kinesisvideoconn
+
+	var resources []*sweep.Resource
+	input := &kinesisvideo.ListStreamsInput{}
+
+	for {
+		output, err := conn.ListStreams(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping Kinesis Video Stream sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Kinesis Video Streams: %s", err)
+		}
+
+		for _, stream := range output.StreamInfoList {
+			arn := aws.StringValue(stream.StreamARN)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        arn,
+				Name:      aws.StringValue(stream.StreamName),
+				CreatedAt: aws.TimeValue(stream.CreationTime),
+				Delete: func() error {
+					_, err := conn.DeleteStream(&kinesisvideo.DeleteStreamInput{
+						StreamARN: aws.String(arn),
+					})
+					if isAWSErr(err, kinesisvideo.ErrCodeResourceNotFoundException, "") {
+						return nil
+					}
+					if err != nil {
+						return err
+					}
+					return waitForKinesisVideoStreamDeletion(conn, arn, 5*time.Minute)
+				},
+			})
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	filter := sweep.Filter{
+		NamePrefixes: []string{"tf-acc-test"},
+	}
+
+	if err := testSweepResources(region, "aws_kinesis_video_stream", filter, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSKinesisVideoStream_basic(t *testing.T) {
+	var stream kinesisvideo.StreamInfo
+	resourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSKinesisVideo(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsKinesisVideoStreamConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsKinesisVideoStreamExists(resourceName, &stream),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "kinesisvideo", regexp.MustCompile(fmt.Sprintf(`stream/%s/.+`, rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "0"),
+					resource.TestCheckResourceAttr(resourceName, "device_name", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "media_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisVideoStream_Update(t *testing.T) {
+	var stream kinesisvideo.StreamInfo
+	resourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSKinesisVideo(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsKinesisVideoStreamConfigOptions(rName, 1, "camera-1", "video/h264"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "1"),
+					resource.TestCheckResourceAttr(resourceName, "device_name", "camera-1"),
+					resource.TestCheckResourceAttr(resourceName, "media_type", "video/h264"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsKinesisVideoStreamConfigOptions(rName, 24, "camera-2", "video/h265"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "24"),
+					resource.TestCheckResourceAttr(resourceName, "device_name", "camera-2"),
+					resource.TestCheckResourceAttr(resourceName, "media_type", "video/h265"),
+				),
+			},
+			{
+				Config: testAccAwsKinesisVideoStreamConfigOptions(rName, 12, "camera-2", "video/h265"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "12"),
+				),
+			},
+			// Device name and media type cannot be unset, so the current values are kept
+			{
+				Config: testAccAwsKinesisVideoStreamConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "device_name", "camera-2"),
+					resource.TestCheckResourceAttr(resourceName, "media_type", "video/h265"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisVideoStream_KmsKeyId(t *testing.T) {
+	var stream kinesisvideo.StreamInfo
+	resourceName := "aws_kinesis_video_stream.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSKinesisVideo(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsKinesisVideoStreamConfigKmsKeyId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", kmsKeyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisVideoStream_Tags(t *testing.T) {
+	var stream kinesisvideo.StreamInfo
+	resourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSKinesisVideo(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsKinesisVideoStreamConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsKinesisVideoStreamConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsKinesisVideoStreamConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisVideoStream_disappears(t *testing.T) {
+	var stream kinesisvideo.StreamInfo
+	resourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSKinesisVideo(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsKinesisVideoStreamConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsKinesisVideoStreamExists(resourceName, &stream),
+					testAccCheckAwsKinesisVideoStreamDisappears(&stream),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSKinesisVideo(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn
+
+	_, err := conn.ListStreams(&kinesisvideo.ListStreamsInput{})
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAwsKinesisVideoStreamExists(resourceName string, v *kinesisvideo.StreamInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn
+
+		stream, err := describeKinesisVideoStream(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *stream
+
+		return nil
+	}
+}
+
+func testAccCheckAwsKinesisVideoStreamDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kinesis_video_stream" {
+			continue
+		}
+
+		stream, err := describeKinesisVideoStream(conn, rs.Primary.ID)
+
+		if isAWSErr(err, kinesisvideo.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kinesis Video Stream (%s) still exists in status %s", rs.Primary.ID, aws.StringValue(stream.Status))
+	}
+
+	return nil
+}
+
+func testAccCheckAwsKinesisVideoStreamDisappears(stream *kinesisvideo.StreamInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn
+
+		_, err := conn.DeleteStream(&kinesisvideo.DeleteStreamInput{
+			StreamARN: stream.StreamARN,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return waitForKinesisVideoStreamDeletion(conn, aws.StringValue(stream.StreamARN), 5*time.Minute)
+	}
+}
+
+func testAccAwsKinesisVideoStreamConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsKinesisVideoStreamConfigOptions(rName string, dataRetentionInHours int, deviceName, mediaType string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = %[2]d
+  device_name             = %[3]q
+  media_type              = %[4]q
+}
+`, rName, dataRetentionInHours, deviceName, mediaType)
+}
+
+func testAccAwsKinesisVideoStreamConfigKmsKeyId(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kinesis_video_stream" "test" {
+  name       = %[1]q
+  kms_key_id = "${aws_kms_key.test.arn}"
+}
+`, rName)
+}
+
+func testAccAwsKinesisVideoStreamConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsKinesisVideoStreamConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/jen20/awspolicyequivalence"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kms_key", &resource.Sweeper{
+	addTestSweepers("aws_kms_key", &resource.Sweeper{
 		Name: "aws_kms_key",
 		F:    testSweepKmsKeys,
 	})
@@ -27,6 +28,8 @@ func testSweepKmsKeys(region string) error {
 	}
 	conn := client.(*AWSClient).kmsconn
 
+	var resources []*sweep.Resource
+
 	err = conn.ListKeysPages(&kms.ListKeysInput{Limit: aws.Int64(int64(1000))}, func(out *kms.ListKeysOutput, lastPage bool) bool {
 		for _, k := range out.Keys {
 			kOut, err := conn.DescribeKey(&kms.DescribeKeyInput{
@@ -45,14 +48,19 @@ func testSweepKmsKeys(region string) error {
 				continue
 			}
 
-			_, err = conn.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{
-				KeyId:               k.KeyId,
-				PendingWindowInDays: aws.Int64(int64(7)),
+			id := aws.StringValue(k.KeyId)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				CreatedAt: aws.TimeValue(kOut.KeyMetadata.CreationDate),
+				Delete: func() error {
+					_, err := conn.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{
+						KeyId:               aws.String(id),
+						PendingWindowInDays: aws.Int64(int64(7)),
+					})
+					return err
+				},
 			})
-			if err != nil {
-				log.Printf("Error: Failed to schedule key %q for deletion: %s", *k.KeyId, err)
-				return false
-			}
 		}
 		return !lastPage
 	})
@@ -64,6 +72,10 @@ func testSweepKmsKeys(region string) error {
 		return fmt.Errorf("Error describing KMS keys: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_kms_key", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -295,7 +307,7 @@ func testAccCheckAWSKmsKeyExists(name string, key *kms.KeyMetadata) resource.Tes
 
 		conn := testAccProvider.Meta().(*AWSClient).kmsconn
 
-		o, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+		o, err := retryOnAwsCode(testAccProvider.Meta(), "NotFoundException", func() (interface{}, error) {
 			return conn.DescribeKey(&kms.DescribeKeyInput{
 				KeyId: aws.String(rs.Primary.ID),
 			})
@@ -17,10 +17,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
+	addTestSweepers("aws_lambda_function", &resource.Sweeper{
 		Name: "aws_lambda_function",
 		F:    testSweepLambdaFunctions,
 	})
@@ -43,22 +44,23 @@ func testSweepLambdaFunctions(region string) error {
 		return fmt.Errorf("Error retrieving Lambda functions: %s", err)
 	}
 
-	if len(resp.Functions) == 0 {
-		log.Print("[DEBUG] No aws lambda functions to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, f := range resp.Functions {
-		_, err := lambdaconn.DeleteFunction(
-			&lambda.DeleteFunctionInput{
-				FunctionName: f.FunctionName,
-			})
-		if err != nil {
-			return err
-		}
+		name := aws.StringValue(f.FunctionName)
+
+		resources = append(resources, &sweep.Resource{
+			ID: name,
+			Delete: func() error {
+				_, err := lambdaconn.DeleteFunction(&lambda.DeleteFunctionInput{
+					FunctionName: aws.String(name),
+				})
+				return err
+			},
+		})
 	}
 
-	return nil
+	return testSweepResources(region, "aws_lambda_function", sweep.Filter{}, resources)
 }
 
 func TestAccAWSLambdaFunction_importLocalFile(t *testing.T) {
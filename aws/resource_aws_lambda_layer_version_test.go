@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
+	addTestSweepers("aws_lambda_layer", &resource.Sweeper{
 		Name: "aws_lambda_layer",
 		F:    testSweepLambdaLayerVersions,
 	})
@@ -36,31 +37,36 @@ func testSweepLambdaLayerVersions(region string) error {
 		return fmt.Errorf("Error retrieving Lambda layers: %s", err)
 	}
 
-	if len(resp.Layers) == 0 {
-		log.Print("[DEBUG] No aws lambda layers to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, l := range resp.Layers {
+		layerName := aws.StringValue(l.LayerName)
+
 		versionResp, err := lambdaconn.ListLayerVersions(&lambda.ListLayerVersionsInput{
-			LayerName: l.LayerName,
+			LayerName: aws.String(layerName),
 		})
 		if err != nil {
 			return fmt.Errorf("Error retrieving versions for lambda layer: %s", err)
 		}
 
 		for _, v := range versionResp.LayerVersions {
-			_, err := lambdaconn.DeleteLayerVersion(&lambda.DeleteLayerVersionInput{
-				LayerName:     l.LayerName,
-				VersionNumber: v.Version,
+			version := aws.Int64Value(v.Version)
+
+			resources = append(resources, &sweep.Resource{
+				ID:   fmt.Sprintf("%s:%d", layerName, version),
+				Name: layerName,
+				Delete: func() error {
+					_, err := lambdaconn.DeleteLayerVersion(&lambda.DeleteLayerVersionInput{
+						LayerName:     aws.String(layerName),
+						VersionNumber: aws.Int64(version),
+					})
+					return err
+				},
 			})
-			if err != nil {
-				return err
-			}
 		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_lambda_layer_version", sweep.Filter{}, resources)
 }
 
 func TestAccAWSLambdaLayerVersion_basic(t *testing.T) {
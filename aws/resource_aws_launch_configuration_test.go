@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_launch_configuration", &resource.Sweeper{
+	addTestSweepers("aws_launch_configuration", &resource.Sweeper{
 		Name:         "aws_launch_configuration",
 		Dependencies: []string{"aws_autoscaling_group"},
 		F:            testSweepLaunchConfigurations,
@@ -39,28 +40,28 @@ func testSweepLaunchConfigurations(region string) error {
 		return fmt.Errorf("Error retrieving launch configuration: %s", err)
 	}
 
-	if len(resp.LaunchConfigurations) == 0 {
-		log.Print("[DEBUG] No aws launch configurations to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, lc := range resp.LaunchConfigurations {
-		name := *lc.LaunchConfigurationName
-
-		log.Printf("[INFO] Deleting Launch Configuration: %s", name)
-		_, err := autoscalingconn.DeleteLaunchConfiguration(
-			&autoscaling.DeleteLaunchConfigurationInput{
-				LaunchConfigurationName: aws.String(name),
-			})
-		if err != nil {
-			if isAWSErr(err, "InvalidConfiguration.NotFound", "") || isAWSErr(err, "ValidationError", "") {
-				return nil
-			}
-			return err
-		}
+		name := aws.StringValue(lc.LaunchConfigurationName)
+
+		resources = append(resources, &sweep.Resource{
+			ID:        name,
+			CreatedAt: aws.TimeValue(lc.CreatedTime),
+			Delete: func() error {
+				_, err := autoscalingconn.DeleteLaunchConfiguration(
+					&autoscaling.DeleteLaunchConfigurationInput{
+						LaunchConfigurationName: aws.String(name),
+					})
+				if isAWSErr(err, "InvalidConfiguration.NotFound", "") || isAWSErr(err, "ValidationError", "") {
+					return nil
+				}
+				return err
+			},
+		})
 	}
 
-	return nil
+	return testSweepResources(region, "aws_launch_configuration", sweep.Filter{}, resources)
 }
 
 func TestAccAWSLaunchConfiguration_importBasic(t *testing.T) {
@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
+	addTestSweepers("aws_lb_target_group", &resource.Sweeper{
 		Name: "aws_lb_target_group",
 		F:    testSweepLBTargetGroups,
 		Dependencies: []string{
@@ -31,22 +32,26 @@ func testSweepLBTargetGroups(region string) error {
 	}
 	conn := client.(*AWSClient).elbv2conn
 
+	var resources []*sweep.Resource
+
 	err = conn.DescribeTargetGroupsPages(&elbv2.DescribeTargetGroupsInput{}, func(page *elbv2.DescribeTargetGroupsOutput, isLast bool) bool {
-		if page == nil || len(page.TargetGroups) == 0 {
-			log.Print("[DEBUG] No LB Target Groups to sweep")
+		if page == nil {
 			return false
 		}
 
 		for _, targetGroup := range page.TargetGroups {
-			name := aws.StringValue(targetGroup.TargetGroupName)
-
-			log.Printf("[INFO] Deleting LB Target Group: %s", name)
-			_, err := conn.DeleteTargetGroup(&elbv2.DeleteTargetGroupInput{
-				TargetGroupArn: targetGroup.TargetGroupArn,
+			arn := aws.StringValue(targetGroup.TargetGroupArn)
+
+			resources = append(resources, &sweep.Resource{
+				ID:   arn,
+				Name: aws.StringValue(targetGroup.TargetGroupName),
+				Delete: func() error {
+					_, err := conn.DeleteTargetGroup(&elbv2.DeleteTargetGroupInput{
+						TargetGroupArn: aws.String(arn),
+					})
+					return err
+				},
 			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete LB Target Group (%s): %s", name, err)
-			}
 		}
 		return !isLast
 	})
@@ -57,6 +62,11 @@ func testSweepLBTargetGroups(region string) error {
 		}
 		return fmt.Errorf("Error retrieving LB Target Groups: %s", err)
 	}
+
+	if err := testSweepResources(region, "aws_lb_target_group", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
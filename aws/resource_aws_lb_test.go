@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lb", &resource.Sweeper{
+	addTestSweepers("aws_lb", &resource.Sweeper{
 		Name: "aws_lb",
 		F:    testSweepLBs,
 		Dependencies: []string{
@@ -32,22 +33,27 @@ func testSweepLBs(region string) error {
 	}
 	conn := client.(*AWSClient).elbv2conn
 
+	var resources []*sweep.Resource
+
 	err = conn.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, isLast bool) bool {
-		if page == nil || len(page.LoadBalancers) == 0 {
-			log.Print("[DEBUG] No LBs to sweep")
+		if page == nil {
 			return false
 		}
 
 		for _, loadBalancer := range page.LoadBalancers {
-			name := aws.StringValue(loadBalancer.LoadBalancerName)
-
-			log.Printf("[INFO] Deleting LB: %s", name)
-			_, err := conn.DeleteLoadBalancer(&elbv2.DeleteLoadBalancerInput{
-				LoadBalancerArn: loadBalancer.LoadBalancerArn,
+			arn := aws.StringValue(loadBalancer.LoadBalancerArn)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        arn,
+				Name:      aws.StringValue(loadBalancer.LoadBalancerName),
+				CreatedAt: aws.TimeValue(loadBalancer.CreatedTime),
+				Delete: func() error {
+					_, err := conn.DeleteLoadBalancer(&elbv2.DeleteLoadBalancerInput{
+						LoadBalancerArn: aws.String(arn),
+					})
+					return err
+				},
 			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete LB (%s): %s", name, err)
-			}
 		}
 		return !isLast
 	})
@@ -58,6 +64,11 @@ func testSweepLBs(region string) error {
 		}
 		return fmt.Errorf("Error retrieving LBs: %s", err)
 	}
+
+	if err := testSweepResources(region, "aws_lb", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
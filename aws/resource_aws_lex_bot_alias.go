@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexBotAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexBotAliasCreate,
+		Read:   resourceAwsLexBotAliasRead,
+		Update: resourceAwsLexBotAliasUpdate,
+		Delete: resourceAwsLexBotAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLexBotAliasImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 50),
+					validation.StringMatch(regexp.MustCompile(lexNameRegexp), ""),
+				),
+			},
+			"bot_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(lexNameRegexp), ""),
+				),
+			},
+		},
+	}
+}
+
+func resourceAwsLexBotAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	botName := d.Get("bot_name").(string)
+	name := d.Get("name").(string)
+
+	input := &lexmodelbuildingservice.PutBotAliasInput{
+		BotName:     aws.String(botName),
+		BotVersion:  aws.String(d.Get("bot_version").(string)),
+		Description: aws.String(d.Get("description").(string)),
+		Name:        aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Lex Bot Alias: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err := conn.PutBotAlias(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Lex Bot Alias (%s): %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", botName, name))
+
+	return resourceAwsLexBotAliasRead(d, meta)
+}
+
+func resourceAwsLexBotAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	output, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+		BotName: aws.String(d.Get("bot_name").(string)),
+		Name:    aws.String(d.Get("name").(string)),
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lex Bot Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Bot Alias (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_name", output.BotName)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", output.Name)
+
+	return nil
+}
+
+func resourceAwsLexBotAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	// The checksum of the bot alias last read guards against overwriting
+	// changes made outside of Terraform since then.
+	input := &lexmodelbuildingservice.PutBotAliasInput{
+		BotName:     aws.String(d.Get("bot_name").(string)),
+		BotVersion:  aws.String(d.Get("bot_version").(string)),
+		Checksum:    aws.String(d.Get("checksum").(string)),
+		Description: aws.String(d.Get("description").(string)),
+		Name:        aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Lex Bot Alias: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.PutBotAlias(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodePreconditionFailedException, "") {
+		return fmt.Errorf("error updating Lex Bot Alias (%s): bot alias was modified outside of Terraform, refresh and try again: %s", d.Id(), err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error updating Lex Bot Alias (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsLexBotAliasRead(d, meta)
+}
+
+func resourceAwsLexBotAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := &lexmodelbuildingservice.DeleteBotAliasInput{
+		BotName: aws.String(d.Get("bot_name").(string)),
+		Name:    aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting Lex Bot Alias: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteBotAlias(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex Bot Alias (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexBotAliasImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected BOT_NAME:ALIAS_NAME", d.Id())
+	}
+
+	d.Set("bot_name", parts[0])
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
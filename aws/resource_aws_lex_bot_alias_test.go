@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+)
+
+func init() {
+	addTestSweepers("aws_lex_bot_alias", &resource.Sweeper{
+		Name: "aws_lex_bot_alias",
+		F:    testSweepLexBotAliases,
+	})
+}
+
+func testSweepLexBotAliases(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).lexmodelconn
+
+	var botNames []string
+	err = conn.GetBotsPages(&lexmodelbuildingservice.GetBotsInput{}, func(page *lexmodelbuildingservice.GetBotsOutput, lastPage bool) bool {
+		for _, bot := range page.Bots {
+			botNames = append(botNames, aws.StringValue(bot.Name))
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lex Bot Alias sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Lex Bots: %s", err)
+	}
+
+	var resources []*sweep.Resource
+	for _, botName := range botNames {
+		botName := botName
+		input := &lexmodelbuildingservice.GetBotAliasesInput{
+			BotName: aws.String(botName),
+		}
+
+		err := conn.GetBotAliasesPages(input, func(page *lexmodelbuildingservice.GetBotAliasesOutput, lastPage bool) bool {
+			for _, alias := range page.BotAliases {
+				name := aws.StringValue(alias.Name)
+
+				resources = append(resources, &sweep.Resource{
+					ID:        fmt.Sprintf("%s:%s", botName, name),
+					Name:      botName,
+					CreatedAt: aws.TimeValue(alias.CreatedDate),
+					Delete: func() error {
+						_, err := conn.DeleteBotAlias(&lexmodelbuildingservice.DeleteBotAliasInput{
+							BotName: aws.String(botName),
+							Name:    aws.String(name),
+						})
+						if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+							return nil
+						}
+						return err
+					},
+				})
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error listing Lex Bot (%s) Aliases: %s", botName, err)
+		}
+	}
+
+	// Aliases are matched on the name of their bot.
+	filter := sweep.Filter{
+		NamePrefixes: []string{"tf_acc_test"},
+	}
+
+	if err := testSweepResources(region, "aws_lex_bot_alias", filter, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSLexBotAlias_basic(t *testing.T) {
+	var alias lexmodelbuildingservice.GetBotAliasOutput
+	resourceName := "aws_lex_bot_alias.test"
+	rName := testAccLexRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexBotAliasConfig(rName, "Production"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotAliasExists(resourceName, &alias),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_name", "aws_lex_bot.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_version", "aws_lex_bot.test", "version"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Production"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsLexBotAliasConfig(rName, "Production version"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotAliasExists(resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "description", "Production version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexBotAlias_disappears(t *testing.T) {
+	var alias lexmodelbuildingservice.GetBotAliasOutput
+	resourceName := "aws_lex_bot_alias.test"
+	rName := testAccLexRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexBotAliasConfig(rName, "Production"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotAliasExists(resourceName, &alias),
+					testAccCheckAwsLexBotAliasDisappears(&alias),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexBotAliasExists(resourceName string, v *lexmodelbuildingservice.GetBotAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		output, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(rs.Primary.Attributes["bot_name"]),
+			Name:    aws.String(rs.Primary.Attributes["name"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLexBotAliasDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_bot_alias" {
+			continue
+		}
+
+		_, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(rs.Primary.Attributes["bot_name"]),
+			Name:    aws.String(rs.Primary.Attributes["name"]),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Bot Alias (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsLexBotAliasDisappears(alias *lexmodelbuildingservice.GetBotAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		_, err := conn.DeleteBotAlias(&lexmodelbuildingservice.DeleteBotAliasInput{
+			BotName: alias.BotName,
+			Name:    alias.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccAwsLexBotAliasConfig(rName, description string) string {
+	return testAccAwsLexBotConfigBuild(rName, true) + fmt.Sprintf(`
+resource "aws_lex_bot_alias" "test" {
+  bot_name    = "${aws_lex_bot.test.name}"
+  bot_version = "${aws_lex_bot.test.version}"
+  description = %[2]q
+  name        = %[1]q
+}
+`, rName, description)
+}
@@ -0,0 +1,323 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+)
+
+func init() {
+	addTestSweepers("aws_lex_bot", &resource.Sweeper{
+		Name: "aws_lex_bot",
+		F:    testSweepLexBots,
+		Dependencies: []string{
+			"aws_lex_bot_alias",
+		},
+	})
+}
+
+func testSweepLexBots(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).lexmodelconn
+
+	var resources []*sweep.Resource
+	err = conn.GetBotsPages(&lexmodelbuildingservice.GetBotsInput{}, func(page *lexmodelbuildingservice.GetBotsOutput, lastPage bool) bool {
+		for _, bot := range page.Bots {
+			name := aws.StringValue(bot.Name)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        name,
+				CreatedAt: aws.TimeValue(bot.CreatedDate),
+				Delete: func() error {
+					_, err := conn.DeleteBot(&lexmodelbuildingservice.DeleteBotInput{
+						Name: aws.String(name),
+					})
+					if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+						return nil
+					}
+					if err != nil {
+						return err
+					}
+					return waitForLexBotDeletion(conn, name, 5*time.Minute)
+				},
+			})
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lex Bot sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Lex Bots: %s", err)
+	}
+
+	filter := sweep.Filter{
+		NamePrefixes: []string{"tf_acc_test"},
+	}
+
+	if err := testSweepResources(region, "aws_lex_bot", filter, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSLexBot_basic(t *testing.T) {
+	var bot lexmodelbuildingservice.GetBotOutput
+	resourceName := "aws_lex_bot.test"
+	rName := testAccLexRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexBotConfigBasic(rName, "Bot to order flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "abort_statement.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttr(resourceName, "child_directed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "clarification_prompt.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Bot to order flowers"),
+					resource.TestCheckResourceAttr(resourceName, "failure_reason", ""),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "intent.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttr(resourceName, "locale", lexmodelbuildingservice.LocaleEnUs),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "process_behavior", lexmodelbuildingservice.ProcessBehaviorSave),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelbuildingservice.StatusNotBuilt),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsLexBotConfigBasic(rName, "Bot to order and pick up flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "description", "Bot to order and pick up flowers"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexBot_Build(t *testing.T) {
+	var bot lexmodelbuildingservice.GetBotOutput
+	resourceName := "aws_lex_bot.test"
+	rName := testAccLexRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexBotConfigBuild(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "process_behavior", lexmodelbuildingservice.ProcessBehaviorBuild),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelbuildingservice.StatusReady),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+			{
+				Config: testAccAwsLexBotConfigBuild(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelbuildingservice.StatusReady),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexBot_disappears(t *testing.T) {
+	var bot lexmodelbuildingservice.GetBotOutput
+	resourceName := "aws_lex_bot.test"
+	rName := testAccLexRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexBotConfigBasic(rName, "Bot to order flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotExists(resourceName, &bot),
+					testAccCheckAwsLexBotDisappears(&bot),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexBotExists(resourceName string, v *lexmodelbuildingservice.GetBotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(rs.Primary.ID),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLexBotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_bot" {
+			continue
+		}
+
+		_, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(rs.Primary.ID),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Bot (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsLexBotDisappears(bot *lexmodelbuildingservice.GetBotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		_, err := conn.DeleteBot(&lexmodelbuildingservice.DeleteBotInput{
+			Name: bot.Name,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return waitForLexBotDeletion(conn, aws.StringValue(bot.Name), 5*time.Minute)
+	}
+}
+
+func testAccAwsLexBotConfigIntent(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_intent" "test" {
+  name              = %[1]q
+  create_version    = true
+  sample_utterances = ["I would like to order some flowers"]
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+}
+`, rName)
+}
+
+func testAccAwsLexBotConfigBasic(rName, description string) string {
+	return testAccAwsLexBotConfigIntent(rName) + fmt.Sprintf(`
+resource "aws_lex_bot" "test" {
+  name           = %[1]q
+  description    = %[2]q
+  child_directed = false
+
+  abort_statement {
+    message {
+      content      = "Sorry, I am not able to assist at this time"
+      content_type = "PlainText"
+    }
+  }
+
+  clarification_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "I didn't understand you, what would you like to do?"
+      content_type = "PlainText"
+    }
+  }
+
+  intent {
+    intent_name    = "${aws_lex_intent.test.name}"
+    intent_version = "${aws_lex_intent.test.version}"
+  }
+}
+`, rName, description)
+}
+
+func testAccAwsLexBotConfigBuild(rName string, createVersion bool) string {
+	return testAccAwsLexBotConfigIntent(rName) + fmt.Sprintf(`
+resource "aws_lex_bot" "test" {
+  name             = %[1]q
+  child_directed   = false
+  create_version   = %[2]t
+  process_behavior = "BUILD"
+
+  abort_statement {
+    message {
+      content      = "Sorry, I am not able to assist at this time"
+      content_type = "PlainText"
+    }
+  }
+
+  clarification_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "I didn't understand you, what would you like to do?"
+      content_type = "PlainText"
+    }
+  }
+
+  intent {
+    intent_name    = "${aws_lex_intent.test.name}"
+    intent_version = "${aws_lex_intent.test.version}"
+  }
+}
+`, rName, createVersion)
+}
@@ -0,0 +1,549 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexIntent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexIntentCreate,
+		Read:   resourceAwsLexIntentRead,
+		Update: resourceAwsLexIntentUpdate,
+		Delete: resourceAwsLexIntentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"conclusion_statement": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Elem:          lexStatementResource,
+				ConflictsWith: []string{"follow_up_prompt"},
+			},
+			"confirmation_prompt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexPromptResource,
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"dialog_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexCodeHookResource,
+			},
+			"follow_up_prompt": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"conclusion_statement"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prompt": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem:     lexPromptResource,
+						},
+						"rejection_statement": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem:     lexStatementResource,
+						},
+					},
+				},
+			},
+			"fulfillment_activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_hook": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     lexCodeHookResource,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lexmodelbuildingservice.FulfillmentActivityTypeCodeHook,
+								lexmodelbuildingservice.FulfillmentActivityTypeReturnIntent,
+							}, false),
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(lexNameRegexp), ""),
+				),
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rejection_statement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexStatementResource,
+			},
+			"sample_utterances": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1500,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 200),
+				},
+			},
+			"slot": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 100),
+								validation.StringMatch(regexp.MustCompile(lexNameRegexp), ""),
+							),
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"response_card": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50000),
+						},
+						"sample_utterances": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+						},
+						"slot_constraint": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lexmodelbuildingservice.SlotConstraintOptional,
+								lexmodelbuildingservice.SlotConstraintRequired,
+							}, false),
+						},
+						"slot_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"slot_type_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"value_elicitation_prompt": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     lexPromptResource,
+						},
+					},
+				},
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexIntentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := expandLexIntentInput(d)
+	input.Name = aws.String(name)
+
+	log.Printf("[DEBUG] Creating Lex Intent: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		output, err := conn.PutIntent(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		d.Set("checksum", output.Checksum)
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Lex Intent (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if d.Get("create_version").(bool) {
+		if err := createLexIntentVersion(conn, d.Id(), d.Get("checksum").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsLexIntentRead(d, meta)
+}
+
+func resourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	output, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+		Name:    aws.String(d.Id()),
+		Version: aws.String(lexVersionLatest),
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lex Intent (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Intent (%s): %s", d.Id(), err)
+	}
+
+	d.Set("checksum", output.Checksum)
+
+	if err := d.Set("conclusion_statement", flattenLexStatement(output.ConclusionStatement)); err != nil {
+		return fmt.Errorf("error setting conclusion_statement: %s", err)
+	}
+
+	if err := d.Set("confirmation_prompt", flattenLexPrompt(output.ConfirmationPrompt)); err != nil {
+		return fmt.Errorf("error setting confirmation_prompt: %s", err)
+	}
+
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+
+	if err := d.Set("dialog_code_hook", flattenLexCodeHook(output.DialogCodeHook)); err != nil {
+		return fmt.Errorf("error setting dialog_code_hook: %s", err)
+	}
+
+	if err := d.Set("follow_up_prompt", flattenLexFollowUpPrompt(output.FollowUpPrompt)); err != nil {
+		return fmt.Errorf("error setting follow_up_prompt: %s", err)
+	}
+
+	if err := d.Set("fulfillment_activity", flattenLexFulfillmentActivity(output.FulfillmentActivity)); err != nil {
+		return fmt.Errorf("error setting fulfillment_activity: %s", err)
+	}
+
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", output.Name)
+	d.Set("parent_intent_signature", output.ParentIntentSignature)
+
+	if err := d.Set("rejection_statement", flattenLexStatement(output.RejectionStatement)); err != nil {
+		return fmt.Errorf("error setting rejection_statement: %s", err)
+	}
+
+	if err := d.Set("sample_utterances", flattenStringSet(output.SampleUtterances)); err != nil {
+		return fmt.Errorf("error setting sample_utterances: %s", err)
+	}
+
+	if err := d.Set("slot", flattenLexSlots(output.Slots)); err != nil {
+		return fmt.Errorf("error setting slot: %s", err)
+	}
+
+	version := lexVersionLatest
+	if d.Get("create_version").(bool) {
+		version, err = getLexIntentLatestVersion(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading Lex Intent (%s) versions: %s", d.Id(), err)
+		}
+	}
+	d.Set("version", version)
+
+	return nil
+}
+
+func resourceAwsLexIntentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	// The checksum of the intent last read guards against overwriting
+	// changes made outside of Terraform since then.
+	input := expandLexIntentInput(d)
+	input.Checksum = aws.String(d.Get("checksum").(string))
+	input.Name = aws.String(d.Id())
+
+	log.Printf("[DEBUG] Updating Lex Intent: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		output, err := conn.PutIntent(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		d.Set("checksum", output.Checksum)
+
+		return nil
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodePreconditionFailedException, "") {
+		return fmt.Errorf("error updating Lex Intent (%s): intent was modified outside of Terraform, refresh and try again: %s", d.Id(), err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error updating Lex Intent (%s): %s", d.Id(), err)
+	}
+
+	if d.Get("create_version").(bool) {
+		if err := createLexIntentVersion(conn, d.Id(), d.Get("checksum").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsLexIntentRead(d, meta)
+}
+
+func resourceAwsLexIntentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := &lexmodelbuildingservice.DeleteIntentInput{
+		Name: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Lex Intent: %s", input)
+	// Bots referencing the intent may still be in the process of being deleted.
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteIntent(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") || isAWSErr(err, lexmodelbuildingservice.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex Intent (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func createLexIntentVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name, checksum string) error {
+	input := &lexmodelbuildingservice.CreateIntentVersionInput{
+		Checksum: aws.String(checksum),
+		Name:     aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Lex Intent version: %s", input)
+	if _, err := conn.CreateIntentVersion(input); err != nil {
+		return fmt.Errorf("error creating Lex Intent (%s) version: %s", name, err)
+	}
+
+	return nil
+}
+
+func expandLexIntentInput(d *schema.ResourceData) *lexmodelbuildingservice.PutIntentInput {
+	input := &lexmodelbuildingservice.PutIntentInput{
+		ConclusionStatement: expandLexStatement(d.Get("conclusion_statement").([]interface{})),
+		ConfirmationPrompt:  expandLexPrompt(d.Get("confirmation_prompt").([]interface{})),
+		Description:         aws.String(d.Get("description").(string)),
+		DialogCodeHook:      expandLexCodeHook(d.Get("dialog_code_hook").([]interface{})),
+		FollowUpPrompt:      expandLexFollowUpPrompt(d.Get("follow_up_prompt").([]interface{})),
+		FulfillmentActivity: expandLexFulfillmentActivity(d.Get("fulfillment_activity").([]interface{})),
+		RejectionStatement:  expandLexStatement(d.Get("rejection_statement").([]interface{})),
+		SampleUtterances:    expandStringSet(d.Get("sample_utterances").(*schema.Set)),
+		Slots:               expandLexSlots(d.Get("slot").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func expandLexFollowUpPrompt(l []interface{}) *lexmodelbuildingservice.FollowUpPrompt {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.FollowUpPrompt{
+		Prompt:             expandLexPrompt(m["prompt"].([]interface{})),
+		RejectionStatement: expandLexStatement(m["rejection_statement"].([]interface{})),
+	}
+}
+
+func flattenLexFollowUpPrompt(followUpPrompt *lexmodelbuildingservice.FollowUpPrompt) []interface{} {
+	if followUpPrompt == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"prompt":              flattenLexPrompt(followUpPrompt.Prompt),
+			"rejection_statement": flattenLexStatement(followUpPrompt.RejectionStatement),
+		},
+	}
+}
+
+func expandLexFulfillmentActivity(l []interface{}) *lexmodelbuildingservice.FulfillmentActivity {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.FulfillmentActivity{
+		CodeHook: expandLexCodeHook(m["code_hook"].([]interface{})),
+		Type:     aws.String(m["type"].(string)),
+	}
+}
+
+func flattenLexFulfillmentActivity(fulfillmentActivity *lexmodelbuildingservice.FulfillmentActivity) []interface{} {
+	if fulfillmentActivity == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"code_hook": flattenLexCodeHook(fulfillmentActivity.CodeHook),
+			"type":      aws.StringValue(fulfillmentActivity.Type),
+		},
+	}
+}
+
+func expandLexSlots(l []interface{}) []*lexmodelbuildingservice.Slot {
+	slots := make([]*lexmodelbuildingservice.Slot, 0, len(l))
+
+	for _, v := range l {
+		m := v.(map[string]interface{})
+
+		slot := &lexmodelbuildingservice.Slot{
+			Name:                   aws.String(m["name"].(string)),
+			Priority:               aws.Int64(int64(m["priority"].(int))),
+			SampleUtterances:       expandStringList(m["sample_utterances"].([]interface{})),
+			SlotConstraint:         aws.String(m["slot_constraint"].(string)),
+			SlotType:               aws.String(m["slot_type"].(string)),
+			ValueElicitationPrompt: expandLexPrompt(m["value_elicitation_prompt"].([]interface{})),
+		}
+
+		if v, ok := m["description"].(string); ok && v != "" {
+			slot.Description = aws.String(v)
+		}
+
+		if v, ok := m["response_card"].(string); ok && v != "" {
+			slot.ResponseCard = aws.String(v)
+		}
+
+		if v, ok := m["slot_type_version"].(string); ok && v != "" {
+			slot.SlotTypeVersion = aws.String(v)
+		}
+
+		slots = append(slots, slot)
+	}
+
+	return slots
+}
+
+func flattenLexSlots(slots []*lexmodelbuildingservice.Slot) []interface{} {
+	l := make([]interface{}, 0, len(slots))
+
+	for _, slot := range slots {
+		l = append(l, map[string]interface{}{
+			"description":              aws.StringValue(slot.Description),
+			"name":                     aws.StringValue(slot.Name),
+			"priority":                 int(aws.Int64Value(slot.Priority)),
+			"response_card":            aws.StringValue(slot.ResponseCard),
+			"sample_utterances":        flattenStringList(slot.SampleUtterances),
+			"slot_constraint":          aws.StringValue(slot.SlotConstraint),
+			"slot_type":                aws.StringValue(slot.SlotType),
+			"slot_type_version":        aws.StringValue(slot.SlotTypeVersion),
+			"value_elicitation_prompt": flattenLexPrompt(slot.ValueElicitationPrompt),
+		})
+	}
+
+	return l
+}
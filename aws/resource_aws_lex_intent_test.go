@@ -0,0 +1,350 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+)
+
+func init() {
+	addTestSweepers("aws_lex_intent", &resource.Sweeper{
+		Name: "aws_lex_intent",
+		F:    testSweepLexIntents,
+		Dependencies: []string{
+			"aws_lex_bot",
+		},
+	})
+}
+
+func testSweepLexIntents(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).lexmodelconn
+
+	var resources []*sweep.Resource
+	err = conn.GetIntentsPages(&lexmodelbuildingservice.GetIntentsInput{}, func(page *lexmodelbuildingservice.GetIntentsOutput, lastPage bool) bool {
+		for _, intent := range page.Intents {
+			name := aws.StringValue(intent.Name)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        name,
+				CreatedAt: aws.TimeValue(intent.CreatedDate),
+				Delete: func() error {
+					_, err := conn.DeleteIntent(&lexmodelbuildingservice.DeleteIntentInput{
+						Name: aws.String(name),
+					})
+					if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+						return nil
+					}
+					return err
+				},
+			})
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lex Intent sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Lex Intents: %s", err)
+	}
+
+	filter := sweep.Filter{
+		NamePrefixes: []string{"tf_acc_test"},
+	}
+
+	if err := testSweepResources(region, "aws_lex_intent", filter, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSLexIntent_basic(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	resourceName := "aws_lex_intent.test"
+	rName := testAccLexRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexIntentConfigBasic(rName, "I would like to order some flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttr(resourceName, "conclusion_statement.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_activity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_activity.0.type", lexmodelbuildingservice.FulfillmentActivityTypeReturnIntent),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slot.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsLexIntentConfigBasic(rName, "I would like to pick up flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexIntent_Slots(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	resourceName := "aws_lex_intent.test"
+	rName := testAccLexRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexIntentConfigSlots(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.0.max_attempts", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rejection_statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slot.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLexIntent_CreateVersion(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	resourceName := "aws_lex_intent.test"
+	rName := testAccLexRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexIntentConfigCreateVersion(rName, "I would like to order some flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAwsLexIntentConfigCreateVersion(rName, "I would like to pick up flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexIntent_disappears(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	resourceName := "aws_lex_intent.test"
+	rName := testAccLexRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexIntentConfigBasic(rName, "I would like to order some flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName, &intent),
+					testAccCheckAwsLexIntentDisappears(&intent),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexIntentExists(resourceName string, v *lexmodelbuildingservice.GetIntentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		output, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLexIntentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_intent" {
+			continue
+		}
+
+		_, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Intent (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsLexIntentDisappears(intent *lexmodelbuildingservice.GetIntentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		_, err := conn.DeleteIntent(&lexmodelbuildingservice.DeleteIntentInput{
+			Name: intent.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccAwsLexIntentConfigBasic(rName, utterance string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_intent" "test" {
+  name              = %[1]q
+  sample_utterances = [%[2]q]
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+}
+`, rName, utterance)
+}
+
+func testAccAwsLexIntentConfigCreateVersion(rName, utterance string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_intent" "test" {
+  name              = %[1]q
+  create_version    = true
+  sample_utterances = [%[2]q]
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+}
+`, rName, utterance)
+}
+
+func testAccAwsLexIntentConfigSlots(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  name           = %[1]q
+  create_version = true
+
+  enumeration_value {
+    value = "lilies"
+  }
+
+  enumeration_value {
+    value = "roses"
+  }
+}
+
+resource "aws_lex_intent" "test" {
+  name              = %[1]q
+  sample_utterances = ["I would like to order some {FlowerType}"]
+
+  confirmation_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "Okay, your {FlowerType} will be ready for pickup. Does this sound okay?"
+      content_type = "PlainText"
+    }
+  }
+
+  rejection_statement {
+    message {
+      content      = "Okay, I will not place your order."
+      content_type = "PlainText"
+    }
+  }
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+
+  slot {
+    name              = "FlowerType"
+    description       = "The type of flowers to pick up"
+    priority          = 1
+    sample_utterances = ["I would like to order {FlowerType}"]
+    slot_constraint   = "Required"
+    slot_type         = "${aws_lex_slot_type.test.name}"
+    slot_type_version = "${aws_lex_slot_type.test.version}"
+
+    value_elicitation_prompt {
+      max_attempts = 2
+
+      message {
+        content      = "What type of flowers would you like to order?"
+        content_type = "PlainText"
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,317 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexSlotType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexSlotTypeCreate,
+		Read:   resourceAwsLexSlotTypeRead,
+		Update: resourceAwsLexSlotTypeUpdate,
+		Delete: resourceAwsLexSlotTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"enumeration_value": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"synonyms": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 140),
+							},
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(lexNameRegexp), ""),
+				),
+			},
+			"value_selection_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue,
+					lexmodelbuildingservice.SlotValueSelectionStrategyTopResolution,
+				}, false),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexSlotTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := &lexmodelbuildingservice.PutSlotTypeInput{
+		Description:            aws.String(d.Get("description").(string)),
+		EnumerationValues:      expandLexEnumerationValues(d.Get("enumeration_value").(*schema.Set).List()),
+		Name:                   aws.String(name),
+		ValueSelectionStrategy: aws.String(d.Get("value_selection_strategy").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Lex Slot Type: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		output, err := conn.PutSlotType(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		d.Set("checksum", output.Checksum)
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Lex Slot Type (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if d.Get("create_version").(bool) {
+		if err := createLexSlotTypeVersion(conn, d.Id(), d.Get("checksum").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsLexSlotTypeRead(d, meta)
+}
+
+func resourceAwsLexSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	output, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+		Name:    aws.String(d.Id()),
+		Version: aws.String(lexVersionLatest),
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lex Slot Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Slot Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+
+	if err := d.Set("enumeration_value", flattenLexEnumerationValues(output.EnumerationValues)); err != nil {
+		return fmt.Errorf("error setting enumeration_value: %s", err)
+	}
+
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", output.Name)
+	d.Set("value_selection_strategy", output.ValueSelectionStrategy)
+
+	version := lexVersionLatest
+	if d.Get("create_version").(bool) {
+		version, err = getLexSlotTypeLatestVersion(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading Lex Slot Type (%s) versions: %s", d.Id(), err)
+		}
+	}
+	d.Set("version", version)
+
+	return nil
+}
+
+func resourceAwsLexSlotTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	// The checksum of the slot type last read guards against overwriting
+	// changes made outside of Terraform since then.
+	input := &lexmodelbuildingservice.PutSlotTypeInput{
+		Checksum:               aws.String(d.Get("checksum").(string)),
+		Description:            aws.String(d.Get("description").(string)),
+		EnumerationValues:      expandLexEnumerationValues(d.Get("enumeration_value").(*schema.Set).List()),
+		Name:                   aws.String(d.Id()),
+		ValueSelectionStrategy: aws.String(d.Get("value_selection_strategy").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Lex Slot Type: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		output, err := conn.PutSlotType(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		d.Set("checksum", output.Checksum)
+
+		return nil
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodePreconditionFailedException, "") {
+		return fmt.Errorf("error updating Lex Slot Type (%s): slot type was modified outside of Terraform, refresh and try again: %s", d.Id(), err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error updating Lex Slot Type (%s): %s", d.Id(), err)
+	}
+
+	if d.Get("create_version").(bool) {
+		if err := createLexSlotTypeVersion(conn, d.Id(), d.Get("checksum").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsLexSlotTypeRead(d, meta)
+}
+
+func resourceAwsLexSlotTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := &lexmodelbuildingservice.DeleteSlotTypeInput{
+		Name: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Lex Slot Type: %s", input)
+	// Intents referencing the slot type may still be in the process of being deleted.
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteSlotType(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") || isAWSErr(err, lexmodelbuildingservice.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex Slot Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func createLexSlotTypeVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name, checksum string) error {
+	input := &lexmodelbuildingservice.CreateSlotTypeVersionInput{
+		Checksum: aws.String(checksum),
+		Name:     aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Lex Slot Type version: %s", input)
+	if _, err := conn.CreateSlotTypeVersion(input); err != nil {
+		return fmt.Errorf("error creating Lex Slot Type (%s) version: %s", name, err)
+	}
+
+	return nil
+}
+
+func expandLexEnumerationValues(l []interface{}) []*lexmodelbuildingservice.EnumerationValue {
+	values := make([]*lexmodelbuildingservice.EnumerationValue, 0, len(l))
+
+	for _, v := range l {
+		m := v.(map[string]interface{})
+
+		value := &lexmodelbuildingservice.EnumerationValue{
+			Value: aws.String(m["value"].(string)),
+		}
+
+		if v, ok := m["synonyms"].(*schema.Set); ok && v.Len() > 0 {
+			value.Synonyms = expandStringSet(v)
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
+
+func flattenLexEnumerationValues(values []*lexmodelbuildingservice.EnumerationValue) []interface{} {
+	l := make([]interface{}, 0, len(values))
+
+	for _, value := range values {
+		l = append(l, map[string]interface{}{
+			"synonyms": flattenStringSet(value.Synonyms),
+			"value":    aws.StringValue(value.Value),
+		})
+	}
+
+	return l
+}
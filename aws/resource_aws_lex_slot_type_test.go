@@ -0,0 +1,269 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+)
+
+func init() {
+	addTestSweepers("aws_lex_slot_type", &resource.Sweeper{
+		Name: "aws_lex_slot_type",
+		F:    testSweepLexSlotTypes,
+		Dependencies: []string{
+			"aws_lex_intent",
+		},
+	})
+}
+
+func testSweepLexSlotTypes(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).lexmodelconn
+
+	var resources []*sweep.Resource
+	err = conn.GetSlotTypesPages(&lexmodelbuildingservice.GetSlotTypesInput{}, func(page *lexmodelbuildingservice.GetSlotTypesOutput, lastPage bool) bool {
+		for _, slotType := range page.SlotTypes {
+			name := aws.StringValue(slotType.Name)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        name,
+				CreatedAt: aws.TimeValue(slotType.CreatedDate),
+				Delete: func() error {
+					_, err := conn.DeleteSlotType(&lexmodelbuildingservice.DeleteSlotTypeInput{
+						Name: aws.String(name),
+					})
+					if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+						return nil
+					}
+					return err
+				},
+			})
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lex Slot Type sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Lex Slot Types: %s", err)
+	}
+
+	filter := sweep.Filter{
+		NamePrefixes: []string{"tf_acc_test"},
+	}
+
+	if err := testSweepResources(region, "aws_lex_slot_type", filter, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSLexSlotType_basic(t *testing.T) {
+	var slotType lexmodelbuildingservice.GetSlotTypeOutput
+	resourceName := "aws_lex_slot_type.test"
+	rName := testAccLexRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexSlotTypeConfig(rName, "lilies", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Types of flowers to order"),
+					resource.TestCheckResourceAttr(resourceName, "enumeration_value.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_strategy", lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsLexSlotTypeConfig(rName, "tulips", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "enumeration_value.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexSlotType_CreateVersion(t *testing.T) {
+	var slotType lexmodelbuildingservice.GetSlotTypeOutput
+	resourceName := "aws_lex_slot_type.test"
+	rName := testAccLexRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexSlotTypeConfig(rName, "lilies", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAwsLexSlotTypeConfig(rName, "tulips", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexSlotType_disappears(t *testing.T) {
+	var slotType lexmodelbuildingservice.GetSlotTypeOutput
+	resourceName := "aws_lex_slot_type.test"
+	rName := testAccLexRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexSlotTypeConfig(rName, "lilies", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName, &slotType),
+					testAccCheckAwsLexSlotTypeDisappears(&slotType),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSLex(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	_, err := conn.GetBots(&lexmodelbuildingservice.GetBotsInput{})
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+// testAccLexRandomName returns a name that is valid for Lex bots, intents and
+// slot types, which may only contain letters and underscores.
+func testAccLexRandomName() string {
+	return fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+}
+
+func testAccCheckAwsLexSlotTypeExists(resourceName string, v *lexmodelbuildingservice.GetSlotTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		output, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLexSlotTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_slot_type" {
+			continue
+		}
+
+		_, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Slot Type (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsLexSlotTypeDisappears(slotType *lexmodelbuildingservice.GetSlotTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		_, err := conn.DeleteSlotType(&lexmodelbuildingservice.DeleteSlotTypeInput{
+			Name: slotType.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccAwsLexSlotTypeConfig(rName, value string, createVersion bool) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  name           = %[1]q
+  description    = "Types of flowers to order"
+  create_version = %[3]t
+
+  enumeration_value {
+    value    = %[2]q
+    synonyms = ["%[2]s_synonym"]
+  }
+
+  enumeration_value {
+    value = "roses"
+  }
+}
+`, rName, value, createVersion)
+}
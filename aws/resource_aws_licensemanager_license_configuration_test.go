@@ -9,10 +9,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_licensemanager_license_configuration", &resource.Sweeper{
+	addTestSweepers("aws_licensemanager_license_configuration", &resource.Sweeper{
 		Name: "aws_licensemanager_license_configuration",
 		F:    testSweepLicenseManagerLicenseConfigurations,
 	})
@@ -35,25 +36,27 @@ func testSweepLicenseManagerLicenseConfigurations(region string) error {
 		return fmt.Errorf("Error retrieving License Manager license configurations: %s", err)
 	}
 
-	if len(resp.LicenseConfigurations) == 0 {
-		log.Print("[DEBUG] No License Manager license configurations to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, lc := range resp.LicenseConfigurations {
 		id := aws.StringValue(lc.LicenseConfigurationArn)
 
-		log.Printf("[INFO] Deleting License Manager license configuration: %s", id)
+		resources = append(resources, &sweep.Resource{
+			ID:   id,
+			Name: aws.StringValue(lc.Name),
+			Delete: func() error {
+				opts := &licensemanager.DeleteLicenseConfigurationInput{
+					LicenseConfigurationArn: aws.String(id),
+				}
 
-		opts := &licensemanager.DeleteLicenseConfigurationInput{
-			LicenseConfigurationArn: aws.String(id),
-		}
-
-		_, err := conn.DeleteLicenseConfiguration(opts)
+				_, err := conn.DeleteLicenseConfiguration(opts)
+				return err
+			},
+		})
+	}
 
-		if err != nil {
-			log.Printf("[ERROR] Error deleting License Manager license configuration (%s): %s", id, err)
-		}
+	if err := testSweepResources(region, "aws_licensemanager_license_configuration", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
 	}
 
 	return nil
@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	addTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    testSweepLightsailStaticIps,
 	})
@@ -29,6 +30,7 @@ func testSweepLightsailStaticIps(region string) error {
 	conn := client.(*AWSClient).lightsailconn
 
 	input := &lightsail.GetStaticIpsInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.GetStaticIps(input)
@@ -40,21 +42,19 @@ func testSweepLightsailStaticIps(region string) error {
 			return fmt.Errorf("Error retrieving Lightsail Static IPs: %s", err)
 		}
 
-		if len(output.StaticIps) == 0 {
-			log.Print("[DEBUG] No Lightsail Static IPs to sweep")
-			return nil
-		}
-
 		for _, staticIp := range output.StaticIps {
 			name := aws.StringValue(staticIp.Name)
 
-			log.Printf("[INFO] Deleting Lightsail Static IP %s", name)
-			_, err := conn.ReleaseStaticIp(&lightsail.ReleaseStaticIpInput{
-				StaticIpName: aws.String(name),
+			resources = append(resources, &sweep.Resource{
+				ID:        name,
+				CreatedAt: aws.TimeValue(staticIp.CreatedAt),
+				Delete: func() error {
+					_, err := conn.ReleaseStaticIp(&lightsail.ReleaseStaticIpInput{
+						StaticIpName: aws.String(name),
+					})
+					return err
+				},
 			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Lightsail Static IP %s: %s", name, err)
-			}
 		}
 
 		if output.NextPageToken == nil {
@@ -63,7 +63,7 @@ func testSweepLightsailStaticIps(region string) error {
 		input.PageToken = output.NextPageToken
 	}
 
-	return nil
+	return testSweepResources(region, "aws_lightsail_static_ip", sweep.Filter{}, resources)
 }
 
 func TestAccAWSLightsailStaticIp_basic(t *testing.T) {
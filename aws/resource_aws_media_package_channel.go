@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsMediaPackageChannel() *schema.Resource {
@@ -80,7 +81,7 @@ func resourceAwsMediaPackageChannelCreate(d *schema.ResourceData, meta interface
 	}
 
 	if attr, ok := d.GetOk("tags"); ok {
-		input.Tags = tagsFromMapGeneric(attr.(map[string]interface{}))
+		input.Tags = keyvaluetags.New(attr.(map[string]interface{})).IgnoreAws().MediapackageTags()
 	}
 
 	_, err := conn.CreateChannel(input)
@@ -110,7 +111,7 @@ func resourceAwsMediaPackageChannelRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("error setting hls_ingest: %s", err)
 	}
 
-	if err := d.Set("tags", tagsToMapGeneric(resp.Tags)); err != nil {
+	if err := d.Set("tags", keyvaluetags.MediapackageKeyValueTags(resp.Tags).IgnoreAws().Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
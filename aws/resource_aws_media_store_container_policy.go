@@ -27,7 +27,7 @@ func resourceAwsMediaStoreContainerPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validateIAMPolicyJson,
+				ValidateFunc:     validateIAMResourcePolicy(0),
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 		},
@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
+	addTestSweepers("aws_mq_broker", &resource.Sweeper{
 		Name: "aws_mq_broker",
 		F:    testSweepMqBrokers,
 	})
@@ -88,27 +89,28 @@ func testSweepMqBrokers(region string) error {
 		return fmt.Errorf("Error listing MQ brokers: %s", err)
 	}
 
-	if len(resp.BrokerSummaries) == 0 {
-		log.Print("[DEBUG] No MQ brokers found to sweep")
-		return nil
-	}
-	log.Printf("[DEBUG] %d MQ brokers found", len(resp.BrokerSummaries))
+	var resources []*sweep.Resource
 
 	for _, bs := range resp.BrokerSummaries {
-		log.Printf("[INFO] Deleting MQ broker %s", *bs.BrokerId)
-		_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
-			BrokerId: bs.BrokerId,
+		id := aws.StringValue(bs.BrokerId)
+
+		resources = append(resources, &sweep.Resource{
+			ID:        id,
+			Name:      aws.StringValue(bs.BrokerName),
+			CreatedAt: aws.TimeValue(bs.Created),
+			Delete: func() error {
+				_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
+					BrokerId: aws.String(id),
+				})
+				if err != nil {
+					return err
+				}
+				return waitForMqBrokerDeletion(conn, id)
+			},
 		})
-		if err != nil {
-			return err
-		}
-		err = waitForMqBrokerDeletion(conn, *bs.BrokerId)
-		if err != nil {
-			return err
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_mq_broker", sweep.Filter{}, resources)
 }
 
 func TestDiffAwsMqBrokerUsers(t *testing.T) {
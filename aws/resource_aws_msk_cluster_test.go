@@ -12,10 +12,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
+	addTestSweepers("aws_msk_cluster", &resource.Sweeper{
 		Name: "aws_msk_cluster",
 		F:    testSweepMskClusters,
 	})
@@ -38,20 +40,31 @@ func testSweepMskClusters(region string) error {
 		return fmt.Errorf("Error retrieving MSK clusters: %s", err)
 	}
 
+	var resources []*sweep.Resource
+
 	for _, cluster := range out.ClusterInfoList {
-		log.Printf("[INFO] Deleting Msk cluster: %s", *cluster.ClusterName)
-		_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
-			ClusterArn: cluster.ClusterArn,
+		arn := aws.StringValue(cluster.ClusterArn)
+
+		resources = append(resources, &sweep.Resource{
+			ID:        arn,
+			Name:      aws.StringValue(cluster.ClusterName),
+			CreatedAt: aws.TimeValue(cluster.CreationTime),
+			Delete: func() error {
+				_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
+					ClusterArn: aws.String(arn),
+				})
+				if err != nil {
+					return err
+				}
+				return resourceAwsMskClusterDeleteWaiter(conn, arn)
+			},
 		})
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete MSK cluster %s: %s", *cluster.ClusterName, err)
-			continue
-		}
-		err = resourceAwsMskClusterDeleteWaiter(conn, *cluster.ClusterArn)
-		if err != nil {
-			log.Printf("[ERROR] failed to wait for deletion of MSK cluster %s: %s", *cluster.ClusterName, err)
-		}
 	}
+
+	if err := testSweepResources(region, "aws_msk_cluster", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -295,7 +308,7 @@ func testAccLoadMskTags(cluster *kafka.ClusterInfo, td *kafka.ListTagsForResourc
 
 func testAccCheckMskClusterTags(td *kafka.ListTagsForResourceOutput, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMapMskCluster(td.Tags)
+		m := keyvaluetags.KafkaKeyValueTags(td.Tags).IgnoreAws().Map()
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s - (found tags %v)", key, m)
@@ -11,10 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	addTestSweepers("aws_nat_gateway", &resource.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    testSweepNatGateways,
 	})
@@ -37,23 +39,25 @@ func testSweepNatGateways(region string) error {
 		return fmt.Errorf("Error describing NAT Gateways: %s", err)
 	}
 
-	if len(resp.NatGateways) == 0 {
-		log.Print("[DEBUG] No AWS NAT Gateways to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, natGateway := range resp.NatGateways {
-		_, err := conn.DeleteNatGateway(&ec2.DeleteNatGatewayInput{
-			NatGatewayId: natGateway.NatGatewayId,
+		id := aws.StringValue(natGateway.NatGatewayId)
+
+		resources = append(resources, &sweep.Resource{
+			ID:        id,
+			Tags:      keyvaluetags.Ec2KeyValueTags(natGateway.Tags),
+			CreatedAt: aws.TimeValue(natGateway.CreateTime),
+			Delete: func() error {
+				_, err := conn.DeleteNatGateway(&ec2.DeleteNatGatewayInput{
+					NatGatewayId: aws.String(id),
+				})
+				return err
+			},
 		})
-		if err != nil {
-			return fmt.Errorf(
-				"Error deleting NAT Gateway (%s): %s",
-				*natGateway.NatGatewayId, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_nat_gateway", sweep.Filter{}, resources)
 }
 
 func TestAccAWSNatGateway_basic(t *testing.T) {
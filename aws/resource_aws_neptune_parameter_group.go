@@ -12,6 +12,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // We can only modify 20 parameters at a time, so walk them until
@@ -168,7 +169,7 @@ func resourceAwsNeptuneParameterGroupRead(d *schema.ResourceData, meta interface
 		log.Printf("[DEBUG] Error retrieving tags for ARN: %s", arn)
 	}
 
-	if err := d.Set("tags", tagsToMapNeptune(resp.TagList)); err != nil {
+	if err := d.Set("tags", keyvaluetags.NeptuneKeyValueTags(resp.TagList).IgnoreAws().Map()); err != nil {
 		return fmt.Errorf("error setting neptune tags: %s", err)
 	}
 
@@ -256,7 +257,7 @@ func resourceAwsNeptuneParameterGroupUpdate(d *schema.ResourceData, meta interfa
 		d.SetPartial("parameter")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := setTagsNeptune(conn, d, d.Get("arn").(string))
 		if err != nil {
 			return fmt.Errorf("error setting Neptune Parameter Group %q tags: %s", d.Id(), err)
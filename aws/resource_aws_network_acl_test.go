@@ -11,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	addTestSweepers("aws_network_acl", &resource.Sweeper{
 		Name: "aws_network_acl",
 		F:    testSweepNetworkAcls,
 	})
@@ -37,69 +39,70 @@ func testSweepNetworkAcls(region string) error {
 		return fmt.Errorf("Error describing Network ACLs: %s", err)
 	}
 
-	if len(resp.NetworkAcls) == 0 {
-		log.Print("[DEBUG] No Network ACLs to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, nacl := range resp.NetworkAcls {
-		// Delete rules first
-		for _, entry := range nacl.Entries {
-			// These are the rule numbers for IPv4 and IPv6 "ALL traffic" rules which cannot be deleted
-			if aws.Int64Value(entry.RuleNumber) == 32767 || aws.Int64Value(entry.RuleNumber) == 32768 {
-				log.Printf("[DEBUG] Skipping Network ACL rule: %q / %d", *nacl.NetworkAclId, *entry.RuleNumber)
-				continue
-			}
-
-			log.Printf("[INFO] Deleting Network ACL rule: %q / %d", *nacl.NetworkAclId, *entry.RuleNumber)
-			_, err := conn.DeleteNetworkAclEntry(&ec2.DeleteNetworkAclEntryInput{
-				NetworkAclId: nacl.NetworkAclId,
-				Egress:       entry.Egress,
-				RuleNumber:   entry.RuleNumber,
-			})
-			if err != nil {
-				return fmt.Errorf(
-					"Error deleting Network ACL rule (%s / %d): %s",
-					*nacl.NetworkAclId, *entry.RuleNumber, err)
-			}
+		// Default Network ACLs will be deleted along with VPC
+		if aws.BoolValue(nacl.IsDefault) {
+			log.Printf("[DEBUG] Skipping default Network ACL: %q", aws.StringValue(nacl.NetworkAclId))
+			continue
 		}
 
-		// Disassociate subnets
-		log.Printf("[DEBUG] Found %d Network ACL associations for %q", len(nacl.Associations), *nacl.NetworkAclId)
-		for _, a := range nacl.Associations {
-			log.Printf("[DEBUG] Replacing subnet associations for Network ACL %q", *nacl.NetworkAclId)
-			defaultAcl, err := getDefaultNetworkAcl(*nacl.VpcId, conn)
-			if err != nil {
-				return fmt.Errorf("Failed to find default Network ACL for VPC %q", *nacl.VpcId)
-			}
-			_, err = conn.ReplaceNetworkAclAssociation(&ec2.ReplaceNetworkAclAssociationInput{
-				NetworkAclId:  defaultAcl.NetworkAclId,
-				AssociationId: a.NetworkAclAssociationId,
-			})
-			if err != nil {
-				return fmt.Errorf("Failed to replace subnet association for Network ACL %q: %s",
-					*nacl.NetworkAclId, err)
-			}
-		}
+		nacl := nacl
 
-		// Default Network ACLs will be deleted along with VPC
-		if *nacl.IsDefault {
-			log.Printf("[DEBUG] Skipping default Network ACL: %q", *nacl.NetworkAclId)
+		resources = append(resources, &sweep.Resource{
+			ID:   aws.StringValue(nacl.NetworkAclId),
+			Tags: keyvaluetags.Ec2KeyValueTags(nacl.Tags),
+			Delete: func() error {
+				return testSweepNetworkAcl(conn, nacl)
+			},
+		})
+	}
+
+	return testSweepResources(region, "aws_network_acl", sweep.Filter{}, resources)
+}
+
+func testSweepNetworkAcl(conn *ec2.EC2, nacl *ec2.NetworkAcl) error {
+	// Delete rules first
+	for _, entry := range nacl.Entries {
+		// These are the rule numbers for IPv4 and IPv6 "ALL traffic" rules which cannot be deleted
+		if aws.Int64Value(entry.RuleNumber) == 32767 || aws.Int64Value(entry.RuleNumber) == 32768 {
+			log.Printf("[DEBUG] Skipping Network ACL rule: %q / %d", *nacl.NetworkAclId, *entry.RuleNumber)
 			continue
 		}
 
-		log.Printf("[INFO] Deleting Network ACL: %q", *nacl.NetworkAclId)
-		_, err := conn.DeleteNetworkAcl(&ec2.DeleteNetworkAclInput{
+		log.Printf("[INFO] Deleting Network ACL rule: %q / %d", *nacl.NetworkAclId, *entry.RuleNumber)
+		_, err := conn.DeleteNetworkAclEntry(&ec2.DeleteNetworkAclEntryInput{
 			NetworkAclId: nacl.NetworkAclId,
+			Egress:       entry.Egress,
+			RuleNumber:   entry.RuleNumber,
 		})
 		if err != nil {
-			return fmt.Errorf(
-				"Error deleting Network ACL (%s): %s",
-				*nacl.NetworkAclId, err)
+			return fmt.Errorf("Error deleting Network ACL rule (%d): %s", *entry.RuleNumber, err)
 		}
 	}
 
-	return nil
+	// Disassociate subnets
+	log.Printf("[DEBUG] Found %d Network ACL associations for %q", len(nacl.Associations), *nacl.NetworkAclId)
+	for _, a := range nacl.Associations {
+		log.Printf("[DEBUG] Replacing subnet associations for Network ACL %q", *nacl.NetworkAclId)
+		defaultAcl, err := getDefaultNetworkAcl(*nacl.VpcId, conn)
+		if err != nil {
+			return fmt.Errorf("Failed to find default Network ACL for VPC %q", *nacl.VpcId)
+		}
+		_, err = conn.ReplaceNetworkAclAssociation(&ec2.ReplaceNetworkAclAssociationInput{
+			NetworkAclId:  defaultAcl.NetworkAclId,
+			AssociationId: a.NetworkAclAssociationId,
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to replace subnet association: %s", err)
+		}
+	}
+
+	_, err := conn.DeleteNetworkAcl(&ec2.DeleteNetworkAclInput{
+		NetworkAclId: nacl.NetworkAclId,
+	})
+	return err
 }
 
 func TestAccAWSNetworkAcl_importBasic(t *testing.T) {
@@ -10,10 +10,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	addTestSweepers("aws_network_interface", &resource.Sweeper{
 		Name: "aws_network_interface",
 		F:    testSweepEc2NetworkInterfaces,
 		Dependencies: []string{
@@ -29,6 +31,8 @@ func testSweepEc2NetworkInterfaces(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 
+	var resources []*sweep.Resource
+
 	err = conn.DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{}, func(page *ec2.DescribeNetworkInterfacesOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -42,16 +46,18 @@ func testSweepEc2NetworkInterfaces(region string) error {
 				continue
 			}
 
-			input := &ec2.DeleteNetworkInterfaceInput{
-				NetworkInterfaceId: aws.String(id),
-			}
-
-			log.Printf("[INFO] Deleting EC2 Network Interface: %s", id)
-			_, err := conn.DeleteNetworkInterface(input)
-
-			if err != nil {
-				log.Printf("[ERROR] Error deleting EC2 Network Interface (%s): %s", id, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:   id,
+				Tags: keyvaluetags.Ec2KeyValueTags(networkInterface.TagSet),
+				Delete: func() error {
+					input := &ec2.DeleteNetworkInterfaceInput{
+						NetworkInterfaceId: aws.String(id),
+					}
+
+					_, err := conn.DeleteNetworkInterface(input)
+					return err
+				},
+			})
 		}
 
 		return !isLast
@@ -64,6 +70,10 @@ func testSweepEc2NetworkInterfaces(region string) error {
 		return fmt.Errorf("error retrieving EC2 Network Interfaces: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_network_interface", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
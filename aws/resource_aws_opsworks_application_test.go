@@ -62,6 +62,11 @@ func TestAccAWSOpsworksApplication(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:      "aws_opsworks_application.tf-acc-app",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAwsOpsworksApplicationUpdate(name),
 				Check: resource.ComposeTestCheckFunc(
@@ -1,7 +1,9 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +20,9 @@ func resourceAwsOpsworksPermission() *schema.Resource {
 		Update: resourceAwsOpsworksSetPermission,
 		Delete: resourceAwsOpsworksPermissionDelete,
 		Read:   resourceAwsOpsworksPermissionRead,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsOpsworksPermissionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"allow_ssh": {
@@ -141,3 +146,28 @@ func resourceAwsOpsworksSetPermission(d *schema.ResourceData, meta interface{})
 
 	return resourceAwsOpsworksPermissionRead(d, meta)
 }
+
+func resourceAwsOpsworksPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	userArn, stackID, err := opsworksParseStackScopedId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("user_arn", userArn)
+	d.Set("stack_id", stackID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// opsworksStackScopedIdRegexp matches the IDs of OpsWorks permissions and RDS
+// DB instance registrations, an ARN directly followed by a stack ID.
+var opsworksStackScopedIdRegexp = regexp.MustCompile(`^(arn:.+)([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})$`)
+
+func opsworksParseStackScopedId(id string) (string, string, error) {
+	matches := opsworksStackScopedIdRegexp.FindStringSubmatch(id)
+	if matches == nil {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected <arn><stack-id>", id)
+	}
+
+	return matches[1], matches[2], nil
+}
@@ -12,6 +12,54 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestOpsworksParseStackScopedId(t *testing.T) {
+	testCases := []struct {
+		ID          string
+		Arn         string
+		StackID     string
+		ExpectError bool
+	}{
+		{
+			ID:      "arn:aws:iam::123456789012:user/example7a33a5e3-3c3b-4b5c-9c3e-7d2a1b9c0f11",
+			Arn:     "arn:aws:iam::123456789012:user/example",
+			StackID: "7a33a5e3-3c3b-4b5c-9c3e-7d2a1b9c0f11",
+		},
+		{
+			ID:      "arn:aws:rds:us-east-1:123456789012:db:example7a33a5e3-3c3b-4b5c-9c3e-7d2a1b9c0f11",
+			Arn:     "arn:aws:rds:us-east-1:123456789012:db:example",
+			StackID: "7a33a5e3-3c3b-4b5c-9c3e-7d2a1b9c0f11",
+		},
+		{
+			ID:          "arn:aws:iam::123456789012:user/example",
+			ExpectError: true,
+		},
+		{
+			ID:          "7a33a5e3-3c3b-4b5c-9c3e-7d2a1b9c0f11",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		arn, stackID, err := opsworksParseStackScopedId(tc.ID)
+
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("%s: expected error", tc.ID)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.ID, err)
+			continue
+		}
+
+		if arn != tc.Arn || stackID != tc.StackID {
+			t.Errorf("%s: expected (%s, %s), got (%s, %s)", tc.ID, tc.Arn, tc.StackID, arn, stackID)
+		}
+	}
+}
+
 func TestAccAWSOpsworksPermission(t *testing.T) {
 	sName := fmt.Sprintf("tf-ops-perm-%d", acctest.RandInt())
 	var opsperm opsworks.Permission
@@ -88,6 +136,11 @@ func TestAccAWSOpsworksPermission(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:      "aws_opsworks_permission.tf-acc-perm",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
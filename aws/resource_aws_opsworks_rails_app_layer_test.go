@@ -44,6 +44,11 @@ func TestAccAWSOpsworksRailsAppLayer(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:      "aws_opsworks_rails_app_layer.tf-acc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -18,6 +18,9 @@ func resourceAwsOpsworksRdsDbInstance() *schema.Resource {
 		Update: resourceAwsOpsworksRdsDbInstanceUpdate,
 		Delete: resourceAwsOpsworksRdsDbInstanceDeregister,
 		Read:   resourceAwsOpsworksRdsDbInstanceRead,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsOpsworksRdsDbInstanceImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"stack_id": {
@@ -161,6 +164,18 @@ func resourceAwsOpsworksRdsDbInstanceRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceAwsOpsworksRdsDbInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	rdsDbInstanceArn, stackID, err := opsworksParseStackScopedId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("rds_db_instance_arn", rdsDbInstanceArn)
+	d.Set("stack_id", stackID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsOpsworksRdsDbInstanceRegister(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).opsworksconn
 
@@ -64,6 +64,12 @@ func TestAccAWSOpsworksRdsDbInstance(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:            "aws_opsworks_rds_db_instance.tf-acc-opsworks-db",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"db_password"},
+			},
 		},
 	})
 }
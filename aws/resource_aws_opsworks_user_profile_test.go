@@ -52,6 +52,11 @@ func TestAccAWSOpsworksUserProfile(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:      "aws_opsworks_user_profile.user",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -19,7 +20,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
+	addTestSweepers("aws_rds_cluster", &resource.Sweeper{
 		Name: "aws_rds_cluster",
 		F:    testSweepRdsClusters,
 		Dependencies: []string{
@@ -37,53 +38,61 @@ func testSweepRdsClusters(region string) error {
 
 	conn := client.(*AWSClient).rdsconn
 	input := &rds.DescribeDBClustersInput{}
+	var resources []*sweep.Resource
 
 	err = conn.DescribeDBClustersPages(input, func(out *rds.DescribeDBClustersOutput, lastPage bool) bool {
 		for _, cluster := range out.DBClusters {
 			id := aws.StringValue(cluster.DBClusterIdentifier)
+			arn := aws.StringValue(cluster.DBClusterArn)
+			engineMode := aws.StringValue(cluster.EngineMode)
+
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				CreatedAt: aws.TimeValue(cluster.ClusterCreateTime),
+				Delete: func() error {
+					// Automatically remove from global cluster to bypass this error on deletion:
+					// InvalidDBClusterStateFault: This cluster is a part of a global cluster, please remove it from globalcluster first
+					if engineMode == "global" {
+						globalCluster, err := rdsDescribeGlobalClusterFromDbClusterARN(conn, arn)
+
+						if err != nil {
+							log.Printf("[ERROR] Failure reading RDS Global Cluster information for DB Cluster (%s): %s", id, err)
+						}
+
+						if globalCluster != nil {
+							globalClusterID := aws.StringValue(globalCluster.GlobalClusterIdentifier)
+							input := &rds.RemoveFromGlobalClusterInput{
+								DbClusterIdentifier:     aws.String(arn),
+								GlobalClusterIdentifier: aws.String(globalClusterID),
+							}
+
+							log.Printf("[INFO] Removing RDS Cluster (%s) from RDS Global Cluster: %s", id, globalClusterID)
+							_, err = conn.RemoveFromGlobalCluster(input)
+
+							if err != nil {
+								log.Printf("[ERROR] Failure removing RDS Cluster (%s) from RDS Global Cluster (%s): %s", id, globalClusterID, err)
+							}
+						}
+					}
 
-			// Automatically remove from global cluster to bypass this error on deletion:
-			// InvalidDBClusterStateFault: This cluster is a part of a global cluster, please remove it from globalcluster first
-			if aws.StringValue(cluster.EngineMode) == "global" {
-				globalCluster, err := rdsDescribeGlobalClusterFromDbClusterARN(conn, aws.StringValue(cluster.DBClusterArn))
-
-				if err != nil {
-					log.Printf("[ERROR] Failure reading RDS Global Cluster information for DB Cluster (%s): %s", id, err)
-				}
-
-				if globalCluster != nil {
-					globalClusterID := aws.StringValue(globalCluster.GlobalClusterIdentifier)
-					input := &rds.RemoveFromGlobalClusterInput{
-						DbClusterIdentifier:     cluster.DBClusterArn,
-						GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
+					input := &rds.DeleteDBClusterInput{
+						DBClusterIdentifier: aws.String(id),
+						SkipFinalSnapshot:   aws.Bool(true),
 					}
 
-					log.Printf("[INFO] Removing RDS Cluster (%s) from RDS Global Cluster: %s", id, globalClusterID)
-					_, err = conn.RemoveFromGlobalCluster(input)
+					_, err := conn.DeleteDBCluster(input)
 
 					if err != nil {
-						log.Printf("[ERROR] Failure removing RDS Cluster (%s) from RDS Global Cluster (%s): %s", id, globalClusterID, err)
+						return err
 					}
-				}
-			}
-
-			input := &rds.DeleteDBClusterInput{
-				DBClusterIdentifier: cluster.DBClusterIdentifier,
-				SkipFinalSnapshot:   aws.Bool(true),
-			}
-
-			log.Printf("[INFO] Deleting RDS DB Cluster: %s", id)
-
-			_, err := conn.DeleteDBCluster(input)
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete RDS DB Cluster (%s): %s", id, err)
-				continue
-			}
+					if err := waitForRDSClusterDeletion(conn, id, 40*time.Minute); err != nil {
+						return fmt.Errorf("error waiting for deletion: %s", err)
+					}
 
-			if err := waitForRDSClusterDeletion(conn, id, 40*time.Minute); err != nil {
-				log.Printf("[ERROR] Failure while waiting for RDS DB Cluster (%s) to be deleted: %s", id, err)
-			}
+					return nil
+				},
+			})
 		}
 		return !lastPage
 	})
@@ -97,6 +106,10 @@ func testSweepRdsClusters(region string) error {
 		return fmt.Errorf("error retrieving RDS DB Clusters: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_rds_cluster", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
+	addTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
 		Name: "aws_rds_global_cluster",
 		F:    testSweepRdsGlobalClusters,
 		Dependencies: []string{
@@ -33,26 +34,32 @@ func testSweepRdsGlobalClusters(region string) error {
 
 	conn := client.(*AWSClient).rdsconn
 	input := &rds.DescribeGlobalClustersInput{}
+	var resources []*sweep.Resource
 
 	err = conn.DescribeGlobalClustersPages(input, func(out *rds.DescribeGlobalClustersOutput, lastPage bool) bool {
 		for _, globalCluster := range out.GlobalClusters {
 			id := aws.StringValue(globalCluster.GlobalClusterIdentifier)
-			input := &rds.DeleteGlobalClusterInput{
-				GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
-			}
 
-			log.Printf("[INFO] Deleting RDS Global Cluster: %s", id)
+			resources = append(resources, &sweep.Resource{
+				ID: id,
+				Delete: func() error {
+					input := &rds.DeleteGlobalClusterInput{
+						GlobalClusterIdentifier: aws.String(id),
+					}
 
-			_, err := conn.DeleteGlobalCluster(input)
+					_, err := conn.DeleteGlobalCluster(input)
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete RDS Global Cluster (%s): %s", id, err)
-				continue
-			}
+					if err != nil {
+						return err
+					}
 
-			if err := waitForRdsGlobalClusterDeletion(conn, id); err != nil {
-				log.Printf("[ERROR] Failure while waiting for RDS Global Cluster (%s) to be deleted: %s", id, err)
-			}
+					if err := waitForRdsGlobalClusterDeletion(conn, id); err != nil {
+						return fmt.Errorf("error waiting for deletion: %s", err)
+					}
+
+					return nil
+				},
+			})
 		}
 		return !lastPage
 	})
@@ -66,6 +73,10 @@ func testSweepRdsGlobalClusters(region string) error {
 		return fmt.Errorf("error retrieving RDS Global Clusters: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_rds_global_cluster", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -13,10 +13,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_cluster", &resource.Sweeper{
+	addTestSweepers("aws_redshift_cluster", &resource.Sweeper{
 		Name: "aws_redshift_cluster",
 		F:    testSweepRedshiftClusters,
 	})
@@ -29,23 +31,25 @@ func testSweepRedshiftClusters(region string) error {
 	}
 	conn := client.(*AWSClient).redshiftconn
 
-	err = conn.DescribeClustersPages(&redshift.DescribeClustersInput{}, func(resp *redshift.DescribeClustersOutput, isLast bool) bool {
-		if len(resp.Clusters) == 0 {
-			log.Print("[DEBUG] No Redshift clusters to sweep")
-			return false
-		}
+	var resources []*sweep.Resource
 
+	err = conn.DescribeClustersPages(&redshift.DescribeClustersInput{}, func(resp *redshift.DescribeClustersOutput, isLast bool) bool {
 		for _, c := range resp.Clusters {
 			id := aws.StringValue(c.ClusterIdentifier)
 
-			input := &redshift.DeleteClusterInput{
-				ClusterIdentifier:        c.ClusterIdentifier,
-				SkipFinalClusterSnapshot: aws.Bool(true),
-			}
-			_, err := conn.DeleteCluster(input)
-			if err != nil {
-				log.Printf("[ERROR] Failed deleting Redshift cluster (%s): %s", id, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				Tags:      keyvaluetags.RedshiftKeyValueTags(c.Tags),
+				CreatedAt: aws.TimeValue(c.ClusterCreateTime),
+				Delete: func() error {
+					input := &redshift.DeleteClusterInput{
+						ClusterIdentifier:        aws.String(id),
+						SkipFinalClusterSnapshot: aws.Bool(true),
+					}
+					_, err := conn.DeleteCluster(input)
+					return err
+				},
+			})
 		}
 		return !isLast
 	})
@@ -56,6 +60,11 @@ func testSweepRedshiftClusters(region string) error {
 		}
 		return fmt.Errorf("Error retrieving Redshift Clusters: %s", err)
 	}
+
+	if err := testSweepResources(region, "aws_redshift_cluster", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
+	addTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
 		Name: "aws_route53_resolver_endpoint",
 		F:    testSweepRoute53ResolverEndpoints,
 	})
@@ -27,6 +28,8 @@ func testSweepRoute53ResolverEndpoints(region string) error {
 	}
 	conn := client.(*AWSClient).route53resolverconn
 
+	var resources []*sweep.Resource
+
 	err = conn.ListResolverEndpointsPages(&route53resolver.ListResolverEndpointsInput{}, func(page *route53resolver.ListResolverEndpointsOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -35,24 +38,25 @@ func testSweepRoute53ResolverEndpoints(region string) error {
 		for _, resolverEndpoint := range page.ResolverEndpoints {
 			id := aws.StringValue(resolverEndpoint.Id)
 
-			log.Printf("[INFO] Deleting Route53 Resolver endpoint: %s", id)
-			_, err := conn.DeleteResolverEndpoint(&route53resolver.DeleteResolverEndpointInput{
-				ResolverEndpointId: aws.String(id),
+			resources = append(resources, &sweep.Resource{
+				ID:   id,
+				Name: aws.StringValue(resolverEndpoint.Name),
+				Delete: func() error {
+					_, err := conn.DeleteResolverEndpoint(&route53resolver.DeleteResolverEndpointInput{
+						ResolverEndpointId: aws.String(id),
+					})
+					if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+						return nil
+					}
+					if err != nil {
+						return err
+					}
+
+					return route53ResolverEndpointWaitUntilTargetState(conn, id, 10*time.Minute,
+						[]string{route53resolver.ResolverEndpointStatusDeleting},
+						[]string{route53ResolverEndpointStatusDeleted})
+				},
 			})
-			if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Route53 Resolver endpoint (%s): %s", id, err)
-				continue
-			}
-
-			err = route53ResolverEndpointWaitUntilTargetState(conn, id, 10*time.Minute,
-				[]string{route53resolver.ResolverEndpointStatusDeleting},
-				[]string{route53ResolverEndpointStatusDeleted})
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-			}
 		}
 
 		return !isLast
@@ -65,6 +69,10 @@ func testSweepRoute53ResolverEndpoints(region string) error {
 		return fmt.Errorf("error retrievingRoute53 Resolver endpoints: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_route53_resolver_endpoint", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
@@ -11,10 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	addTestSweepers("aws_route_table", &resource.Sweeper{
 		Name: "aws_route_table",
 		F:    testSweepRouteTables,
 	})
@@ -27,42 +29,51 @@ func testSweepRouteTables(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 
+	var resources []*sweep.Resource
+
 	input := &ec2.DescribeRouteTablesInput{}
 	err = conn.DescribeRouteTablesPages(input, func(page *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
 		for _, routeTable := range page.RouteTables {
+			id := aws.StringValue(routeTable.RouteTableId)
+			associations := routeTable.Associations
 			isMainRouteTableAssociation := false
 
-			for _, routeTableAssociation := range routeTable.Associations {
+			for _, routeTableAssociation := range associations {
 				if aws.BoolValue(routeTableAssociation.Main) {
 					isMainRouteTableAssociation = true
 					break
 				}
-
-				input := &ec2.DisassociateRouteTableInput{
-					AssociationId: routeTableAssociation.RouteTableAssociationId,
-				}
-
-				log.Printf("[DEBUG] Deleting Route Table Association: %s", input)
-				_, err := conn.DisassociateRouteTable(input)
-				if err != nil {
-					log.Printf("[ERROR] Error deleting Route Table Association (%s): %s", aws.StringValue(routeTableAssociation.RouteTableAssociationId), err)
-				}
 			}
 
 			if isMainRouteTableAssociation {
-				log.Printf("[DEBUG] Skipping Main Route Table: %s", aws.StringValue(routeTable.RouteTableId))
+				log.Printf("[DEBUG] Skipping Main Route Table: %s", id)
 				continue
 			}
 
-			input := &ec2.DeleteRouteTableInput{
-				RouteTableId: routeTable.RouteTableId,
-			}
-
-			log.Printf("[DEBUG] Deleting Route Table: %s", input)
-			_, err := conn.DeleteRouteTable(input)
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Route Table (%s): %s", aws.StringValue(routeTable.RouteTableId), err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:   id,
+				Tags: keyvaluetags.Ec2KeyValueTags(routeTable.Tags),
+				Delete: func() error {
+					for _, routeTableAssociation := range associations {
+						input := &ec2.DisassociateRouteTableInput{
+							AssociationId: routeTableAssociation.RouteTableAssociationId,
+						}
+
+						log.Printf("[DEBUG] Deleting Route Table Association: %s", input)
+						_, err := conn.DisassociateRouteTable(input)
+						if err != nil {
+							log.Printf("[ERROR] Error deleting Route Table Association (%s): %s", aws.StringValue(routeTableAssociation.RouteTableAssociationId), err)
+						}
+					}
+
+					input := &ec2.DeleteRouteTableInput{
+						RouteTableId: aws.String(id),
+					}
+
+					_, err := conn.DeleteRouteTable(input)
+					return err
+				},
+			})
 		}
 
 		return !lastPage
@@ -77,6 +88,10 @@ func testSweepRouteTables(region string) error {
 		return fmt.Errorf("Error describing Route Tables: %s", err)
 	}
 
+	if err := testSweepResources(region, "aws_route_table", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
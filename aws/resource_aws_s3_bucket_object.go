@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -153,6 +154,45 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"object_lock_legal_hold_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				// Removing the legal hold turns it off.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == s3.ObjectLockLegalHoldStatusOff && new == ""
+				},
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectLockLegalHoldStatusOn,
+					s3.ObjectLockLegalHoldStatusOff,
+				}, false),
+			},
+
+			// The retention of objects defaults to the default retention of
+			// the bucket.
+			"object_lock_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectLockModeGovernance,
+					s3.ObjectLockModeCompliance,
+				}, false),
+			},
+
+			"object_lock_retain_until_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivalentRFC3339Timestamps,
+			},
 		},
 	}
 }
@@ -252,6 +292,18 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+		putInput.ObjectLockLegalHoldStatus = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("object_lock_mode"); ok {
+		putInput.ObjectLockMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("object_lock_retain_until_date"); ok {
+		putInput.ObjectLockRetainUntilDate = expandS3ObjectLockRetainUntilDate(v.(string))
+	}
+
 	if _, err := s3conn.PutObject(putInput); err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
@@ -295,6 +347,9 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("version_id", resp.VersionId)
 	d.Set("server_side_encryption", resp.ServerSideEncryption)
 	d.Set("website_redirect", resp.WebsiteRedirectLocation)
+	d.Set("object_lock_legal_hold_status", resp.ObjectLockLegalHoldStatus)
+	d.Set("object_lock_mode", resp.ObjectLockMode)
+	d.Set("object_lock_retain_until_date", flattenS3ObjectLockRetainUntilDate(resp.ObjectLockRetainUntilDate))
 
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if resp.SSEKMSKeyId != nil {
@@ -322,7 +377,7 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("storage_class", resp.StorageClass)
 	}
 
-	if err := getTagsS3Object(s3conn, d); err != nil {
+	if err := getTagsS3Object(s3conn, d, meta); err != nil {
 		return fmt.Errorf("error getting S3 object tags (bucket: %s, key: %s): %s", bucket, key, err)
 	}
 
@@ -364,6 +419,49 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("object_lock_legal_hold_status") {
+		status := d.Get("object_lock_legal_hold_status").(string)
+		if status == "" {
+			status = s3.ObjectLockLegalHoldStatusOff
+		}
+
+		_, err := conn.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+			Bucket: aws.String(d.Get("bucket").(string)),
+			Key:    aws.String(d.Get("key").(string)),
+			LegalHold: &s3.ObjectLockLegalHold{
+				Status: aws.String(status),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error putting S3 object legal hold: %s", err)
+		}
+	}
+
+	// Both arguments are computed from the bucket default retention, so they
+	// only change when configured.
+	if d.HasChange("object_lock_mode") || d.HasChange("object_lock_retain_until_date") {
+		input := &s3.PutObjectRetentionInput{
+			Bucket: aws.String(d.Get("bucket").(string)),
+			Key:    aws.String(d.Get("key").(string)),
+			Retention: &s3.ObjectLockRetention{
+				Mode:            aws.String(d.Get("object_lock_mode").(string)),
+				RetainUntilDate: expandS3ObjectLockRetainUntilDate(d.Get("object_lock_retain_until_date").(string)),
+			},
+		}
+
+		// Shortening a retention period requires bypassing it, which is only
+		// allowed in governance mode.
+		o, n := d.GetChange("object_lock_retain_until_date")
+		oDate, nDate := expandS3ObjectLockRetainUntilDate(o.(string)), expandS3ObjectLockRetainUntilDate(n.(string))
+		if oDate != nil && nDate != nil && nDate.Before(*oDate) {
+			input.BypassGovernanceRetention = aws.Bool(true)
+		}
+
+		if _, err := conn.PutObjectRetention(input); err != nil {
+			return fmt.Errorf("error putting S3 object retention: %s", err)
+		}
+	}
+
 	if err := setTagsS3Object(conn, d); err != nil {
 		return fmt.Errorf("error setting S3 object tags: %s", err)
 	}
@@ -391,12 +489,7 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 		}
 
 		for _, v := range out.Versions {
-			input := s3.DeleteObjectInput{
-				Bucket:    aws.String(bucket),
-				Key:       aws.String(key),
-				VersionId: v.VersionId,
-			}
-			_, err := s3conn.DeleteObject(&input)
+			err := deleteS3ObjectVersion(s3conn, bucket, key, aws.StringValue(v.VersionId), d.Get("force_destroy").(bool))
 			if err != nil {
 				return fmt.Errorf("Error deleting S3 object version of %s:\n %s:\n %s",
 					key, v, err)
@@ -404,11 +497,7 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	} else {
 		// Just delete the object
-		input := s3.DeleteObjectInput{
-			Bucket: aws.String(bucket),
-			Key:    aws.String(key),
-		}
-		_, err := s3conn.DeleteObject(&input)
+		err := deleteS3ObjectVersion(s3conn, bucket, key, "", d.Get("force_destroy").(bool))
 		if err != nil {
 			return fmt.Errorf("Error deleting S3 bucket object: %s  Bucket: %q Object: %q", err, bucket, key)
 		}
@@ -417,6 +506,70 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// deleteS3ObjectVersion deletes an object, or a version of it. With force,
+// governance mode retention is bypassed and a legal hold is removed first,
+// if the deletion is denied.
+func deleteS3ObjectVersion(conn *s3.S3, bucket, key, versionID string, force bool) error {
+	input := &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	if force {
+		input.BypassGovernanceRetention = aws.Bool(true)
+	}
+
+	_, err := conn.DeleteObject(input)
+
+	if !force || !isAWSErr(err, "AccessDenied", "") {
+		return err
+	}
+
+	log.Printf("[DEBUG] Removing legal hold of S3 object (%s/%s) version %q", bucket, key, versionID)
+	_, lhErr := conn.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+		Bucket:    aws.String(bucket),
+		Key:       aws.String(key),
+		VersionId: input.VersionId,
+		LegalHold: &s3.ObjectLockLegalHold{
+			Status: aws.String(s3.ObjectLockLegalHoldStatusOff),
+		},
+	})
+
+	// Not an object lock restriction
+	if isAWSErr(lhErr, "InvalidRequest", "") {
+		return err
+	}
+
+	if lhErr != nil {
+		return fmt.Errorf("error removing legal hold: %s", lhErr)
+	}
+
+	_, err = conn.DeleteObject(input)
+
+	return err
+}
+
+func expandS3ObjectLockRetainUntilDate(v string) *time.Time {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil
+	}
+
+	return aws.Time(t)
+}
+
+func flattenS3ObjectLockRetainUntilDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
 func resourceAwsS3BucketObjectCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
 	if d.HasChange("etag") {
 		d.SetNewComputed("version_id")
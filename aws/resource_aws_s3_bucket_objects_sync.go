@@ -0,0 +1,431 @@
+package aws
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mitchellh/go-homedir"
+)
+
+func resourceAwsS3BucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketObjectsSyncPut,
+		Read:   resourceAwsS3BucketObjectsSyncRead,
+		Update: resourceAwsS3BucketObjectsSyncPut,
+		Delete: resourceAwsS3BucketObjectsSyncDelete,
+
+		CustomizeDiff: resourceAwsS3BucketObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"acl": {
+				Type:     schema.TypeString,
+				Default:  s3.ObjectCannedACLPrivate,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectCannedACLPrivate,
+					s3.ObjectCannedACLPublicRead,
+					s3.ObjectCannedACLPublicReadWrite,
+					s3.ObjectCannedACLAuthenticatedRead,
+					s3.ObjectCannedACLAwsExecRead,
+					s3.ObjectCannedACLBucketOwnerRead,
+					s3.ObjectCannedACLBucketOwnerFullControl,
+				}, false),
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectStorageClassStandard,
+					s3.ObjectStorageClassReducedRedundancy,
+					s3.ObjectStorageClassGlacier,
+					s3.ObjectStorageClassStandardIa,
+					s3.ObjectStorageClassOnezoneIa,
+					s3.ObjectStorageClassIntelligentTiering,
+					s3.ObjectStorageClassDeepArchive,
+				}, false),
+			},
+
+			// Change detection compares the ETags of the objects with the MD5
+			// digests of the files, which excludes KMS encryption.
+			"server_side_encryption": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ServerSideEncryptionAes256,
+				}, false),
+			},
+
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// s3ObjectsSyncFile is a local file to be uploaded as an object.
+type s3ObjectsSyncFile struct {
+	path        string
+	etag        string
+	contentType string
+}
+
+// s3ObjectsSyncLocalFiles returns the files of a directory tree by object key.
+func s3ObjectsSyncLocalFiles(sourceDir, keyPrefix string, contentTypes map[string]interface{}) (map[string]*s3ObjectsSyncFile, error) {
+	root, err := homedir.Expand(sourceDir)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source_dir (%s): %s", sourceDir, err)
+	}
+
+	files := make(map[string]*s3ObjectsSyncFile)
+
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		etag, err := s3ObjectsSyncFileMd5(path)
+		if err != nil {
+			return err
+		}
+
+		files[keyPrefix+filepath.ToSlash(rel)] = &s3ObjectsSyncFile{
+			path:        path,
+			etag:        etag,
+			contentType: s3ObjectsSyncContentType(path, contentTypes),
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading source_dir (%s): %s", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+// s3ObjectsSyncFileMd5 returns the hex encoded MD5 digest of a file, which
+// is the ETag of an object uploaded from it in a single part.
+func s3ObjectsSyncFileMd5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// s3ObjectsSyncContentType returns the content type of a file by its
+// extension. Configured content types take precedence over the system MIME
+// types.
+func s3ObjectsSyncContentType(path string, contentTypes map[string]interface{}) string {
+	ext := strings.ToLower(filepath.Ext(path))
+
+	if ext == "" {
+		return ""
+	}
+
+	if v, ok := contentTypes[ext]; ok {
+		return v.(string)
+	}
+
+	return mime.TypeByExtension(ext)
+}
+
+func s3ObjectsSyncEtags(files map[string]*s3ObjectsSyncFile) map[string]interface{} {
+	etags := make(map[string]interface{}, len(files))
+
+	for key, file := range files {
+		etags[key] = file.etag
+	}
+
+	return etags
+}
+
+func resourceAwsS3BucketObjectsSyncCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("key_prefix") {
+		return d.SetNewComputed("files")
+	}
+
+	files, err := s3ObjectsSyncLocalFiles(d.Get("source_dir").(string), d.Get("key_prefix").(string), d.Get("content_types").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	etags := s3ObjectsSyncEtags(files)
+
+	if !reflect.DeepEqual(d.Get("files").(map[string]interface{}), etags) {
+		if err := d.SetNew("files", etags); err != nil {
+			return fmt.Errorf("error setting files diff: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsSyncPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	files, err := s3ObjectsSyncLocalFiles(d.Get("source_dir").(string), keyPrefix, d.Get("content_types").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	// Changes to any of these attributes require all objects to be uploaded again
+	uploadAll := d.IsNewResource()
+	for _, key := range []string{
+		"acl",
+		"cache_control",
+		"content_types",
+		"storage_class",
+		"server_side_encryption",
+	} {
+		if d.HasChange(key) {
+			uploadAll = true
+		}
+	}
+
+	o, _ := d.GetChange("files")
+	remote := o.(map[string]interface{})
+
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		file := files[key]
+
+		if !uploadAll && remote[key] == file.etag {
+			continue
+		}
+
+		if err := resourceAwsS3BucketObjectsSyncUpload(conn, d, key, file); err != nil {
+			return err
+		}
+	}
+
+	var removed []string
+	for key := range remote {
+		if _, ok := files[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	if err := deleteS3ObjectsSyncObjects(conn, bucket, removed); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, keyPrefix))
+	d.Set("files", s3ObjectsSyncEtags(files))
+
+	return resourceAwsS3BucketObjectsSyncRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectsSyncUpload(conn *s3.S3, d *schema.ResourceData, key string, file *s3ObjectsSyncFile) error {
+	bucket := d.Get("bucket").(string)
+
+	body, err := os.Open(file.path)
+	if err != nil {
+		return fmt.Errorf("error opening S3 bucket objects sync source (%s): %s", file.path, err)
+	}
+	defer func() {
+		if err := body.Close(); err != nil {
+			log.Printf("[WARN] Error closing S3 bucket objects sync source (%s): %s", file.path, err)
+		}
+	}()
+
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		ACL:    aws.String(d.Get("acl").(string)),
+		Body:   body,
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	if file.contentType != "" {
+		input.ContentType = aws.String(file.contentType)
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Uploading S3 bucket object (%s/%s) from %s", bucket, key, file.path)
+	if _, err := conn.PutObject(input); err != nil {
+		return fmt.Errorf("error putting object (%s) in S3 bucket (%s): %s", key, bucket, err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsSyncRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	managed := d.Get("files").(map[string]interface{})
+	etags := make(map[string]interface{}, len(managed))
+
+	input := &s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(d.Get("key_prefix").(string)),
+	}
+
+	err := conn.ListObjectsPages(input, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, object := range page.Contents {
+			key := aws.StringValue(object.Key)
+
+			if _, ok := managed[key]; ok {
+				// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+				etags[key] = strings.Trim(aws.StringValue(object.ETag), `"`)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 bucket objects sync (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing objects of S3 bucket (%s): %s", bucket, err)
+	}
+
+	// Missing or modified objects are uploaded again on the next apply
+	if err := d.Set("files", etags); err != nil {
+		return fmt.Errorf("error setting files: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	var keys []string
+	for key := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	err := deleteS3ObjectsSyncObjects(conn, d.Get("bucket").(string), keys)
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+
+	return err
+}
+
+// deleteS3ObjectsSyncObjects deletes objects in batches of the maximum
+// DeleteObjects request size.
+func deleteS3ObjectsSyncObjects(conn *s3.S3, bucket string, keys []string) error {
+	const batchSize = 1000
+
+	sort.Strings(keys)
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > batchSize {
+			n = batchSize
+		}
+
+		objects := make([]*s3.ObjectIdentifier, 0, n)
+		for _, key := range keys[:n] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		keys = keys[n:]
+
+		log.Printf("[DEBUG] Deleting %d objects from S3 bucket (%s)", len(objects), bucket)
+		output, err := conn.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+
+		if err != nil {
+			return fmt.Errorf("error deleting objects from S3 bucket (%s): %s", bucket, err)
+		}
+
+		if len(output.Errors) > 0 {
+			e := output.Errors[0]
+			return fmt.Errorf("error deleting object (%s) from S3 bucket (%s): %s: %s", aws.StringValue(e.Key), bucket, aws.StringValue(e.Code), aws.StringValue(e.Message))
+		}
+	}
+
+	return nil
+}
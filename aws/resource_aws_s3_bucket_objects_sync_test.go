@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestS3ObjectsSyncContentType(t *testing.T) {
+	contentTypes := map[string]interface{}{
+		".md": "text/markdown",
+	}
+
+	testCases := []struct {
+		Path     string
+		Expected string
+	}{
+		{
+			Path:     "index.html",
+			Expected: "text/html; charset=utf-8",
+		},
+		{
+			Path:     "images/logo.PNG",
+			Expected: "image/png",
+		},
+		{
+			Path:     "README.md",
+			Expected: "text/markdown",
+		},
+		{
+			Path:     "LICENSE",
+			Expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := s3ObjectsSyncContentType(tc.Path, contentTypes); got != tc.Expected {
+			t.Errorf("%s: expected content type %q, got %q", tc.Path, tc.Expected, got)
+		}
+	}
+}
+
+func TestS3ObjectsSyncLocalFiles(t *testing.T) {
+	dir := testAccAWSS3BucketObjectsSyncCreateTempDir(t, map[string]string{
+		"index.html":     "<html></html>",
+		"css/style.css":  "body {}",
+		"empty/.keep":    "",
+		"nested/a/b.txt": "test",
+	})
+	defer os.RemoveAll(dir)
+
+	files, err := s3ObjectsSyncLocalFiles(dir, "site/", nil)
+	if err != nil {
+		t.Fatalf("error reading local files: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"site/css/style.css":  "fcdce6b6d6e2175f6406869882f6f1ce",
+		"site/empty/.keep":    "d41d8cd98f00b204e9800998ecf8427e",
+		"site/index.html":     "c83301425b2ad1d496473a5ff3d9ecca",
+		"site/nested/a/b.txt": "098f6bcd4621d373cade4e832627b4f6",
+	}
+
+	if etags := s3ObjectsSyncEtags(files); !reflect.DeepEqual(etags, expected) {
+		t.Fatalf("expected:\n%v\n\ngot:\n%v", expected, etags)
+	}
+
+	if got := files["site/index.html"].contentType; got != "text/html; charset=utf-8" {
+		t.Fatalf("expected content type text/html; charset=utf-8, got: %s", got)
+	}
+}
+
+func TestAccAWSS3BucketObjectsSync_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	dir := testAccAWSS3BucketObjectsSyncCreateTempDir(t, map[string]string{
+		"index.html":    "<html></html>",
+		"css/style.css": "body {}",
+		"old.txt":       "old",
+	})
+	defer os.RemoveAll(dir)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/old.txt", "149603e6c03516362a8da23f624db945"),
+					testAccCheckAWSS3BucketObjectsSyncObject(rName, "site/index.html", "text/html; charset=utf-8", "<html></html>"),
+					testAccCheckAWSS3BucketObjectsSyncObject(rName, "site/css/style.css", "text/css", "body {}"),
+					testAccCheckAWSS3BucketObjectsSyncObject(rName, "site/old.txt", "binary/octet-stream", "old"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccAWSS3BucketObjectsSyncWriteFile(t, dir, "index.html", "<html><body></body></html>")
+					testAccAWSS3BucketObjectsSyncWriteFile(t, dir, "js/app.js", "main()")
+					if err := os.Remove(filepath.Join(dir, "old.txt")); err != nil {
+						t.Fatalf("error removing file: %s", err)
+					}
+				},
+				Config: testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.site/old.txt"),
+					testAccCheckAWSS3BucketObjectsSyncObject(rName, "site/index.html", "text/html; charset=utf-8", "<html><body></body></html>"),
+					testAccCheckAWSS3BucketObjectsSyncObject(rName, "site/js/app.js", "application/javascript", "main()"),
+					testAccCheckAWSS3BucketObjectsSyncNoObject(rName, "site/old.txt"),
+				),
+			},
+			{
+				Config:   testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccAWSS3BucketObjectsSyncCreateTempDir(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "tf-acc-s3-sync")
+	if err != nil {
+		t.Fatalf("error creating temporary directory: %s", err)
+	}
+
+	for name, content := range files {
+		testAccAWSS3BucketObjectsSyncWriteFile(t, dir, name, content)
+	}
+
+	return dir
+}
+
+func testAccAWSS3BucketObjectsSyncWriteFile(t *testing.T, dir, name, content string) {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("error creating directory: %s", err)
+	}
+
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("error writing file: %s", err)
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncObject(bucket, key, contentType, body string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		out, err := conn.GetObject(&s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("error getting S3 object (%s): %s", key, err)
+		}
+		defer out.Body.Close()
+
+		content, err := ioutil.ReadAll(out.Body)
+		if err != nil {
+			return fmt.Errorf("error reading S3 object (%s): %s", key, err)
+		}
+
+		if got := string(content); got != body {
+			return fmt.Errorf("S3 object (%s): expected body %q, got %q", key, body, got)
+		}
+
+		if got := aws.StringValue(out.ContentType); got != contentType {
+			return fmt.Errorf("S3 object (%s): expected content type %q, got %q", key, contentType, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncNoObject(bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err == nil {
+			return fmt.Errorf("S3 object (%s) still exists", key)
+		}
+
+		if !isAWSErr(err, "NotFound", "") {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSS3BucketObjectsSyncConfig(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket     = "${aws_s3_bucket.test.id}"
+  key_prefix = "site/"
+  source_dir = %[2]q
+
+  content_types = {
+    ".css" = "text/css"
+    ".js"  = "application/javascript"
+    ".txt" = ""
+  }
+}
+`, rName, dir)
+}
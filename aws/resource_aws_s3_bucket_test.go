@@ -19,10 +19,11 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	addTestSweepers("aws_s3_bucket", &resource.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    testSweepS3Buckets,
 		Dependencies: []string{
@@ -51,63 +52,50 @@ func testSweepS3Buckets(region string) error {
 		return fmt.Errorf("error listing S3 Buckets: %s", err)
 	}
 
-	if len(output.Buckets) == 0 {
-		log.Print("[DEBUG] No S3 Buckets to sweep")
-		return nil
+	filter := sweep.Filter{
+		NamePrefixes: []string{"mybucket.", "mylogs.", "tf-acc", "tf-object-test", "tf-test"},
 	}
 
+	var resources []*sweep.Resource
+
 	for _, bucket := range output.Buckets {
 		name := aws.StringValue(bucket.Name)
 
-		hasPrefix := false
-		prefixes := []string{"mybucket.", "mylogs.", "tf-acc", "tf-object-test", "tf-test"}
-
-		for _, prefix := range prefixes {
-			if strings.HasPrefix(name, prefix) {
-				hasPrefix = true
-				break
-			}
-		}
-
-		if !hasPrefix {
-			log.Printf("[INFO] Skipping S3 Bucket: %s", name)
-			continue
-		}
-
-		input := &s3.DeleteBucketInput{
-			Bucket: bucket.Name,
-		}
+		resources = append(resources, &sweep.Resource{
+			ID:        name,
+			CreatedAt: aws.TimeValue(bucket.CreationDate),
+			Delete: func() error {
+				input := &s3.DeleteBucketInput{
+					Bucket: aws.String(name),
+				}
 
-		log.Printf("[INFO] Deleting S3 Bucket: %s", name)
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.DeleteBucket(input)
+				return resource.Retry(1*time.Minute, func() *resource.RetryError {
+					_, err := conn.DeleteBucket(input)
 
-			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
-				return nil
-			}
+					if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+						return nil
+					}
 
-			if isAWSErr(err, "AuthorizationHeaderMalformed", "region") || isAWSErr(err, "BucketRegionError", "") {
-				log.Printf("[INFO] Skipping S3 Bucket (%s): %s", name, err)
-				return nil
-			}
+					if isAWSErr(err, "AuthorizationHeaderMalformed", "region") || isAWSErr(err, "BucketRegionError", "") {
+						log.Printf("[INFO] Skipping S3 Bucket (%s): %s", name, err)
+						return nil
+					}
 
-			if isAWSErr(err, "BucketNotEmpty", "") {
-				return resource.RetryableError(err)
-			}
+					if isAWSErr(err, "BucketNotEmpty", "") {
+						return resource.RetryableError(err)
+					}
 
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
+					if err != nil {
+						return resource.NonRetryableError(err)
+					}
 
-			return nil
+					return nil
+				})
+			},
 		})
-
-		if err != nil {
-			return fmt.Errorf("error deleting S3 Bucket (%s): %s", name, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_s3_bucket", filter, resources)
 }
 
 func TestAccAWSS3Bucket_importBasic(t *testing.T) {
@@ -0,0 +1,1135 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3ControlJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3ControlJobCreate,
+		Read:   resourceAwsS3ControlJobRead,
+		Update: resourceAwsS3ControlJobUpdate,
+		Delete: resourceAwsS3ControlJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirmation_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"confirmed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"failure_reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"manifest": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												s3control.JobManifestFieldNameBucket,
+												s3control.JobManifestFieldNameIgnore,
+												s3control.JobManifestFieldNameKey,
+												s3control.JobManifestFieldNameVersionId,
+											}, false),
+										},
+									},
+									"format": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.JobManifestFormatS3batchOperationsCsv20180820,
+											s3control.JobManifestFormatS3inventoryReportCsv20161130,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"function_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"glacier_job_tier": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										Default:  s3control.S3GlacierJobTierStandard,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.S3GlacierJobTierBulk,
+											s3control.S3GlacierJobTierStandard,
+										}, false),
+									},
+								},
+							},
+						},
+						"s3_put_object_acl": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"canned_access_control_list": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateS3ControlCannedAccessControlList,
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"canned_access_control_list": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validateS3ControlCannedAccessControlList,
+									},
+									"metadata_directive": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.S3MetadataDirectiveCopy,
+											s3control.S3MetadataDirectiveReplace,
+										}, false),
+									},
+									"new_object_metadata": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cache_control": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_disposition": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_encoding": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_language": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"sse_algorithm": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														s3control.S3SSEAlgorithmAes256,
+														s3control.S3SSEAlgorithmKms,
+													}, false),
+												},
+												"user_metadata": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"new_object_tagging": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"requester_pays": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.S3StorageClassDeepArchive,
+											s3control.S3StorageClassGlacier,
+											s3control.S3StorageClassIntelligentTiering,
+											s3control.S3StorageClassOnezoneIa,
+											s3control.S3StorageClassStandard,
+											s3control.S3StorageClassStandardIa,
+										}, false),
+									},
+									"target_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"progress_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number_of_tasks_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_tasks_succeeded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_number_of_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3control.JobReportFormatReportCsv20180820,
+							}, false),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_scope": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3control.JobReportScopeAllTasks,
+								s3control.JobReportScopeFailedTasksOnly,
+							}, false),
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_update_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+var validateS3ControlCannedAccessControlList = validation.StringInSlice([]string{
+	s3control.S3CannedAccessControlListAuthenticatedRead,
+	s3control.S3CannedAccessControlListAwsExecRead,
+	s3control.S3CannedAccessControlListBucketOwnerFullControl,
+	s3control.S3CannedAccessControlListBucketOwnerRead,
+	s3control.S3CannedAccessControlListPrivate,
+	s3control.S3CannedAccessControlListPublicRead,
+	s3control.S3CannedAccessControlListPublicReadWrite,
+}, false)
+
+// s3ControlJobTerminalStatuses are the statuses after which a job does no
+// further work.
+var s3ControlJobTerminalStatuses = []string{
+	s3control.JobStatusCancelled,
+	s3control.JobStatusComplete,
+	s3control.JobStatusFailed,
+}
+
+// s3ControlJobPendingStatuses are the statuses of a job that is still
+// working towards a terminal status.
+var s3ControlJobPendingStatuses = []string{
+	s3control.JobStatusActive,
+	s3control.JobStatusCancelling,
+	s3control.JobStatusCompleting,
+	s3control.JobStatusFailing,
+	s3control.JobStatusNew,
+	s3control.JobStatusPaused,
+	s3control.JobStatusPausing,
+	s3control.JobStatusPreparing,
+	s3control.JobStatusReady,
+}
+
+func resourceAwsS3ControlJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	operation, err := expandS3ControlJobOperation(d.Get("operation").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(resource.UniqueId()),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Manifest:             expandS3ControlJobManifest(d.Get("manifest").([]interface{})),
+		Operation:            operation,
+		Priority:             aws.Int64(int64(d.Get("priority").(int))),
+		Report:               expandS3ControlJobReport(d.Get("report").([]interface{})),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating S3 Control Job: %s", input)
+	var output *s3control.CreateJobOutput
+	// Retry for IAM eventual consistency of the job role
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateJob(input)
+
+		if isAWSErr(err, s3control.ErrCodeBadRequestException, "role") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Control Job: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+	d.Set("account_id", accountID)
+
+	job, err := waitForS3ControlJobStatus(conn, accountID, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 Control Job (%s) to finish: %s", d.Id(), err)
+	}
+
+	if err := s3ControlJobFailureError(d.Id(), job); err != nil {
+		return err
+	}
+
+	if aws.StringValue(job.Status) == s3control.JobStatusSuspended && d.Get("confirmed").(bool) {
+		if err := confirmS3ControlJob(conn, accountID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsS3ControlJobRead(d, meta)
+}
+
+func resourceAwsS3ControlJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	job, err := describeS3ControlJob(conn, accountID, d.Id())
+
+	if isAWSErr(err, s3control.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] S3 Control Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Control Job (%s): %s", d.Id(), err)
+	}
+
+	if job == nil {
+		return fmt.Errorf("error reading S3 Control Job (%s): empty response", d.Id())
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", job.JobArn)
+	d.Set("confirmation_required", job.ConfirmationRequired)
+	d.Set("description", job.Description)
+	d.Set("priority", job.Priority)
+	d.Set("role_arn", job.RoleArn)
+	d.Set("status", job.Status)
+	d.Set("status_update_reason", job.StatusUpdateReason)
+
+	if err := d.Set("failure_reasons", flattenS3ControlJobFailures(job.FailureReasons)); err != nil {
+		return fmt.Errorf("error setting failure_reasons: %s", err)
+	}
+
+	if err := d.Set("manifest", flattenS3ControlJobManifest(job.Manifest)); err != nil {
+		return fmt.Errorf("error setting manifest: %s", err)
+	}
+
+	if err := d.Set("operation", flattenS3ControlJobOperation(job.Operation)); err != nil {
+		return fmt.Errorf("error setting operation: %s", err)
+	}
+
+	if err := d.Set("progress_summary", flattenS3ControlJobProgressSummary(job.ProgressSummary)); err != nil {
+		return fmt.Errorf("error setting progress_summary: %s", err)
+	}
+
+	if err := d.Set("report", flattenS3ControlJobReport(job.Report)); err != nil {
+		return fmt.Errorf("error setting report: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3ControlJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	if d.HasChange("priority") {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(d.Get("account_id").(string)),
+			JobId:     aws.String(d.Id()),
+			Priority:  aws.Int64(int64(d.Get("priority").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating S3 Control Job priority: %s", input)
+		if _, err := conn.UpdateJobPriority(input); err != nil {
+			return fmt.Errorf("error updating S3 Control Job (%s) priority: %s", d.Id(), err)
+		}
+	}
+
+	// A job cannot be unconfirmed, so only confirming a suspended job has an effect.
+	if d.HasChange("confirmed") && d.Get("confirmed").(bool) && d.Get("status").(string) == s3control.JobStatusSuspended {
+		if err := confirmS3ControlJob(conn, d.Get("account_id").(string), d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsS3ControlJobRead(d, meta)
+}
+
+func resourceAwsS3ControlJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+	accountID := d.Get("account_id").(string)
+
+	// Jobs cannot be deleted, they expire 90 days after they finish. Only a
+	// job that has not finished yet is cancelled.
+	switch d.Get("status").(string) {
+	case s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed:
+		return nil
+	}
+
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(d.Id()),
+		RequestedJobStatus: aws.String(s3control.RequestedJobStatusCancelled),
+		StatusUpdateReason: aws.String("Deleted by Terraform"),
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Control Job: %s", input)
+	_, err := conn.UpdateJobStatus(input)
+
+	if isAWSErr(err, s3control.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	// The job finished in the meantime
+	if isAWSErr(err, s3control.ErrCodeJobStatusException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling S3 Control Job (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitForS3ControlJobStatus(conn, accountID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for S3 Control Job (%s) to be cancelled: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeS3ControlJob(conn *s3control.S3Control, accountID, jobID string) (*s3control.JobDescriptor, error) {
+	output, err := conn.DescribeJob(&s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Job, nil
+}
+
+func s3ControlJobRefreshFunc(conn *s3control.S3Control, accountID, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		job, err := describeS3ControlJob(conn, accountID, jobID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if job == nil {
+			return nil, "", nil
+		}
+
+		return job, aws.StringValue(job.Status), nil
+	}
+}
+
+// waitForS3ControlJobStatus waits for a job to reach a terminal status, or to
+// be suspended, e.g. while it awaits confirmation.
+func waitForS3ControlJobStatus(conn *s3control.S3Control, accountID, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    s3ControlJobPendingStatuses,
+		Target:     append([]string{s3control.JobStatusSuspended}, s3ControlJobTerminalStatuses...),
+		Refresh:    s3ControlJobRefreshFunc(conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	job, err := stateConf.WaitForState()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return job.(*s3control.JobDescriptor), nil
+}
+
+// confirmS3ControlJob confirms a job that is suspended awaiting confirmation
+// and waits for it to finish.
+func confirmS3ControlJob(conn *s3control.S3Control, accountID, jobID string, timeout time.Duration) error {
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: aws.String(s3control.RequestedJobStatusReady),
+	}
+
+	log.Printf("[DEBUG] Confirming S3 Control Job: %s", input)
+	if _, err := conn.UpdateJobStatus(input); err != nil {
+		return fmt.Errorf("error confirming S3 Control Job (%s): %s", jobID, err)
+	}
+
+	// The job may still be reported as suspended right after it is confirmed.
+	stateConf := &resource.StateChangeConf{
+		Pending:    append([]string{s3control.JobStatusSuspended}, s3ControlJobPendingStatuses...),
+		Target:     s3ControlJobTerminalStatuses,
+		Refresh:    s3ControlJobRefreshFunc(conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	job, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 Control Job (%s) to finish: %s", jobID, err)
+	}
+
+	return s3ControlJobFailureError(jobID, job.(*s3control.JobDescriptor))
+}
+
+// s3ControlJobFailureError returns an error with the failure reasons of a job
+// that failed or was cancelled.
+func s3ControlJobFailureError(jobID string, job *s3control.JobDescriptor) error {
+	switch status := aws.StringValue(job.Status); status {
+	case s3control.JobStatusCancelled, s3control.JobStatusFailed:
+		return fmt.Errorf("S3 Control Job (%s) %s: %s", jobID, strings.ToLower(status), s3ControlJobFailureMessage(job))
+	}
+
+	return nil
+}
+
+func s3ControlJobFailureMessage(job *s3control.JobDescriptor) string {
+	var reasons []string
+
+	for _, failure := range job.FailureReasons {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", aws.StringValue(failure.FailureCode), aws.StringValue(failure.FailureReason)))
+	}
+
+	if len(reasons) == 0 {
+		return aws.StringValue(job.StatusUpdateReason)
+	}
+
+	return strings.Join(reasons, ", ")
+}
+
+func expandS3ControlJobManifest(l []interface{}) *s3control.JobManifest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	manifest := &s3control.JobManifest{}
+
+	if v, ok := m["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		lm := v[0].(map[string]interface{})
+
+		manifest.Location = &s3control.JobManifestLocation{
+			ETag:      aws.String(lm["etag"].(string)),
+			ObjectArn: aws.String(lm["object_arn"].(string)),
+		}
+
+		if v, ok := lm["object_version_id"].(string); ok && v != "" {
+			manifest.Location.ObjectVersionId = aws.String(v)
+		}
+	}
+
+	if v, ok := m["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		sm := v[0].(map[string]interface{})
+
+		manifest.Spec = &s3control.JobManifestSpec{
+			Format: aws.String(sm["format"].(string)),
+		}
+
+		if v, ok := sm["fields"].([]interface{}); ok && len(v) > 0 {
+			manifest.Spec.Fields = expandStringList(v)
+		}
+	}
+
+	return manifest
+}
+
+func flattenS3ControlJobManifest(manifest *s3control.JobManifest) []interface{} {
+	if manifest == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if manifest.Location != nil {
+		m["location"] = []interface{}{
+			map[string]interface{}{
+				"etag":              aws.StringValue(manifest.Location.ETag),
+				"object_arn":        aws.StringValue(manifest.Location.ObjectArn),
+				"object_version_id": aws.StringValue(manifest.Location.ObjectVersionId),
+			},
+		}
+	}
+
+	if manifest.Spec != nil {
+		m["spec"] = []interface{}{
+			map[string]interface{}{
+				"fields": flattenStringList(manifest.Spec.Fields),
+				"format": aws.StringValue(manifest.Spec.Format),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandS3ControlJobOperation(l []interface{}) (*s3control.JobOperation, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, fmt.Errorf("exactly one operation must be configured")
+	}
+
+	m := l[0].(map[string]interface{})
+	operation := &s3control.JobOperation{}
+	operations := 0
+
+	if v, ok := m["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		om := v[0].(map[string]interface{})
+		operations++
+
+		operation.LambdaInvoke = &s3control.LambdaInvokeOperation{
+			FunctionArn: aws.String(om["function_arn"].(string)),
+		}
+	}
+
+	if v, ok := m["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		om := v[0].(map[string]interface{})
+		operations++
+
+		operation.S3InitiateRestoreObject = &s3control.S3InitiateRestoreObjectOperation{
+			ExpirationInDays: aws.Int64(int64(om["expiration_in_days"].(int))),
+			GlacierJobTier:   aws.String(om["glacier_job_tier"].(string)),
+		}
+	}
+
+	if v, ok := m["s3_put_object_acl"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		om := v[0].(map[string]interface{})
+		operations++
+
+		operation.S3PutObjectAcl = &s3control.S3SetObjectAclOperation{
+			AccessControlPolicy: &s3control.S3AccessControlPolicy{
+				CannedAccessControlList: aws.String(om["canned_access_control_list"].(string)),
+			},
+		}
+	}
+
+	if v, ok := m["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		operations++
+		operation.S3PutObjectCopy = expandS3ControlJobCopyObjectOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		operations++
+		operation.S3PutObjectTagging = &s3control.S3SetObjectTaggingOperation{
+			TagSet: []*s3control.S3Tag{},
+		}
+
+		if v[0] != nil {
+			om := v[0].(map[string]interface{})
+			operation.S3PutObjectTagging.TagSet = expandS3ControlJobTags(om["tag_set"].(map[string]interface{}))
+		}
+	}
+
+	if operations != 1 {
+		return nil, fmt.Errorf("exactly one operation must be configured, got %d", operations)
+	}
+
+	return operation, nil
+}
+
+func expandS3ControlJobCopyObjectOperation(m map[string]interface{}) *s3control.S3CopyObjectOperation {
+	operation := &s3control.S3CopyObjectOperation{
+		TargetResource: aws.String(m["target_resource"].(string)),
+	}
+
+	if v, ok := m["canned_access_control_list"].(string); ok && v != "" {
+		operation.CannedAccessControlList = aws.String(v)
+	}
+
+	if v, ok := m["metadata_directive"].(string); ok && v != "" {
+		operation.MetadataDirective = aws.String(v)
+	}
+
+	if v, ok := m["new_object_metadata"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mm := v[0].(map[string]interface{})
+		metadata := &s3control.S3ObjectMetadata{}
+
+		if v, ok := mm["cache_control"].(string); ok && v != "" {
+			metadata.CacheControl = aws.String(v)
+		}
+
+		if v, ok := mm["content_disposition"].(string); ok && v != "" {
+			metadata.ContentDisposition = aws.String(v)
+		}
+
+		if v, ok := mm["content_encoding"].(string); ok && v != "" {
+			metadata.ContentEncoding = aws.String(v)
+		}
+
+		if v, ok := mm["content_language"].(string); ok && v != "" {
+			metadata.ContentLanguage = aws.String(v)
+		}
+
+		if v, ok := mm["content_type"].(string); ok && v != "" {
+			metadata.ContentType = aws.String(v)
+		}
+
+		if v, ok := mm["sse_algorithm"].(string); ok && v != "" {
+			metadata.SSEAlgorithm = aws.String(v)
+		}
+
+		if v, ok := mm["user_metadata"].(map[string]interface{}); ok && len(v) > 0 {
+			metadata.UserMetadata = stringMapToPointers(v)
+		}
+
+		operation.NewObjectMetadata = metadata
+	}
+
+	if v, ok := m["new_object_tagging"].(map[string]interface{}); ok && len(v) > 0 {
+		operation.NewObjectTagging = expandS3ControlJobTags(v)
+	}
+
+	if v, ok := m["requester_pays"].(bool); ok && v {
+		operation.RequesterPays = aws.Bool(v)
+	}
+
+	if v, ok := m["sse_aws_kms_key_id"].(string); ok && v != "" {
+		operation.SSEAwsKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := m["storage_class"].(string); ok && v != "" {
+		operation.StorageClass = aws.String(v)
+	}
+
+	if v, ok := m["target_key_prefix"].(string); ok && v != "" {
+		operation.TargetKeyPrefix = aws.String(v)
+	}
+
+	return operation
+}
+
+func flattenS3ControlJobOperation(operation *s3control.JobOperation) []interface{} {
+	if operation == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := operation.LambdaInvoke; v != nil {
+		m["lambda_invoke"] = []interface{}{
+			map[string]interface{}{
+				"function_arn": aws.StringValue(v.FunctionArn),
+			},
+		}
+	}
+
+	if v := operation.S3InitiateRestoreObject; v != nil {
+		m["s3_initiate_restore_object"] = []interface{}{
+			map[string]interface{}{
+				"expiration_in_days": int(aws.Int64Value(v.ExpirationInDays)),
+				"glacier_job_tier":   aws.StringValue(v.GlacierJobTier),
+			},
+		}
+	}
+
+	if v := operation.S3PutObjectAcl; v != nil && v.AccessControlPolicy != nil {
+		m["s3_put_object_acl"] = []interface{}{
+			map[string]interface{}{
+				"canned_access_control_list": aws.StringValue(v.AccessControlPolicy.CannedAccessControlList),
+			},
+		}
+	}
+
+	if v := operation.S3PutObjectCopy; v != nil {
+		om := map[string]interface{}{
+			"canned_access_control_list": aws.StringValue(v.CannedAccessControlList),
+			"metadata_directive":         aws.StringValue(v.MetadataDirective),
+			"new_object_tagging":         flattenS3ControlJobTags(v.NewObjectTagging),
+			"requester_pays":             aws.BoolValue(v.RequesterPays),
+			"sse_aws_kms_key_id":         aws.StringValue(v.SSEAwsKmsKeyId),
+			"storage_class":              aws.StringValue(v.StorageClass),
+			"target_key_prefix":          aws.StringValue(v.TargetKeyPrefix),
+			"target_resource":            aws.StringValue(v.TargetResource),
+		}
+
+		if metadata := v.NewObjectMetadata; metadata != nil {
+			om["new_object_metadata"] = []interface{}{
+				map[string]interface{}{
+					"cache_control":       aws.StringValue(metadata.CacheControl),
+					"content_disposition": aws.StringValue(metadata.ContentDisposition),
+					"content_encoding":    aws.StringValue(metadata.ContentEncoding),
+					"content_language":    aws.StringValue(metadata.ContentLanguage),
+					"content_type":        aws.StringValue(metadata.ContentType),
+					"sse_algorithm":       aws.StringValue(metadata.SSEAlgorithm),
+					"user_metadata":       pointersMapToStringList(metadata.UserMetadata),
+				},
+			}
+		}
+
+		m["s3_put_object_copy"] = []interface{}{om}
+	}
+
+	if v := operation.S3PutObjectTagging; v != nil {
+		m["s3_put_object_tagging"] = []interface{}{
+			map[string]interface{}{
+				"tag_set": flattenS3ControlJobTags(v.TagSet),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandS3ControlJobTags(m map[string]interface{}) []*s3control.S3Tag {
+	tags := make([]*s3control.S3Tag, 0, len(m))
+
+	for k, v := range m {
+		tags = append(tags, &s3control.S3Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return tags
+}
+
+func flattenS3ControlJobTags(tags []*s3control.S3Tag) map[string]interface{} {
+	m := make(map[string]interface{}, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return m
+}
+
+func expandS3ControlJobReport(l []interface{}) *s3control.JobReport {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	report := &s3control.JobReport{
+		Enabled: aws.Bool(m["enabled"].(bool)),
+	}
+
+	if v, ok := m["bucket"].(string); ok && v != "" {
+		report.Bucket = aws.String(v)
+	}
+
+	if v, ok := m["format"].(string); ok && v != "" {
+		report.Format = aws.String(v)
+	}
+
+	if v, ok := m["prefix"].(string); ok && v != "" {
+		report.Prefix = aws.String(v)
+	}
+
+	if v, ok := m["report_scope"].(string); ok && v != "" {
+		report.ReportScope = aws.String(v)
+	}
+
+	return report
+}
+
+func flattenS3ControlJobReport(report *s3control.JobReport) []interface{} {
+	if report == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"bucket":       aws.StringValue(report.Bucket),
+		"enabled":      aws.BoolValue(report.Enabled),
+		"format":       aws.StringValue(report.Format),
+		"prefix":       aws.StringValue(report.Prefix),
+		"report_scope": aws.StringValue(report.ReportScope),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenS3ControlJobProgressSummary(summary *s3control.JobProgressSummary) []interface{} {
+	if summary == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"number_of_tasks_failed":    int(aws.Int64Value(summary.NumberOfTasksFailed)),
+		"number_of_tasks_succeeded": int(aws.Int64Value(summary.NumberOfTasksSucceeded)),
+		"total_number_of_tasks":     int(aws.Int64Value(summary.TotalNumberOfTasks)),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenS3ControlJobFailures(failures []*s3control.JobFailure) []interface{} {
+	l := make([]interface{}, 0, len(failures))
+
+	for _, failure := range failures {
+		l = append(l, map[string]interface{}{
+			"failure_code":   aws.StringValue(failure.FailureCode),
+			"failure_reason": aws.StringValue(failure.FailureReason),
+		})
+	}
+
+	return l
+}
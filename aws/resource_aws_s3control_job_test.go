@@ -0,0 +1,428 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandS3ControlJobOperation(t *testing.T) {
+	testCases := []struct {
+		Config            []interface{}
+		ExpectedOperation *s3control.JobOperation
+		ExpectError       bool
+	}{
+		{
+			Config:      []interface{}{},
+			ExpectError: true,
+		},
+		{
+			Config: []interface{}{
+				map[string]interface{}{
+					"lambda_invoke":         []interface{}{},
+					"s3_put_object_tagging": []interface{}{nil},
+				},
+			},
+			ExpectedOperation: &s3control.JobOperation{
+				S3PutObjectTagging: &s3control.S3SetObjectTaggingOperation{
+					TagSet: []*s3control.S3Tag{},
+				},
+			},
+		},
+		{
+			Config: []interface{}{
+				map[string]interface{}{
+					"s3_put_object_tagging": []interface{}{
+						map[string]interface{}{
+							"tag_set": map[string]interface{}{
+								"key1": "value1",
+							},
+						},
+					},
+				},
+			},
+			ExpectedOperation: &s3control.JobOperation{
+				S3PutObjectTagging: &s3control.S3SetObjectTaggingOperation{
+					TagSet: []*s3control.S3Tag{
+						{
+							Key:   aws.String("key1"),
+							Value: aws.String("value1"),
+						},
+					},
+				},
+			},
+		},
+		{
+			Config: []interface{}{
+				map[string]interface{}{
+					"s3_put_object_copy": []interface{}{
+						map[string]interface{}{
+							"canned_access_control_list": "",
+							"metadata_directive":         "",
+							"new_object_metadata": []interface{}{
+								map[string]interface{}{
+									"sse_algorithm": s3control.S3SSEAlgorithmKms,
+								},
+							},
+							"new_object_tagging": map[string]interface{}{},
+							"requester_pays":     false,
+							"sse_aws_kms_key_id": "arn:aws:kms:us-west-2:123456789012:key/example",
+							"storage_class":      s3control.S3StorageClassStandardIa,
+							"target_key_prefix":  "",
+							"target_resource":    "arn:aws:s3:::example",
+						},
+					},
+				},
+			},
+			ExpectedOperation: &s3control.JobOperation{
+				S3PutObjectCopy: &s3control.S3CopyObjectOperation{
+					NewObjectMetadata: &s3control.S3ObjectMetadata{
+						SSEAlgorithm: aws.String(s3control.S3SSEAlgorithmKms),
+					},
+					SSEAwsKmsKeyId: aws.String("arn:aws:kms:us-west-2:123456789012:key/example"),
+					StorageClass:   aws.String(s3control.S3StorageClassStandardIa),
+					TargetResource: aws.String("arn:aws:s3:::example"),
+				},
+			},
+		},
+		{
+			Config: []interface{}{
+				map[string]interface{}{
+					"lambda_invoke": []interface{}{
+						map[string]interface{}{
+							"function_arn": "arn:aws:lambda:us-west-2:123456789012:function:example",
+						},
+					},
+					"s3_put_object_acl": []interface{}{
+						map[string]interface{}{
+							"canned_access_control_list": s3control.S3CannedAccessControlListPrivate,
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		operation, err := expandS3ControlJobOperation(tc.Config)
+
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("Case #%d: expected error", i)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Case #%d: unexpected error: %s", i, err)
+		}
+
+		if !reflect.DeepEqual(operation, tc.ExpectedOperation) {
+			t.Fatalf("Case #%d: Given:\n%s\n\nExpected:\n%s", i, operation, tc.ExpectedOperation)
+		}
+	}
+}
+
+func TestFlattenS3ControlJobOperation(t *testing.T) {
+	operation := &s3control.JobOperation{
+		S3InitiateRestoreObject: &s3control.S3InitiateRestoreObjectOperation{
+			ExpirationInDays: aws.Int64(7),
+			GlacierJobTier:   aws.String(s3control.S3GlacierJobTierBulk),
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"s3_initiate_restore_object": []interface{}{
+				map[string]interface{}{
+					"expiration_in_days": 7,
+					"glacier_job_tier":   s3control.S3GlacierJobTierBulk,
+				},
+			},
+		},
+	}
+
+	if got := flattenS3ControlJobOperation(operation); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Given:\n%#v\n\nExpected:\n%#v", got, expected)
+	}
+}
+
+func TestAccAWSS3ControlJob_basic(t *testing.T) {
+	var job s3control.JobDescriptor
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlJobConfig_PutObjectTagging(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &job),
+					testAccCheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.Migrated", "true"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.total_number_of_tasks", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusComplete),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlJob_ConfirmationRequired(t *testing.T) {
+	var job s3control.JobDescriptor
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlJobConfig_Confirmed(rName, 10, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusSuspended),
+				),
+			},
+			{
+				Config: testAccAWSS3ControlJobConfig_Confirmed(rName, 20, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusSuspended),
+				),
+			},
+			{
+				Config: testAccAWSS3ControlJobConfig_Confirmed(rName, 20, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "confirmed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusComplete),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3ControlJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_job" {
+			continue
+		}
+
+		job, err := describeS3ControlJob(conn, rs.Primary.Attributes["account_id"], rs.Primary.ID)
+
+		if isAWSErr(err, s3control.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Jobs are retained after they finish, so a destroyed job is one that
+		// does no further work.
+		switch status := aws.StringValue(job.Status); status {
+		case s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed:
+		default:
+			return fmt.Errorf("S3 Control Job (%s) still has status %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3ControlJobExists(n string, res *s3control.JobDescriptor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Control Job ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+		job, err := describeS3ControlJob(conn, rs.Primary.Attributes["account_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if job == nil {
+			return fmt.Errorf("S3 Control Job (%s) not found", rs.Primary.ID)
+		}
+
+		*res = *job
+
+		return nil
+	}
+}
+
+func testAccAWSS3ControlJobConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket  = "${aws_s3_bucket.test.id}"
+  key     = "data/object"
+  content = "test"
+}
+
+resource "aws_s3_bucket_object" "manifest" {
+  bucket  = "${aws_s3_bucket.test.id}"
+  key     = "manifest.csv"
+  content = "${aws_s3_bucket.test.id},${aws_s3_bucket_object.object.key}\n"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "batchoperations.s3.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:PutObjectTagging",
+        "s3:PutObjectVersionTagging"
+      ],
+      "Resource": "${aws_s3_bucket.test.arn}/*"
+    }
+  ]
+}
+POLICY
+}
+`, rName)
+}
+
+func testAccAWSS3ControlJobConfig_PutObjectTagging(rName string) string {
+	return testAccAWSS3ControlJobConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  depends_on = ["aws_iam_role_policy.test"]
+
+  description = %[1]q
+  role_arn    = "${aws_iam_role.test.arn}"
+
+  manifest {
+    location {
+      etag       = "${aws_s3_bucket_object.manifest.etag}"
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_bucket_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Migrated = "true"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+}
+`, rName)
+}
+
+func testAccAWSS3ControlJobConfig_Confirmed(rName string, priority int, confirmed bool) string {
+	return testAccAWSS3ControlJobConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  depends_on = ["aws_iam_role_policy.test"]
+
+  confirmation_required = true
+  confirmed             = %[3]t
+  priority              = %[2]d
+  role_arn              = "${aws_iam_role.test.arn}"
+
+  manifest {
+    location {
+      etag       = "${aws_s3_bucket_object.manifest.etag}"
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_bucket_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Migrated = "true"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+}
+`, rName, priority, confirmed)
+}
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -11,10 +10,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_endpoint_configuration", &resource.Sweeper{
+	addTestSweepers("aws_sagemaker_endpoint_configuration", &resource.Sweeper{
 		Name: "aws_sagemaker_endpoint_configuration",
 		Dependencies: []string{
 			"aws_sagemaker_model",
@@ -38,23 +38,24 @@ func testSweepSagemakerEndpointConfigurations(region string) error {
 		return fmt.Errorf("error listing endpoint configs: %s", err)
 	}
 
-	if len(resp.EndpointConfigs) == 0 {
-		log.Print("[DEBUG] No SageMaker endpoint config to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, endpointConfig := range resp.EndpointConfigs {
-		_, err := conn.DeleteEndpointConfig(&sagemaker.DeleteEndpointConfigInput{
-			EndpointConfigName: endpointConfig.EndpointConfigName,
+		name := aws.StringValue(endpointConfig.EndpointConfigName)
+
+		resources = append(resources, &sweep.Resource{
+			ID:        name,
+			CreatedAt: aws.TimeValue(endpointConfig.CreationTime),
+			Delete: func() error {
+				_, err := conn.DeleteEndpointConfig(&sagemaker.DeleteEndpointConfigInput{
+					EndpointConfigName: aws.String(name),
+				})
+				return err
+			},
 		})
-		if err != nil {
-			return fmt.Errorf(
-				"failed to delete SageMaker endpoint config (%s): %s",
-				*endpointConfig.EndpointConfigName, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_sagemaker_endpoint_configuration", sweep.Filter{}, resources)
 }
 
 func TestAccAWSSagemakerEndpointConfiguration_Basic(t *testing.T) {
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -10,10 +9,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_endpoint", &resource.Sweeper{
+	addTestSweepers("aws_sagemaker_endpoint", &resource.Sweeper{
 		Name: "aws_sagemaker_endpoint",
 		Dependencies: []string{
 			"aws_sagemaker_model",
@@ -38,23 +38,24 @@ func testSweepSagemakerEndpoints(region string) error {
 		return fmt.Errorf("error listing endpoints: %s", err)
 	}
 
-	if len(resp.Endpoints) == 0 {
-		log.Print("[DEBUG] No SageMaker Endpoint to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, endpoint := range resp.Endpoints {
-		_, err := conn.DeleteEndpoint(&sagemaker.DeleteEndpointInput{
-			EndpointName: endpoint.EndpointName,
+		name := aws.StringValue(endpoint.EndpointName)
+
+		resources = append(resources, &sweep.Resource{
+			ID:        name,
+			CreatedAt: aws.TimeValue(endpoint.CreationTime),
+			Delete: func() error {
+				_, err := conn.DeleteEndpoint(&sagemaker.DeleteEndpointInput{
+					EndpointName: aws.String(name),
+				})
+				return err
+			},
 		})
-		if err != nil {
-			return fmt.Errorf(
-				"error deleting SageMaker Endpoint (%s): %s",
-				*endpoint.EndpointName, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_sagemaker_endpoint", sweep.Filter{}, resources)
 }
 
 func TestAccAWSSagemakerEndpoint_basic(t *testing.T) {
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsSagemakerModel() *schema.Resource {
@@ -178,7 +179,7 @@ func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if v, ok := d.GetOk("tags"); ok {
-		createOpts.SetTags(tagsFromMapSagemaker(v.(map[string]interface{})))
+		createOpts.SetTags(keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().SagemakerTags())
 	}
 
 	if v, ok := d.GetOk("vpc_config"); ok {
@@ -191,7 +192,7 @@ func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	log.Printf("[DEBUG] Sagemaker model create config: %#v", *createOpts)
-	_, err := retryOnAwsCode("ValidationException", func() (interface{}, error) {
+	_, err := retryOnAwsCode(meta, "ValidationException", func() (interface{}, error) {
 		return conn.CreateModel(createOpts)
 	})
 
@@ -262,7 +263,7 @@ func resourceAwsSagemakerModelRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error listing tags of Sagemaker model %s: %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapSagemaker(tagsOutput.Tags)); err != nil {
+	if err := d.Set("tags", keyvaluetags.SagemakerKeyValueTags(tagsOutput.Tags).IgnoreAws().Map()); err != nil {
 		return err
 	}
 	return nil
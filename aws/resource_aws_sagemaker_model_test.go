@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 const (
@@ -18,7 +18,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_model", &resource.Sweeper{
+	addTestSweepers("aws_sagemaker_model", &resource.Sweeper{
 		Name: "aws_sagemaker_model",
 		F:    testSweepSagemakerModels,
 	})
@@ -37,23 +37,24 @@ func testSweepSagemakerModels(region string) error {
 		return fmt.Errorf("error listing models: %s", err)
 	}
 
-	if len(resp.Models) == 0 {
-		log.Print("[DEBUG] No sagemaker models to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, model := range resp.Models {
-		_, err := conn.DeleteModel(&sagemaker.DeleteModelInput{
-			ModelName: model.ModelName,
+		name := aws.StringValue(model.ModelName)
+
+		resources = append(resources, &sweep.Resource{
+			ID:        name,
+			CreatedAt: aws.TimeValue(model.CreationTime),
+			Delete: func() error {
+				_, err := conn.DeleteModel(&sagemaker.DeleteModelInput{
+					ModelName: aws.String(name),
+				})
+				return err
+			},
 		})
-		if err != nil {
-			return fmt.Errorf(
-				"error deleting sagemaker model (%s): %s",
-				*model.ModelName, err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_sagemaker_model", sweep.Filter{}, resources)
 }
 
 func TestAccAWSSagemakerModel_basic(t *testing.T) {
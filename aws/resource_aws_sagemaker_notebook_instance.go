@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsSagemakerNotebookInstance() *schema.Resource {
@@ -103,7 +104,7 @@ func resourceAwsSagemakerNotebookInstanceCreate(d *schema.ResourceData, meta int
 
 	if v, ok := d.GetOk("tags"); ok {
 		tagsIn := v.(map[string]interface{})
-		createOpts.Tags = tagsFromMapSagemaker(tagsIn)
+		createOpts.Tags = keyvaluetags.New(tagsIn).IgnoreAws().SagemakerTags()
 	}
 
 	log.Printf("[DEBUG] sagemaker notebook instance create config: %#v", *createOpts)
@@ -184,7 +185,7 @@ func resourceAwsSagemakerNotebookInstanceRead(d *schema.ResourceData, meta inter
 		return fmt.Errorf("error listing tags for sagemaker notebook instance (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapSagemaker(tagsOutput.Tags)); err != nil {
+	if err := d.Set("tags", keyvaluetags.SagemakerKeyValueTags(tagsOutput.Tags).IgnoreAws().Map()); err != nil {
 		return fmt.Errorf("error setting tags for notebook instance (%s): %s", d.Id(), err)
 	}
 	return nil
@@ -12,10 +12,12 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/jen20/awspolicyequivalence"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_secretsmanager_secret", &resource.Sweeper{
+	addTestSweepers("aws_secretsmanager_secret", &resource.Sweeper{
 		Name: "aws_secretsmanager_secret",
 		F:    testSweepSecretsManagerSecrets,
 	})
@@ -28,28 +30,28 @@ func testSweepSecretsManagerSecrets(region string) error {
 	}
 	conn := client.(*AWSClient).secretsmanagerconn
 
-	err = conn.ListSecretsPages(&secretsmanager.ListSecretsInput{}, func(page *secretsmanager.ListSecretsOutput, isLast bool) bool {
-		if len(page.SecretList) == 0 {
-			log.Print("[DEBUG] No Secrets Manager Secrets to sweep")
-			return true
-		}
+	var resources []*sweep.Resource
 
+	err = conn.ListSecretsPages(&secretsmanager.ListSecretsInput{}, func(page *secretsmanager.ListSecretsOutput, isLast bool) bool {
 		for _, secret := range page.SecretList {
 			name := aws.StringValue(secret.Name)
 
-			log.Printf("[INFO] Deleting Secrets Manager Secret: %s", name)
-			input := &secretsmanager.DeleteSecretInput{
-				ForceDeleteWithoutRecovery: aws.Bool(true),
-				SecretId:                   aws.String(name),
-			}
-
-			_, err := conn.DeleteSecret(input)
-			if err != nil {
-				if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
-					continue
-				}
-				log.Printf("[ERROR] Failed to delete Secrets Manager Secret (%s): %s", name, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:   name,
+				Tags: keyvaluetags.SecretsmanagerKeyValueTags(secret.Tags),
+				Delete: func() error {
+					input := &secretsmanager.DeleteSecretInput{
+						ForceDeleteWithoutRecovery: aws.Bool(true),
+						SecretId:                   aws.String(name),
+					}
+
+					_, err := conn.DeleteSecret(input)
+					if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+						return nil
+					}
+					return err
+				},
+			})
 		}
 
 		return !isLast
@@ -61,6 +63,11 @@ func testSweepSecretsManagerSecrets(region string) error {
 		}
 		return fmt.Errorf("Error retrieving Secrets Manager Secrets: %s", err)
 	}
+
+	if err := testSweepResources(region, "aws_secretsmanager_secret", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
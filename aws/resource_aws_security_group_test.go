@@ -18,11 +18,13 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // add sweeper to delete known test sgs
 func init() {
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	addTestSweepers("aws_security_group", &resource.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
@@ -39,36 +41,75 @@ func testSweepSecurityGroups(region string) error {
 	conn := client.(*AWSClient).ec2conn
 
 	input := &ec2.DescribeSecurityGroupsInput{}
+	var rules, groups []*sweep.Resource
 
-	// Delete all non-default EC2 Security Group Rules to prevent DependencyViolation errors
 	err = conn.DescribeSecurityGroupsPages(input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
 		for _, sg := range page.SecurityGroups {
+			id := aws.StringValue(sg.GroupId)
+
 			if aws.StringValue(sg.GroupName) == "default" {
-				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.StringValue(sg.GroupId))
+				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", id)
 				continue
 			}
 
-			if sg.IpPermissions != nil {
-				req := &ec2.RevokeSecurityGroupIngressInput{
-					GroupId:       sg.GroupId,
-					IpPermissions: sg.IpPermissions,
-				}
+			ingress := sg.IpPermissions
+			egress := sg.IpPermissionsEgress
+			tags := keyvaluetags.Ec2KeyValueTags(sg.Tags)
+
+			rules = append(rules, &sweep.Resource{
+				ID:   id,
+				Name: aws.StringValue(sg.GroupName),
+				Tags: tags,
+				Delete: func() error {
+					if ingress != nil {
+						req := &ec2.RevokeSecurityGroupIngressInput{
+							GroupId:       aws.String(id),
+							IpPermissions: ingress,
+						}
 
-				if _, err = conn.RevokeSecurityGroupIngress(req); err != nil {
-					log.Printf("[ERROR] Error revoking ingress rule for Security Group (%s): %s", aws.StringValue(sg.GroupId), err)
-				}
-			}
+						if _, err := conn.RevokeSecurityGroupIngress(req); err != nil {
+							log.Printf("[ERROR] Error revoking ingress rule for Security Group (%s): %s", id, err)
+						}
+					}
 
-			if sg.IpPermissionsEgress != nil {
-				req := &ec2.RevokeSecurityGroupEgressInput{
-					GroupId:       sg.GroupId,
-					IpPermissions: sg.IpPermissionsEgress,
-				}
+					if egress != nil {
+						req := &ec2.RevokeSecurityGroupEgressInput{
+							GroupId:       aws.String(id),
+							IpPermissions: egress,
+						}
 
-				if _, err = conn.RevokeSecurityGroupEgress(req); err != nil {
-					log.Printf("[ERROR] Error revoking egress rule for Security Group (%s): %s", aws.StringValue(sg.GroupId), err)
-				}
-			}
+						if _, err := conn.RevokeSecurityGroupEgress(req); err != nil {
+							log.Printf("[ERROR] Error revoking egress rule for Security Group (%s): %s", id, err)
+						}
+					}
+
+					return nil
+				},
+			})
+
+			groups = append(groups, &sweep.Resource{
+				ID:   id,
+				Name: aws.StringValue(sg.GroupName),
+				Tags: tags,
+				Delete: func() error {
+					input := &ec2.DeleteSecurityGroupInput{
+						GroupId: aws.String(id),
+					}
+
+					// Handle EC2 eventual consistency
+					return resource.Retry(1*time.Minute, func() *resource.RetryError {
+						_, err := conn.DeleteSecurityGroup(input)
+
+						if isAWSErr(err, "DependencyViolation", "") {
+							return resource.RetryableError(err)
+						}
+						if err != nil {
+							return resource.NonRetryableError(err)
+						}
+						return nil
+					})
+				},
+			})
 		}
 
 		return !lastPage
@@ -83,40 +124,14 @@ func testSweepSecurityGroups(region string) error {
 		return fmt.Errorf("Error retrieving EC2 Security Groups: %s", err)
 	}
 
-	err = conn.DescribeSecurityGroupsPages(input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
-		for _, sg := range page.SecurityGroups {
-			if aws.StringValue(sg.GroupName) == "default" {
-				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.StringValue(sg.GroupId))
-				continue
-			}
-
-			input := &ec2.DeleteSecurityGroupInput{
-				GroupId: sg.GroupId,
-			}
-
-			// Handle EC2 eventual consistency
-			err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteSecurityGroup(input)
-
-				if isAWSErr(err, "DependencyViolation", "") {
-					return resource.RetryableError(err)
-				}
-				if err != nil {
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Security Group (%s): %s", aws.StringValue(sg.GroupId), err)
-			}
-		}
-
-		return !lastPage
-	})
+	// Delete all EC2 Security Group Rules first to prevent DependencyViolation
+	// errors between groups that reference each other
+	if err := testSweepResources(region, "aws_security_group_rule", sweep.Filter{}, rules); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
 
-	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 Security Groups: %s", err)
+	if err := testSweepResources(region, "aws_security_group", sweep.Filter{}, groups); err != nil {
+		log.Printf("[ERROR] %s", err)
 	}
 
 	return nil
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // Mutable attributes
@@ -66,7 +67,7 @@ func resourceAwsSnsTopic() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     validation.ValidateJsonString,
+				ValidateFunc:     validateIAMResourcePolicy(0),
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
@@ -151,7 +152,7 @@ func resourceAwsSnsTopic() *schema.Resource {
 
 func resourceAwsSnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
 	snsconn := meta.(*AWSClient).snsconn
-	tags := tagsFromMapSNS(d.Get("tags").(map[string]interface{}))
+	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().SnsTags()
 	var name string
 	if v, ok := d.GetOk("name"); ok {
 		name = v.(string)
@@ -183,7 +184,7 @@ func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 	for terraformAttrName, snsAttrName := range SNSAttributeMap {
 		if d.HasChange(terraformAttrName) {
 			_, terraformAttrValue := d.GetChange(terraformAttrName)
-			err := updateAwsSnsTopicAttribute(d.Id(), snsAttrName, terraformAttrValue, conn)
+			err := updateAwsSnsTopicAttribute(d.Id(), snsAttrName, terraformAttrValue, conn, meta)
 			if err != nil {
 				return err
 			}
@@ -245,7 +246,7 @@ func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error listing SNS Topic tags for %s: %s", d.Id(), err)
 	}
-	if err := d.Set("tags", tagsToMapSNS(tagList.Tags)); err != nil {
+	if err := d.Set("tags", keyvaluetags.SnsKeyValueTags(tagList.Tags).IgnoreAws().Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -263,7 +264,7 @@ func resourceAwsSnsTopicDelete(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
-func updateAwsSnsTopicAttribute(topicArn, name string, value interface{}, conn *sns.SNS) error {
+func updateAwsSnsTopicAttribute(topicArn, name string, value interface{}, conn *sns.SNS, meta interface{}) error {
 	// Ignore an empty policy
 	if name == "Policy" && value == "" {
 		return nil
@@ -280,7 +281,7 @@ func updateAwsSnsTopicAttribute(topicArn, name string, value interface{}, conn *
 	// Retry the update in the event of an eventually consistent style of
 	// error, where say an IAM resource is successfully created but not
 	// actually available. See https://github.com/hashicorp/terraform/issues/3660
-	_, err := retryOnAwsCode(sns.ErrCodeInvalidParameterException, func() (interface{}, error) {
+	_, err := retryOnAwsCode(meta, sns.ErrCodeInvalidParameterException, func() (interface{}, error) {
 		return conn.SetTopicAttributes(&req)
 	})
 
@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	addTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    testSweepSpotFleetRequests,
 	})
@@ -29,20 +30,19 @@ func testSweepSpotFleetRequests(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 
-	err = conn.DescribeSpotFleetRequestsPages(&ec2.DescribeSpotFleetRequestsInput{}, func(page *ec2.DescribeSpotFleetRequestsOutput, isLast bool) bool {
-		if len(page.SpotFleetRequestConfigs) == 0 {
-			log.Print("[DEBUG] No Spot Fleet Requests to sweep")
-			return false
-		}
+	var resources []*sweep.Resource
 
+	err = conn.DescribeSpotFleetRequestsPages(&ec2.DescribeSpotFleetRequestsInput{}, func(page *ec2.DescribeSpotFleetRequestsOutput, isLast bool) bool {
 		for _, config := range page.SpotFleetRequestConfigs {
 			id := aws.StringValue(config.SpotFleetRequestId)
 
-			log.Printf("[INFO] Deleting Spot Fleet Request: %s", id)
-			err := deleteSpotFleetRequest(id, true, 5*time.Minute, conn)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Spot Fleet Request (%s): %s", id, err)
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				CreatedAt: aws.TimeValue(config.CreateTime),
+				Delete: func() error {
+					return deleteSpotFleetRequest(id, true, 5*time.Minute, conn)
+				},
+			})
 		}
 		return !isLast
 	})
@@ -53,6 +53,11 @@ func testSweepSpotFleetRequests(region string) error {
 		}
 		return fmt.Errorf("Error retrieving EC2 Spot Fleet Requests: %s", err)
 	}
+
+	if err := testSweepResources(region, "aws_spot_fleet_request", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
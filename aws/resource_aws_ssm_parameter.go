@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsSsmParameter() *schema.Resource {
@@ -157,7 +158,7 @@ func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error
 	}); err != nil {
 		return fmt.Errorf("Failed to get SSM parameter tags for %s: %s", d.Get("name"), err)
 	} else {
-		d.Set("tags", tagsToMapSSM(tagList.TagList))
+		d.Set("tags", keyvaluetags.SsmKeyValueTags(tagList.TagList).IgnoreAws().Map())
 	}
 
 	arn := arn.ARN{
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_storagegateway_gateway", &resource.Sweeper{
+	addTestSweepers("aws_storagegateway_gateway", &resource.Sweeper{
 		Name: "aws_storagegateway_gateway",
 		F:    testSweepStorageGatewayGateways,
 	})
@@ -27,27 +28,27 @@ func testSweepStorageGatewayGateways(region string) error {
 	}
 	conn := client.(*AWSClient).storagegatewayconn
 
-	err = conn.ListGatewaysPages(&storagegateway.ListGatewaysInput{}, func(page *storagegateway.ListGatewaysOutput, isLast bool) bool {
-		if len(page.Gateways) == 0 {
-			log.Print("[DEBUG] No Storage Gateway Gateways to sweep")
-			return true
-		}
+	var resources []*sweep.Resource
 
+	err = conn.ListGatewaysPages(&storagegateway.ListGatewaysInput{}, func(page *storagegateway.ListGatewaysOutput, isLast bool) bool {
 		for _, gateway := range page.Gateways {
-			name := aws.StringValue(gateway.GatewayName)
-
-			log.Printf("[INFO] Deleting Storage Gateway Gateway: %s", name)
-			input := &storagegateway.DeleteGatewayInput{
-				GatewayARN: gateway.GatewayARN,
-			}
-
-			_, err := conn.DeleteGateway(input)
-			if err != nil {
-				if isAWSErr(err, storagegateway.ErrorCodeGatewayNotFound, "") {
-					continue
-				}
-				log.Printf("[ERROR] Failed to delete Storage Gateway Gateway (%s): %s", name, err)
-			}
+			arn := aws.StringValue(gateway.GatewayARN)
+
+			resources = append(resources, &sweep.Resource{
+				ID:   arn,
+				Name: aws.StringValue(gateway.GatewayName),
+				Delete: func() error {
+					input := &storagegateway.DeleteGatewayInput{
+						GatewayARN: aws.String(arn),
+					}
+
+					_, err := conn.DeleteGateway(input)
+					if isAWSErr(err, storagegateway.ErrorCodeGatewayNotFound, "") {
+						return nil
+					}
+					return err
+				},
+			})
 		}
 
 		return !isLast
@@ -59,6 +60,11 @@ func testSweepStorageGatewayGateways(region string) error {
 		}
 		return fmt.Errorf("Error retrieving Storage Gateway Gateways: %s", err)
 	}
+
+	if err := testSweepResources(region, "aws_storagegateway_gateway", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	return nil
 }
 
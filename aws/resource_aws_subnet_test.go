@@ -12,17 +12,19 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // add sweeper to delete known test subnets
 func init() {
-	resource.AddTestSweepers("aws_subnet", &resource.Sweeper{
+	addTestSweepers("aws_subnet", &resource.Sweeper{
 		Name: "aws_subnet",
 		F:    testSweepSubnets,
 		Dependencies: []string{
 			"aws_autoscaling_group",
 			"aws_batch_compute_environment",
-			"aws_beanstalk_environment",
+			"aws_elastic_beanstalk_environment",
 			"aws_db_subnet_group",
 			"aws_directory_service_directory",
 			"aws_ec2_client_vpn_endpoint",
@@ -62,10 +64,7 @@ func testSweepSubnets(region string) error {
 		return fmt.Errorf("Error describing subnets: %s", err)
 	}
 
-	if len(resp.Subnets) == 0 {
-		log.Print("[DEBUG] No aws subnets to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, subnet := range resp.Subnets {
 		if subnet == nil {
@@ -76,31 +75,35 @@ func testSweepSubnets(region string) error {
 			continue
 		}
 
-		input := &ec2.DeleteSubnetInput{
-			SubnetId: subnet.SubnetId,
-		}
+		id := aws.StringValue(subnet.SubnetId)
 
-		// Handle eventual consistency, especially with lingering ENIs from Load Balancers and Lambda
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-			_, err := conn.DeleteSubnet(input)
+		resources = append(resources, &sweep.Resource{
+			ID:   id,
+			Tags: keyvaluetags.Ec2KeyValueTags(subnet.Tags),
+			Delete: func() error {
+				input := &ec2.DeleteSubnetInput{
+					SubnetId: aws.String(id),
+				}
 
-			if isAWSErr(err, "DependencyViolation", "") {
-				return resource.RetryableError(err)
-			}
+				// Handle eventual consistency, especially with lingering ENIs from Load Balancers and Lambda
+				return resource.Retry(5*time.Minute, func() *resource.RetryError {
+					_, err := conn.DeleteSubnet(input)
 
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
+					if isAWSErr(err, "DependencyViolation", "") {
+						return resource.RetryableError(err)
+					}
 
-			return nil
-		})
+					if err != nil {
+						return resource.NonRetryableError(err)
+					}
 
-		if err != nil {
-			return fmt.Errorf("Error deleting Subnet (%s): %s", aws.StringValue(subnet.SubnetId), err)
-		}
+					return nil
+				})
+			},
+		})
 	}
 
-	return nil
+	return testSweepResources(region, "aws_subnet", sweep.Filter{}, resources)
 }
 
 func TestAccAWSSubnet_importBasic(t *testing.T) {
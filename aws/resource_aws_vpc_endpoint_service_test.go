@@ -12,10 +12,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	addTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    testSweepEc2VpcEndpointServices,
 		Dependencies: []string{
@@ -31,6 +33,7 @@ func testSweepEc2VpcEndpointServices(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 	input := &ec2.DescribeVpcEndpointServiceConfigurationsInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.DescribeVpcEndpointServiceConfigurations(input)
@@ -50,24 +53,32 @@ func testSweepEc2VpcEndpointServices(region string) error {
 			}
 
 			id := aws.StringValue(serviceConfiguration.ServiceId)
-			input := &ec2.DeleteVpcEndpointServiceConfigurationsInput{
-				ServiceIds: []*string{serviceConfiguration.ServiceId},
-			}
 
-			log.Printf("[INFO] Deleting EC2 VPC Endpoint Service: %s", id)
-			_, err := conn.DeleteVpcEndpointServiceConfigurations(input)
+			resources = append(resources, &sweep.Resource{
+				ID:   id,
+				Tags: keyvaluetags.Ec2KeyValueTags(serviceConfiguration.Tags),
+				Delete: func() error {
+					input := &ec2.DeleteVpcEndpointServiceConfigurationsInput{
+						ServiceIds: aws.StringSlice([]string{id}),
+					}
 
-			if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
-				continue
-			}
+					_, err := conn.DeleteVpcEndpointServiceConfigurations(input)
 
-			if err != nil {
-				return fmt.Errorf("error deleting EC2 VPC Endpoint Service (%s): %s", id, err)
-			}
+					if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+						return nil
+					}
 
-			if err := waitForVpcEndpointServiceDeletion(conn, id); err != nil {
-				return fmt.Errorf("error waiting for VPC Endpoint Service (%s) to delete: %s", id, err)
-			}
+					if err != nil {
+						return err
+					}
+
+					if err := waitForVpcEndpointServiceDeletion(conn, id); err != nil {
+						return fmt.Errorf("error waiting for deletion: %s", err)
+					}
+
+					return nil
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -77,7 +88,7 @@ func testSweepEc2VpcEndpointServices(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return testSweepResources(region, "aws_vpc_endpoint_service", sweep.Filter{}, resources)
 }
 
 func TestAccAWSVpcEndpointService_importBasic(t *testing.T) {
@@ -15,10 +15,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	addTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    testSweepEc2VpcEndpoints,
 	})
@@ -31,6 +33,7 @@ func testSweepEc2VpcEndpoints(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 	input := &ec2.DescribeVpcEndpointsInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.DescribeVpcEndpoints(input)
@@ -51,24 +54,32 @@ func testSweepEc2VpcEndpoints(region string) error {
 
 			id := aws.StringValue(vpcEndpoint.VpcEndpointId)
 
-			input := &ec2.DeleteVpcEndpointsInput{
-				VpcEndpointIds: []*string{aws.String(id)},
-			}
+			resources = append(resources, &sweep.Resource{
+				ID:        id,
+				Tags:      keyvaluetags.Ec2KeyValueTags(vpcEndpoint.Tags),
+				CreatedAt: aws.TimeValue(vpcEndpoint.CreationTimestamp),
+				Delete: func() error {
+					input := &ec2.DeleteVpcEndpointsInput{
+						VpcEndpointIds: []*string{aws.String(id)},
+					}
 
-			log.Printf("[INFO] Deleting EC2 VPC Endpoint: %s", id)
-			_, err := conn.DeleteVpcEndpoints(input)
+					_, err := conn.DeleteVpcEndpoints(input)
 
-			if isAWSErr(err, "InvalidVpcEndpointId.NotFound", "") {
-				continue
-			}
+					if isAWSErr(err, "InvalidVpcEndpointId.NotFound", "") {
+						return nil
+					}
 
-			if err != nil {
-				return fmt.Errorf("error deleting EC2 VPC Endpoint (%s): %s", id, err)
-			}
+					if err != nil {
+						return err
+					}
 
-			if err := vpcEndpointWaitUntilDeleted(conn, id, 10*time.Minute); err != nil {
-				return fmt.Errorf("error waiting for VPC Endpoint (%s) to delete: %s", id, err)
-			}
+					if err := vpcEndpointWaitUntilDeleted(conn, id, 10*time.Minute); err != nil {
+						return fmt.Errorf("error waiting for deletion: %s", err)
+					}
+
+					return nil
+				},
+			})
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -78,7 +89,7 @@ func testSweepEc2VpcEndpoints(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return testSweepResources(region, "aws_vpc_endpoint", sweep.Filter{}, resources)
 }
 
 func TestAccAWSVpcEndpoint_importBasic(t *testing.T) {
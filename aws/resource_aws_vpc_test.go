@@ -12,11 +12,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // add sweeper to delete known test vpcs
 func init() {
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	addTestSweepers("aws_vpc", &resource.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_internet_gateway",
@@ -48,40 +50,40 @@ func testSweepVPCs(region string) error {
 		return fmt.Errorf("Error describing vpcs: %s", err)
 	}
 
-	if len(resp.Vpcs) == 0 {
-		log.Print("[DEBUG] No aws vpcs to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, vpc := range resp.Vpcs {
+		id := aws.StringValue(vpc.VpcId)
+
 		if aws.BoolValue(vpc.IsDefault) {
-			log.Printf("[DEBUG] Skipping Default VPC: %s", aws.StringValue(vpc.VpcId))
+			log.Printf("[DEBUG] Skipping Default VPC: %s", id)
 			continue
 		}
 
-		input := &ec2.DeleteVpcInput{
-			VpcId: vpc.VpcId,
-		}
-		log.Printf("[DEBUG] Deleting VPC: %s", input)
-
-		// Handle EC2 eventual consistency
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.DeleteVpc(input)
-			if isAWSErr(err, "DependencyViolation", "") {
-				return resource.RetryableError(err)
-			}
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			return nil
+		resources = append(resources, &sweep.Resource{
+			ID:   id,
+			Tags: keyvaluetags.Ec2KeyValueTags(vpc.Tags),
+			Delete: func() error {
+				input := &ec2.DeleteVpcInput{
+					VpcId: aws.String(id),
+				}
+
+				// Handle EC2 eventual consistency
+				return resource.Retry(1*time.Minute, func() *resource.RetryError {
+					_, err := conn.DeleteVpc(input)
+					if isAWSErr(err, "DependencyViolation", "") {
+						return resource.RetryableError(err)
+					}
+					if err != nil {
+						return resource.NonRetryableError(err)
+					}
+					return nil
+				})
+			},
 		})
-
-		if err != nil {
-			return fmt.Errorf("Error deleting VPC (%s): %s", aws.StringValue(vpc.VpcId), err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_vpc", sweep.Filter{}, resources)
 }
 
 func TestAccAWSVpc_basic(t *testing.T) {
@@ -11,11 +11,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // add sweeper to delete known test VPN Gateways
 func init() {
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	addTestSweepers("aws_vpn_gateway", &resource.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    testSweepVPNGateways,
 		Dependencies: []string{
@@ -51,49 +53,54 @@ func testSweepVPNGateways(region string) error {
 		return fmt.Errorf("Error describing VPN Gateways: %s", err)
 	}
 
-	if len(resp.VpnGateways) == 0 {
-		log.Print("[DEBUG] No VPN Gateways to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, vpng := range resp.VpnGateways {
-		for _, vpcAttachment := range vpng.VpcAttachments {
-			input := &ec2.DetachVpnGatewayInput{
-				VpcId:        vpcAttachment.VpcId,
-				VpnGatewayId: vpng.VpnGatewayId,
-			}
+		id := aws.StringValue(vpng.VpnGatewayId)
+		vpcAttachments := vpng.VpcAttachments
+
+		resources = append(resources, &sweep.Resource{
+			ID:   id,
+			Tags: keyvaluetags.Ec2KeyValueTags(vpng.Tags),
+			Delete: func() error {
+				for _, vpcAttachment := range vpcAttachments {
+					vpcID := aws.StringValue(vpcAttachment.VpcId)
+
+					input := &ec2.DetachVpnGatewayInput{
+						VpcId:        aws.String(vpcID),
+						VpnGatewayId: aws.String(id),
+					}
 
-			log.Printf("[DEBUG] Detaching VPN Gateway: %s", input)
-			_, err := conn.DetachVpnGateway(input)
-			if err != nil {
-				return fmt.Errorf("error detaching VPN Gateway (%s) from VPC (%s): %s", aws.StringValue(vpng.VpnGatewayId), aws.StringValue(vpcAttachment.VpcId), err)
-			}
+					log.Printf("[DEBUG] Detaching VPN Gateway: %s", input)
+					_, err := conn.DetachVpnGateway(input)
+					if err != nil {
+						return fmt.Errorf("error detaching from VPC (%s): %s", vpcID, err)
+					}
 
-			stateConf := &resource.StateChangeConf{
-				Pending: []string{"attached", "detaching"},
-				Target:  []string{"detached"},
-				Refresh: vpnGatewayAttachmentStateRefresh(conn, aws.StringValue(vpcAttachment.VpcId), aws.StringValue(vpng.VpnGatewayId)),
-				Timeout: 10 * time.Minute,
-			}
+					stateConf := &resource.StateChangeConf{
+						Pending: []string{"attached", "detaching"},
+						Target:  []string{"detached"},
+						Refresh: vpnGatewayAttachmentStateRefresh(conn, vpcID, id),
+						Timeout: 10 * time.Minute,
+					}
 
-			log.Printf("[DEBUG] Waiting for VPN Gateway (%s) to detach from VPC (%s)", aws.StringValue(vpng.VpnGatewayId), aws.StringValue(vpcAttachment.VpcId))
-			if _, err = stateConf.WaitForState(); err != nil {
-				return fmt.Errorf("error waiting for VPN Gateway (%s) to detach from VPC (%s): %s", aws.StringValue(vpng.VpnGatewayId), aws.StringValue(vpcAttachment.VpcId), err)
-			}
-		}
+					log.Printf("[DEBUG] Waiting for VPN Gateway (%s) to detach from VPC (%s)", id, vpcID)
+					if _, err = stateConf.WaitForState(); err != nil {
+						return fmt.Errorf("error waiting for detachment from VPC (%s): %s", vpcID, err)
+					}
+				}
 
-		input := &ec2.DeleteVpnGatewayInput{
-			VpnGatewayId: vpng.VpnGatewayId,
-		}
+				input := &ec2.DeleteVpnGatewayInput{
+					VpnGatewayId: aws.String(id),
+				}
 
-		log.Printf("[DEBUG] Deleting VPN Gateway: %s", input)
-		_, err := conn.DeleteVpnGateway(input)
-		if err != nil {
-			return fmt.Errorf("error deleting VPN Gateway (%s): %s", aws.StringValue(vpng.VpnGatewayId), err)
-		}
+				_, err := conn.DeleteVpnGateway(input)
+				return err
+			},
+		})
 	}
 
-	return nil
+	return testSweepResources(region, "aws_vpn_gateway", sweep.Filter{}, resources)
 }
 
 func TestAccAWSVpnGateway_importBasic(t *testing.T) {
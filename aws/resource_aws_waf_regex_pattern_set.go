@@ -15,6 +15,9 @@ func resourceAwsWafRegexPatternSet() *schema.Resource {
 		Read:   resourceAwsWafRegexPatternSetRead,
 		Update: resourceAwsWafRegexPatternSetUpdate,
 		Delete: resourceAwsWafRegexPatternSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -48,6 +48,11 @@ func testAccAWSWafRegexPatternSet_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_waf_regex_pattern_set.test", "regex_pattern_strings.3351840846", "two"),
 				),
 			},
+			{
+				ResourceName:      "aws_waf_regex_pattern_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
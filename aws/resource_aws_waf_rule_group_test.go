@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_rule_group", &resource.Sweeper{
+	addTestSweepers("aws_waf_rule_group", &resource.Sweeper{
 		Name: "aws_waf_rule_group",
 		F:    testSweepWafRuleGroups,
 	})
@@ -37,26 +38,28 @@ func testSweepWafRuleGroups(region string) error {
 		return fmt.Errorf("Error describing WAF Rule Groups: %s", err)
 	}
 
-	if len(resp.RuleGroups) == 0 {
-		log.Print("[DEBUG] No AWS WAF Rule Groups to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, group := range resp.RuleGroups {
-		rResp, err := conn.ListActivatedRulesInRuleGroup(&waf.ListActivatedRulesInRuleGroupInput{
-			RuleGroupId: group.RuleGroupId,
+		id := aws.StringValue(group.RuleGroupId)
+
+		resources = append(resources, &sweep.Resource{
+			ID:   id,
+			Name: aws.StringValue(group.Name),
+			Delete: func() error {
+				rResp, err := conn.ListActivatedRulesInRuleGroup(&waf.ListActivatedRulesInRuleGroupInput{
+					RuleGroupId: aws.String(id),
+				})
+				if err != nil {
+					return err
+				}
+				oldRules := flattenWafActivatedRules(rResp.ActivatedRules)
+				return deleteWafRuleGroup(id, oldRules, conn)
+			},
 		})
-		if err != nil {
-			return err
-		}
-		oldRules := flattenWafActivatedRules(rResp.ActivatedRules)
-		err = deleteWafRuleGroup(*group.RuleGroupId, oldRules, conn)
-		if err != nil {
-			return err
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_waf_rule_group", sweep.Filter{}, resources)
 }
 
 func TestAccAWSWafRuleGroup_basic(t *testing.T) {
@@ -86,6 +89,11 @@ func TestAccAWSWafRuleGroup_basic(t *testing.T) {
 					testCheckResourceAttrWithIndexesAddr("aws_waf_rule_group.test", "activated_rule.%d.type", &idx, waf.WafRuleTypeRegular),
 				),
 			},
+			{
+				ResourceName:      "aws_waf_rule_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
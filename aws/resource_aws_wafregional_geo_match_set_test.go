@@ -38,6 +38,11 @@ func TestAccAWSWafRegionalGeoMatchSet_basic(t *testing.T) {
 						"aws_wafregional_geo_match_set.test", "geo_match_constraint.1991628426.value", "CA"),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_geo_match_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
+	addTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rate_based_rule",
 		F:    testSweepWafRegionalRateBasedRules,
 		Dependencies: []string{
@@ -32,6 +33,7 @@ func testSweepWafRegionalRateBasedRules(region string) error {
 	conn := client.(*AWSClient).wafregionalconn
 
 	input := &waf.ListRateBasedRulesInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.ListRateBasedRules(input)
@@ -46,75 +48,82 @@ func testSweepWafRegionalRateBasedRules(region string) error {
 		}
 
 		for _, rule := range output.Rules {
-			deleteInput := &waf.DeleteRateBasedRuleInput{
-				RuleId: rule.RuleId,
-			}
 			id := aws.StringValue(rule.RuleId)
-			wr := newWafRegionalRetryer(conn, region)
 
-			_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
-				return conn.DeleteRateBasedRule(deleteInput)
+			resources = append(resources, &sweep.Resource{
+				ID:   id,
+				Name: aws.StringValue(rule.Name),
+				Delete: func() error {
+					return testSweepWafRegionalRateBasedRule(conn, region, id)
+				},
 			})
+		}
 
-			if isAWSErr(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
-				getRateBasedRuleInput := &waf.GetRateBasedRuleInput{
-					RuleId: rule.RuleId,
-				}
+		if aws.StringValue(output.NextMarker) == "" {
+			break
+		}
 
-				getRateBasedRuleOutput, getRateBasedRuleErr := conn.GetRateBasedRule(getRateBasedRuleInput)
+		input.NextMarker = output.NextMarker
+	}
 
-				if getRateBasedRuleErr != nil {
-					return fmt.Errorf("error getting WAF Regional Rate-Based Rule (%s): %s", id, getRateBasedRuleErr)
-				}
+	return testSweepResources(region, "aws_wafregional_rate_based_rule", sweep.Filter{}, resources)
+}
 
-				var updates []*waf.RuleUpdate
-				updateRateBasedRuleInput := &waf.UpdateRateBasedRuleInput{
-					RateLimit: getRateBasedRuleOutput.Rule.RateLimit,
-					RuleId:    rule.RuleId,
-					Updates:   updates,
-				}
+func testSweepWafRegionalRateBasedRule(conn *wafregional.WAFRegional, region, id string) error {
+	deleteInput := &waf.DeleteRateBasedRuleInput{
+		RuleId: aws.String(id),
+	}
+	wr := newWafRegionalRetryer(conn, region)
 
-				for _, predicate := range getRateBasedRuleOutput.Rule.MatchPredicates {
-					update := &waf.RuleUpdate{
-						Action:    aws.String(waf.ChangeActionDelete),
-						Predicate: predicate,
-					}
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		deleteInput.ChangeToken = token
+		return conn.DeleteRateBasedRule(deleteInput)
+	})
 
-					updateRateBasedRuleInput.Updates = append(updateRateBasedRuleInput.Updates, update)
-				}
+	if isAWSErr(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
+		getRateBasedRuleInput := &waf.GetRateBasedRuleInput{
+			RuleId: aws.String(id),
+		}
 
-				_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
-					updateRateBasedRuleInput.ChangeToken = token
-					log.Printf("[INFO] Removing Predicates from WAF Regional Rate-Based Rule: %s", id)
-					return conn.UpdateRateBasedRule(updateRateBasedRuleInput)
-				})
+		getRateBasedRuleOutput, getRateBasedRuleErr := conn.GetRateBasedRule(getRateBasedRuleInput)
 
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing predicates from WAF Regional Rate-Based Rule (%s): %s", id, updateWebACLErr)
-				}
+		if getRateBasedRuleErr != nil {
+			return fmt.Errorf("error getting WAF Regional Rate-Based Rule: %s", getRateBasedRuleErr)
+		}
 
-				_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
-					return conn.DeleteRateBasedRule(deleteInput)
-				})
-			}
+		var updates []*waf.RuleUpdate
+		updateRateBasedRuleInput := &waf.UpdateRateBasedRuleInput{
+			RateLimit: getRateBasedRuleOutput.Rule.RateLimit,
+			RuleId:    aws.String(id),
+			Updates:   updates,
+		}
 
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Rate-Based Rule (%s): %s", id, err)
+		for _, predicate := range getRateBasedRuleOutput.Rule.MatchPredicates {
+			update := &waf.RuleUpdate{
+				Action:    aws.String(waf.ChangeActionDelete),
+				Predicate: predicate,
 			}
+
+			updateRateBasedRuleInput.Updates = append(updateRateBasedRuleInput.Updates, update)
 		}
 
-		if aws.StringValue(output.NextMarker) == "" {
-			break
+		_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
+			updateRateBasedRuleInput.ChangeToken = token
+			log.Printf("[INFO] Removing Predicates from WAF Regional Rate-Based Rule: %s", id)
+			return conn.UpdateRateBasedRule(updateRateBasedRuleInput)
+		})
+
+		if updateWebACLErr != nil {
+			return fmt.Errorf("error removing predicates: %s", updateWebACLErr)
 		}
 
-		input.NextMarker = output.NextMarker
+		_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
+			deleteInput.ChangeToken = token
+			return conn.DeleteRateBasedRule(deleteInput)
+		})
 	}
 
-	return nil
+	return err
 }
 
 func TestAccAWSWafRegionalRateBasedRule_basic(t *testing.T) {
@@ -137,6 +146,11 @@ func TestAccAWSWafRegionalRateBasedRule_basic(t *testing.T) {
 						"aws_wafregional_rate_based_rule.wafrule", "metric_name", wafRuleName),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_rate_based_rule.wafrule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
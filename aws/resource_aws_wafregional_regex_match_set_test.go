@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
+	addTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_regex_match_set",
 		F:    testSweepWafRegionalRegexMatchSet,
 	})
@@ -37,42 +38,44 @@ func testSweepWafRegionalRegexMatchSet(region string) error {
 		return fmt.Errorf("Error describing WAF Regional Regex Match Sets: %s", err)
 	}
 
-	if len(resp.RegexMatchSets) == 0 {
-		log.Print("[DEBUG] No AWS WAF Regional Regex Match Sets to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, s := range resp.RegexMatchSets {
-		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
-			RegexMatchSetId: s.RegexMatchSetId,
-		})
-		if err != nil {
-			return err
-		}
-		set := resp.RegexMatchSet
-
-		oldTuples := flattenWafRegexMatchTuples(set.RegexMatchTuples)
-		noTuples := []interface{}{}
-		err = updateRegexMatchSetResourceWR(*set.RegexMatchSetId, oldTuples, noTuples, conn, region)
-		if err != nil {
-			return fmt.Errorf("Error updating WAF Regional Regex Match Set: %s", err)
-		}
-
-		wr := newWafRegionalRetryer(conn, region)
-		_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
-			req := &waf.DeleteRegexMatchSetInput{
-				ChangeToken:     token,
-				RegexMatchSetId: set.RegexMatchSetId,
-			}
-			log.Printf("[INFO] Deleting WAF Regional Regex Match Set: %s", req)
-			return conn.DeleteRegexMatchSet(req)
+		id := aws.StringValue(s.RegexMatchSetId)
+
+		resources = append(resources, &sweep.Resource{
+			ID:   id,
+			Name: aws.StringValue(s.Name),
+			Delete: func() error {
+				resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
+					RegexMatchSetId: aws.String(id),
+				})
+				if err != nil {
+					return err
+				}
+				set := resp.RegexMatchSet
+
+				oldTuples := flattenWafRegexMatchTuples(set.RegexMatchTuples)
+				noTuples := []interface{}{}
+				err = updateRegexMatchSetResourceWR(id, oldTuples, noTuples, conn, region)
+				if err != nil {
+					return fmt.Errorf("error updating: %s", err)
+				}
+
+				wr := newWafRegionalRetryer(conn, region)
+				_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
+					req := &waf.DeleteRegexMatchSetInput{
+						ChangeToken:     token,
+						RegexMatchSetId: aws.String(id),
+					}
+					return conn.DeleteRegexMatchSet(req)
+				})
+				return err
+			},
 		})
-		if err != nil {
-			return fmt.Errorf("error deleting WAF Regional Regex Match Set (%s): %s", aws.StringValue(set.RegexMatchSetId), err)
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_wafregional_regex_match_set", sweep.Filter{}, resources)
 }
 
 // Serialized acceptance tests due to WAF account limits
@@ -125,6 +128,11 @@ func testAccAWSWafRegionalRegexMatchSet_basic(t *testing.T) {
 					testCheckResourceAttrWithIndexesAddr("aws_wafregional_regex_match_set.test", "regex_match_tuple.%d.text_transformation", &idx, "NONE"),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_regex_match_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
+	addTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
 		Name: "aws_wafregional_rule_group",
 		F:    testSweepWafRegionalRuleGroups,
 	})
@@ -37,26 +38,28 @@ func testSweepWafRegionalRuleGroups(region string) error {
 		return fmt.Errorf("Error describing WAF Regional Rule Groups: %s", err)
 	}
 
-	if len(resp.RuleGroups) == 0 {
-		log.Print("[DEBUG] No AWS WAF Regional Rule Groups to sweep")
-		return nil
-	}
+	var resources []*sweep.Resource
 
 	for _, group := range resp.RuleGroups {
-		rResp, err := conn.ListActivatedRulesInRuleGroup(&waf.ListActivatedRulesInRuleGroupInput{
-			RuleGroupId: group.RuleGroupId,
+		id := aws.StringValue(group.RuleGroupId)
+
+		resources = append(resources, &sweep.Resource{
+			ID:   id,
+			Name: aws.StringValue(group.Name),
+			Delete: func() error {
+				rResp, err := conn.ListActivatedRulesInRuleGroup(&waf.ListActivatedRulesInRuleGroupInput{
+					RuleGroupId: aws.String(id),
+				})
+				if err != nil {
+					return err
+				}
+				oldRules := flattenWafActivatedRules(rResp.ActivatedRules)
+				return deleteWafRegionalRuleGroup(id, oldRules, conn, region)
+			},
 		})
-		if err != nil {
-			return err
-		}
-		oldRules := flattenWafActivatedRules(rResp.ActivatedRules)
-		err = deleteWafRegionalRuleGroup(*group.RuleGroupId, oldRules, conn, region)
-		if err != nil {
-			return err
-		}
 	}
 
-	return nil
+	return testSweepResources(region, "aws_wafregional_rule_group", sweep.Filter{}, resources)
 }
 
 func TestAccAWSWafRegionalRuleGroup_basic(t *testing.T) {
@@ -86,6 +89,11 @@ func TestAccAWSWafRegionalRuleGroup_basic(t *testing.T) {
 					testCheckResourceAttrWithIndexesAddr("aws_wafregional_rule_group.test", "activated_rule.%d.type", &idx, waf.WafRuleTypeRegular),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_rule_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
+	addTestSweepers("aws_wafregional_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rule",
 		F:    testSweepWafRegionalRules,
 		Dependencies: []string{
@@ -32,6 +33,7 @@ func testSweepWafRegionalRules(region string) error {
 	conn := client.(*AWSClient).wafregionalconn
 
 	input := &waf.ListRulesInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.ListRules(input)
@@ -46,74 +48,81 @@ func testSweepWafRegionalRules(region string) error {
 		}
 
 		for _, rule := range output.Rules {
-			deleteInput := &waf.DeleteRuleInput{
-				RuleId: rule.RuleId,
-			}
 			id := aws.StringValue(rule.RuleId)
-			wr := newWafRegionalRetryer(conn, region)
 
-			_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
-				return conn.DeleteRule(deleteInput)
+			resources = append(resources, &sweep.Resource{
+				ID:   id,
+				Name: aws.StringValue(rule.Name),
+				Delete: func() error {
+					return testSweepWafRegionalRule(conn, region, id)
+				},
 			})
+		}
 
-			if isAWSErr(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
-				getRuleInput := &waf.GetRuleInput{
-					RuleId: rule.RuleId,
-				}
+		if aws.StringValue(output.NextMarker) == "" {
+			break
+		}
 
-				getRuleOutput, getRuleErr := conn.GetRule(getRuleInput)
+		input.NextMarker = output.NextMarker
+	}
 
-				if getRuleErr != nil {
-					return fmt.Errorf("error getting WAF Regional Rule (%s): %s", id, getRuleErr)
-				}
+	return testSweepResources(region, "aws_wafregional_rule", sweep.Filter{}, resources)
+}
 
-				var updates []*waf.RuleUpdate
-				updateRuleInput := &waf.UpdateRuleInput{
-					RuleId:  rule.RuleId,
-					Updates: updates,
-				}
+func testSweepWafRegionalRule(conn *wafregional.WAFRegional, region, id string) error {
+	deleteInput := &waf.DeleteRuleInput{
+		RuleId: aws.String(id),
+	}
+	wr := newWafRegionalRetryer(conn, region)
 
-				for _, predicate := range getRuleOutput.Rule.Predicates {
-					update := &waf.RuleUpdate{
-						Action:    aws.String(waf.ChangeActionDelete),
-						Predicate: predicate,
-					}
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		deleteInput.ChangeToken = token
+		return conn.DeleteRule(deleteInput)
+	})
 
-					updateRuleInput.Updates = append(updateRuleInput.Updates, update)
-				}
+	if isAWSErr(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
+		getRuleInput := &waf.GetRuleInput{
+			RuleId: aws.String(id),
+		}
 
-				_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
-					updateRuleInput.ChangeToken = token
-					log.Printf("[INFO] Removing Predicates from WAF Regional Rule: %s", id)
-					return conn.UpdateRule(updateRuleInput)
-				})
+		getRuleOutput, getRuleErr := conn.GetRule(getRuleInput)
 
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing predicates from WAF Regional Rule (%s): %s", id, updateWebACLErr)
-				}
+		if getRuleErr != nil {
+			return fmt.Errorf("error getting WAF Regional Rule: %s", getRuleErr)
+		}
 
-				_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
-					return conn.DeleteRule(deleteInput)
-				})
-			}
+		var updates []*waf.RuleUpdate
+		updateRuleInput := &waf.UpdateRuleInput{
+			RuleId:  aws.String(id),
+			Updates: updates,
+		}
 
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Rule (%s): %s", id, err)
+		for _, predicate := range getRuleOutput.Rule.Predicates {
+			update := &waf.RuleUpdate{
+				Action:    aws.String(waf.ChangeActionDelete),
+				Predicate: predicate,
 			}
+
+			updateRuleInput.Updates = append(updateRuleInput.Updates, update)
 		}
 
-		if aws.StringValue(output.NextMarker) == "" {
-			break
+		_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
+			updateRuleInput.ChangeToken = token
+			log.Printf("[INFO] Removing Predicates from WAF Regional Rule: %s", id)
+			return conn.UpdateRule(updateRuleInput)
+		})
+
+		if updateWebACLErr != nil {
+			return fmt.Errorf("error removing predicates: %s", updateWebACLErr)
 		}
 
-		input.NextMarker = output.NextMarker
+		_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
+			deleteInput.ChangeToken = token
+			return conn.DeleteRule(deleteInput)
+		})
 	}
 
-	return nil
+	return err
 }
 
 func TestAccAWSWafRegionalRule_basic(t *testing.T) {
@@ -136,6 +145,11 @@ func TestAccAWSWafRegionalRule_basic(t *testing.T) {
 						"aws_wafregional_rule.wafrule", "metric_name", wafRuleName),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_rule.wafrule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -17,6 +17,9 @@ func resourceAwsWafRegionalWebAclAssociation() *schema.Resource {
 		Create: resourceAwsWafRegionalWebAclAssociationCreate,
 		Read:   resourceAwsWafRegionalWebAclAssociationRead,
 		Delete: resourceAwsWafRegionalWebAclAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafRegionalWebAclAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"web_acl_id": {
@@ -91,7 +94,9 @@ func resourceAwsWafRegionalWebAclAssociationRead(d *schema.ResourceData, meta in
 	}
 
 	if output == nil || output.WebACLSummary == nil {
-		return fmt.Errorf("error getting WAF Regional Web ACL for resource (%s): empty response", resourceArn)
+		log.Printf("[WARN] WAF Regional Web ACL for resource (%s) not found, removing from state", resourceArn)
+		d.SetId("")
+		return nil
 	}
 
 	d.Set("resource_arn", resourceArn)
@@ -116,6 +121,14 @@ func resourceAwsWafRegionalWebAclAssociationDelete(d *schema.ResourceData, meta
 	return err
 }
 
+func resourceAwsWafRegionalWebAclAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if parts := strings.SplitN(d.Id(), ":", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <web-acl-id>:<resource-arn>", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsWafRegionalWebAclAssociationParseId(id string) (webAclId, resourceArn string) {
 	parts := strings.SplitN(id, ":", 2)
 	webAclId = parts[0]
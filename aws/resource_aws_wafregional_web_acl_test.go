@@ -14,10 +14,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
+	addTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
 		Name: "aws_wafregional_web_acl",
 		F:    testSweepWafRegionalWebAcls,
 	})
@@ -31,6 +32,7 @@ func testSweepWafRegionalWebAcls(region string) error {
 	conn := client.(*AWSClient).wafregionalconn
 
 	input := &waf.ListWebACLsInput{}
+	var resources []*sweep.Resource
 
 	for {
 		output, err := conn.ListWebACLs(input)
@@ -45,75 +47,82 @@ func testSweepWafRegionalWebAcls(region string) error {
 		}
 
 		for _, webACL := range output.WebACLs {
-			deleteInput := &waf.DeleteWebACLInput{
-				WebACLId: webACL.WebACLId,
-			}
 			id := aws.StringValue(webACL.WebACLId)
-			wr := newWafRegionalRetryer(conn, region)
 
-			_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
-				return conn.DeleteWebACL(deleteInput)
+			resources = append(resources, &sweep.Resource{
+				ID:   id,
+				Name: aws.StringValue(webACL.Name),
+				Delete: func() error {
+					return testSweepWafRegionalWebAcl(conn, region, id)
+				},
 			})
+		}
 
-			if isAWSErr(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
-				getWebACLInput := &waf.GetWebACLInput{
-					WebACLId: webACL.WebACLId,
-				}
+		if aws.StringValue(output.NextMarker) == "" {
+			break
+		}
 
-				getWebACLOutput, getWebACLErr := conn.GetWebACL(getWebACLInput)
+		input.NextMarker = output.NextMarker
+	}
 
-				if getWebACLErr != nil {
-					return fmt.Errorf("error getting WAF Regional Web ACL (%s): %s", id, getWebACLErr)
-				}
+	return testSweepResources(region, "aws_wafregional_web_acl", sweep.Filter{}, resources)
+}
 
-				var updates []*waf.WebACLUpdate
-				updateWebACLInput := &waf.UpdateWebACLInput{
-					DefaultAction: getWebACLOutput.WebACL.DefaultAction,
-					Updates:       updates,
-					WebACLId:      webACL.WebACLId,
-				}
+func testSweepWafRegionalWebAcl(conn *wafregional.WAFRegional, region, id string) error {
+	deleteInput := &waf.DeleteWebACLInput{
+		WebACLId: aws.String(id),
+	}
+	wr := newWafRegionalRetryer(conn, region)
 
-				for _, rule := range getWebACLOutput.WebACL.Rules {
-					update := &waf.WebACLUpdate{
-						Action:        aws.String(waf.ChangeActionDelete),
-						ActivatedRule: rule,
-					}
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		deleteInput.ChangeToken = token
+		return conn.DeleteWebACL(deleteInput)
+	})
 
-					updateWebACLInput.Updates = append(updateWebACLInput.Updates, update)
-				}
+	if isAWSErr(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
+		getWebACLInput := &waf.GetWebACLInput{
+			WebACLId: aws.String(id),
+		}
 
-				_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
-					updateWebACLInput.ChangeToken = token
-					log.Printf("[INFO] Removing Rules from WAF Regional Web ACL: %s", id)
-					return conn.UpdateWebACL(updateWebACLInput)
-				})
+		getWebACLOutput, getWebACLErr := conn.GetWebACL(getWebACLInput)
 
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing rules from WAF Regional Web ACL (%s): %s", id, updateWebACLErr)
-				}
+		if getWebACLErr != nil {
+			return fmt.Errorf("error getting WAF Regional Web ACL: %s", getWebACLErr)
+		}
 
-				_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
-					return conn.DeleteWebACL(deleteInput)
-				})
-			}
+		var updates []*waf.WebACLUpdate
+		updateWebACLInput := &waf.UpdateWebACLInput{
+			DefaultAction: getWebACLOutput.WebACL.DefaultAction,
+			Updates:       updates,
+			WebACLId:      aws.String(id),
+		}
 
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Web ACL (%s): %s", id, err)
+		for _, rule := range getWebACLOutput.WebACL.Rules {
+			update := &waf.WebACLUpdate{
+				Action:        aws.String(waf.ChangeActionDelete),
+				ActivatedRule: rule,
 			}
+
+			updateWebACLInput.Updates = append(updateWebACLInput.Updates, update)
 		}
 
-		if aws.StringValue(output.NextMarker) == "" {
-			break
+		_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
+			updateWebACLInput.ChangeToken = token
+			log.Printf("[INFO] Removing Rules from WAF Regional Web ACL: %s", id)
+			return conn.UpdateWebACL(updateWebACLInput)
+		})
+
+		if updateWebACLErr != nil {
+			return fmt.Errorf("error removing rules: %s", updateWebACLErr)
 		}
 
-		input.NextMarker = output.NextMarker
+		_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
+			deleteInput.ChangeToken = token
+			return conn.DeleteWebACL(deleteInput)
+		})
 	}
 
-	return nil
+	return err
 }
 
 func TestAccAWSWafRegionalWebAcl_basic(t *testing.T) {
@@ -144,6 +153,11 @@ func TestAccAWSWafRegionalWebAcl_basic(t *testing.T) {
 						"aws_wafregional_web_acl.waf_acl", "logging_configuration.#", "0"),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_web_acl.waf_acl",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsWorkspacesIpGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesIpGroupCreate,
+		Read:   resourceAwsWorkspacesIpGroupRead,
+		Update: resourceAwsWorkspacesIpGroupUpdate,
+		Delete: resourceAwsWorkspacesIpGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCIDRNetworkAddress,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsWorkspacesIpGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	input := &workspaces.CreateIpGroupInput{
+		GroupName: aws.String(d.Get("name").(string)),
+		UserRules: expandWorkspacesIpGroupRules(d.Get("rules").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.GroupDesc = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().WorkspacesTags()
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces IP Group: %s", input)
+	output, err := conn.CreateIpGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces IP Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.GroupId))
+
+	return resourceAwsWorkspacesIpGroupRead(d, meta)
+}
+
+func resourceAwsWorkspacesIpGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	group, err := describeWorkspacesIpGroup(conn, d.Id())
+
+	if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] WorkSpaces IP Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	if group == nil {
+		log.Printf("[WARN] WorkSpaces IP Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", group.GroupName)
+	d.Set("description", group.GroupDesc)
+
+	if err := d.Set("rules", flattenWorkspacesIpGroupRules(group.UserRules)); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
+
+	tags, err := listWorkspacesTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.WorkspacesKeyValueTags(tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesIpGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	if d.HasChange("rules") {
+		input := &workspaces.UpdateRulesOfIpGroupInput{
+			GroupId:   aws.String(d.Id()),
+			UserRules: expandWorkspacesIpGroupRules(d.Get("rules").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating WorkSpaces IP Group rules: %s", input)
+		if _, err := conn.UpdateRulesOfIpGroup(input); err != nil {
+			return fmt.Errorf("error updating WorkSpaces IP Group (%s) rules: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.WorkspacesUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating WorkSpaces IP Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsWorkspacesIpGroupRead(d, meta)
+}
+
+func resourceAwsWorkspacesIpGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	log.Printf("[DEBUG] Deleting WorkSpaces IP Group: %s", d.Id())
+	_, err := conn.DeleteIpGroup(&workspaces.DeleteIpGroupInput{
+		GroupId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandWorkspacesIpGroupRules(l []interface{}) []*workspaces.IpRuleItem {
+	rules := make([]*workspaces.IpRuleItem, 0, len(l))
+
+	for _, v := range l {
+		m := v.(map[string]interface{})
+
+		rule := &workspaces.IpRuleItem{
+			IpRule: aws.String(m["source"].(string)),
+		}
+
+		if v, ok := m["description"].(string); ok && v != "" {
+			rule.RuleDesc = aws.String(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func flattenWorkspacesIpGroupRules(rules []*workspaces.IpRuleItem) []interface{} {
+	l := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		l = append(l, map[string]interface{}{
+			"source":      aws.StringValue(rule.IpRule),
+			"description": aws.StringValue(rule.RuleDesc),
+		})
+	}
+
+	return l
+}
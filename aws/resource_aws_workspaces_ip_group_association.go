@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsWorkspacesIpGroupAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesIpGroupAssociationCreate,
+		Read:   resourceAwsWorkspacesIpGroupAssociationRead,
+		Delete: resourceAwsWorkspacesIpGroupAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"ip_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsWorkspacesIpGroupAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	directoryID := d.Get("directory_id").(string)
+	ipGroupID := d.Get("ip_group_id").(string)
+
+	// Directories cannot be registered through the WorkSpaces API in use, so
+	// only wait for a registration started elsewhere to complete.
+	log.Printf("[DEBUG] Waiting for WorkSpaces Directory (%s) to be registered", directoryID)
+	if err := waitForWorkspacesDirectoryRegistration(conn, directoryID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Directory (%s) registration: %s", directoryID, err)
+	}
+
+	input := &workspaces.AssociateIpGroupsInput{
+		DirectoryId: aws.String(directoryID),
+		GroupIds:    aws.StringSlice([]string{ipGroupID}),
+	}
+
+	log.Printf("[DEBUG] Associating WorkSpaces IP Group: %s", input)
+	if _, err := conn.AssociateIpGroups(input); err != nil {
+		return fmt.Errorf("error associating WorkSpaces IP Group (%s) with Directory (%s): %s", ipGroupID, directoryID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", directoryID, ipGroupID))
+
+	return resourceAwsWorkspacesIpGroupAssociationRead(d, meta)
+}
+
+func resourceAwsWorkspacesIpGroupAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	directoryID, ipGroupID, err := decodeWorkspacesIpGroupAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	directory, err := describeWorkspacesDirectory(conn, directoryID)
+
+	if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] WorkSpaces Directory (%s) not found, removing IP Group Association (%s) from state", directoryID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Directory (%s): %s", directoryID, err)
+	}
+
+	if directory == nil || aws.StringValue(directory.State) == workspaces.WorkspaceDirectoryStateDeregistered {
+		log.Printf("[WARN] WorkSpaces Directory (%s) not found, removing IP Group Association (%s) from state", directoryID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var associated bool
+
+	for _, groupID := range directory.IpGroupIds {
+		if aws.StringValue(groupID) == ipGroupID {
+			associated = true
+			break
+		}
+	}
+
+	if !associated {
+		log.Printf("[WARN] WorkSpaces IP Group Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("directory_id", directoryID)
+	d.Set("ip_group_id", ipGroupID)
+
+	return nil
+}
+
+func resourceAwsWorkspacesIpGroupAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	directoryID, ipGroupID, err := decodeWorkspacesIpGroupAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &workspaces.DisassociateIpGroupsInput{
+		DirectoryId: aws.String(directoryID),
+		GroupIds:    aws.StringSlice([]string{ipGroupID}),
+	}
+
+	log.Printf("[DEBUG] Disassociating WorkSpaces IP Group: %s", input)
+	_, err = conn.DisassociateIpGroups(input)
+
+	if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating WorkSpaces IP Group (%s) from Directory (%s): %s", ipGroupID, directoryID, err)
+	}
+
+	return nil
+}
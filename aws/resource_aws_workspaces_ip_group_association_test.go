@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWorkspacesIpGroupAssociation_basic(t *testing.T) {
+	directoryID := testAccAWSWorkspacesDirectoryID(t)
+	resourceName := "aws_workspaces_ip_group_association.test"
+	ipGroupResourceName := "aws_workspaces_ip_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSWorkspaces(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesIpGroupAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesIpGroupAssociationConfig(rName, directoryID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "directory_id", directoryID),
+					resource.TestCheckResourceAttrPair(resourceName, "ip_group_id", ipGroupResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSWorkspacesIpGroupAssociation_disappears(t *testing.T) {
+	directoryID := testAccAWSWorkspacesDirectoryID(t)
+	resourceName := "aws_workspaces_ip_group_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSWorkspaces(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesIpGroupAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesIpGroupAssociationConfig(rName, directoryID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupAssociationExists(resourceName),
+					testAccCheckAwsWorkspacesIpGroupAssociationDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccAwsWorkspacesIpGroupAssociated returns whether an IP Group is
+// associated with a directory.
+func testAccAwsWorkspacesIpGroupAssociated(id string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	directoryID, ipGroupID, err := decodeWorkspacesIpGroupAssociationID(id)
+	if err != nil {
+		return false, err
+	}
+
+	directory, err := describeWorkspacesDirectory(conn, directoryID)
+
+	if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if directory == nil {
+		return false, nil
+	}
+
+	for _, groupID := range directory.IpGroupIds {
+		if aws.StringValue(groupID) == ipGroupID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func testAccCheckAwsWorkspacesIpGroupAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		associated, err := testAccAwsWorkspacesIpGroupAssociated(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if !associated {
+			return fmt.Errorf("WorkSpaces IP Group Association (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsWorkspacesIpGroupAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_ip_group_association" {
+			continue
+		}
+
+		associated, err := testAccAwsWorkspacesIpGroupAssociated(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if associated {
+			return fmt.Errorf("WorkSpaces IP Group Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsWorkspacesIpGroupAssociationDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		_, err := conn.DisassociateIpGroups(&workspaces.DisassociateIpGroupsInput{
+			DirectoryId: aws.String(rs.Primary.Attributes["directory_id"]),
+			GroupIds:    aws.StringSlice([]string{rs.Primary.Attributes["ip_group_id"]}),
+		})
+
+		return err
+	}
+}
+
+func testAccAwsWorkspacesIpGroupAssociationConfig(rName, directoryID string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_workspaces_ip_group_association" "test" {
+  directory_id = %[2]q
+  ip_group_id  = "${aws_workspaces_ip_group.test.id}"
+}
+`, rName, directoryID)
+}
@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+)
+
+func init() {
+	addTestSweepers("aws_workspaces_ip_group", &resource.Sweeper{
+		Name: "aws_workspaces_ip_group",
+		F:    testSweepWorkspacesIpGroups,
+	})
+}
+
+func testSweepWorkspacesIpGroups(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).workspacesconn
+
+	var resources []*sweep.Resource
+	input := &workspaces.DescribeIpGroupsInput{}
+
+	for {
+		output, err := conn.DescribeIpGroups(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping WorkSpaces IP Group sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing WorkSpaces IP Groups: %s", err)
+		}
+
+		for _, group := range output.Result {
+			id := aws.StringValue(group.GroupId)
+
+			resources = append(resources, &sweep.Resource{
+				ID:   id,
+				Name: aws.StringValue(group.GroupName),
+				Delete: func() error {
+					_, err := conn.DeleteIpGroup(&workspaces.DeleteIpGroupInput{
+						GroupId: aws.String(id),
+					})
+					if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+						return nil
+					}
+					return err
+				},
+			})
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	filter := sweep.Filter{
+		NamePrefixes: []string{"tf-acc-test"},
+	}
+
+	if err := testSweepResources(region, "aws_workspaces_ip_group", filter, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSWorkspacesIpGroup_basic(t *testing.T) {
+	var group workspaces.IpGroup
+	resourceName := "aws_workspaces_ip_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSWorkspaces(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesIpGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigRules(rName, "10.0.0.0/16", "172.16.0.0/12"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigRules(rName, "10.0.0.0/16", "192.168.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSWorkspacesIpGroup_disappears(t *testing.T) {
+	var group workspaces.IpGroup
+	resourceName := "aws_workspaces_ip_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSWorkspaces(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesIpGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigRules(rName, "10.0.0.0/16", "172.16.0.0/12"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &group),
+					testAccCheckAwsWorkspacesIpGroupDisappears(&group),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSWorkspacesIpGroup_Tags(t *testing.T) {
+	var group workspaces.IpGroup
+	resourceName := "aws_workspaces_ip_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSWorkspaces(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesIpGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSWorkspaces(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	_, err := conn.DescribeIpGroups(&workspaces.DescribeIpGroupsInput{})
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAwsWorkspacesIpGroupExists(resourceName string, v *workspaces.IpGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		group, err := describeWorkspacesIpGroup(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if group == nil {
+			return fmt.Errorf("WorkSpaces IP Group (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *group
+
+		return nil
+	}
+}
+
+func testAccCheckAwsWorkspacesIpGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_ip_group" {
+			continue
+		}
+
+		group, err := describeWorkspacesIpGroup(conn, rs.Primary.ID)
+
+		if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if group != nil {
+			return fmt.Errorf("WorkSpaces IP Group (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsWorkspacesIpGroupDisappears(group *workspaces.IpGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		_, err := conn.DeleteIpGroup(&workspaces.DeleteIpGroupInput{
+			GroupId: group.GroupId,
+		})
+
+		return err
+	}
+}
+
+func testAccAwsWorkspacesIpGroupConfigRules(rName, source1, source2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name        = %[1]q
+  description = "Terraform acceptance test"
+
+  rules {
+    source = %[2]q
+  }
+
+  rules {
+    source      = %[3]q
+    description = "second"
+  }
+}
+`, rName, source1, source2)
+}
+
+func testAccAwsWorkspacesIpGroupConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsWorkspacesIpGroupConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
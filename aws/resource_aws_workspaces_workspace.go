@@ -0,0 +1,396 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsWorkspacesWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesWorkspaceCreate,
+		Read:   resourceAwsWorkspacesWorkspaceRead,
+		Update: resourceAwsWorkspacesWorkspaceUpdate,
+		Delete: resourceAwsWorkspacesWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"computer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"root_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"volume_encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"workspace_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_type_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								workspaces.ComputeValue,
+								workspaces.ComputeStandard,
+								workspaces.ComputePerformance,
+								workspaces.ComputePower,
+								workspaces.ComputeGraphics,
+								workspaces.ComputePowerpro,
+								workspaces.ComputeGraphicspro,
+							}, false),
+						},
+						"root_volume_size_gib": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"running_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  workspaces.RunningModeAlwaysOn,
+							ValidateFunc: validation.StringInSlice([]string{
+								workspaces.RunningModeAlwaysOn,
+								workspaces.RunningModeAutoStop,
+							}, false),
+						},
+						"running_mode_auto_stop_timeout_in_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								value := v.(int)
+								if value%60 != 0 {
+									errors = append(errors, fmt.Errorf("%q should be configured in 60-minute intervals, got: %d", k, value))
+								}
+								return
+							},
+						},
+						"user_volume_size_gib": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWorkspacesWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	request := &workspaces.WorkspaceRequest{
+		BundleId:                    aws.String(d.Get("bundle_id").(string)),
+		DirectoryId:                 aws.String(d.Get("directory_id").(string)),
+		RootVolumeEncryptionEnabled: aws.Bool(d.Get("root_volume_encryption_enabled").(bool)),
+		UserName:                    aws.String(d.Get("user_name").(string)),
+		UserVolumeEncryptionEnabled: aws.Bool(d.Get("user_volume_encryption_enabled").(bool)),
+		WorkspaceProperties:         expandWorkspacesWorkspaceProperties(d.Get("workspace_properties").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		request.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().WorkspacesTags()
+	}
+
+	if v, ok := d.GetOk("volume_encryption_key"); ok {
+		request.VolumeEncryptionKey = aws.String(v.(string))
+	}
+
+	input := &workspaces.CreateWorkspacesInput{
+		Workspaces: []*workspaces.WorkspaceRequest{request},
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces Workspace: %s", input)
+	output, err := conn.CreateWorkspaces(input)
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces Workspace: %s", err)
+	}
+
+	if len(output.FailedRequests) > 0 {
+		failure := output.FailedRequests[0]
+		return fmt.Errorf("error creating WorkSpaces Workspace: %s: %s", aws.StringValue(failure.ErrorCode), aws.StringValue(failure.ErrorMessage))
+	}
+
+	if len(output.PendingRequests) == 0 {
+		return fmt.Errorf("error creating WorkSpaces Workspace: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.PendingRequests[0].WorkspaceId))
+
+	log.Printf("[DEBUG] Waiting for WorkSpaces Workspace (%s) to become available", d.Id())
+	if err := waitForWorkspacesWorkspaceCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	workspace, err := describeWorkspacesWorkspace(conn, d.Id())
+
+	if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] WorkSpaces Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if workspace == nil || aws.StringValue(workspace.State) == workspaces.WorkspaceStateTerminated {
+		log.Printf("[WARN] WorkSpaces Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bundle_id", workspace.BundleId)
+	d.Set("computer_name", workspace.ComputerName)
+	d.Set("directory_id", workspace.DirectoryId)
+	d.Set("ip_address", workspace.IpAddress)
+	d.Set("root_volume_encryption_enabled", workspace.RootVolumeEncryptionEnabled)
+	d.Set("state", workspace.State)
+	d.Set("user_name", workspace.UserName)
+	d.Set("user_volume_encryption_enabled", workspace.UserVolumeEncryptionEnabled)
+	d.Set("volume_encryption_key", workspace.VolumeEncryptionKey)
+
+	if err := d.Set("workspace_properties", flattenWorkspacesWorkspaceProperties(workspace.WorkspaceProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_properties: %s", err)
+	}
+
+	tags, err := listWorkspacesTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.WorkspacesKeyValueTags(tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	// WorkSpaces only allows one workspace property to be modified at a time,
+	// so each change is applied and waited on separately.
+	if d.HasChange("workspace_properties.0.compute_type_name") {
+		properties := &workspaces.WorkspaceProperties{
+			ComputeTypeName: aws.String(d.Get("workspace_properties.0.compute_type_name").(string)),
+		}
+
+		if err := modifyWorkspacesWorkspaceProperties(conn, d.Id(), properties, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("workspace_properties.0.root_volume_size_gib") {
+		properties := &workspaces.WorkspaceProperties{
+			RootVolumeSizeGib: aws.Int64(int64(d.Get("workspace_properties.0.root_volume_size_gib").(int))),
+		}
+
+		if err := modifyWorkspacesWorkspaceProperties(conn, d.Id(), properties, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("workspace_properties.0.user_volume_size_gib") {
+		properties := &workspaces.WorkspaceProperties{
+			UserVolumeSizeGib: aws.Int64(int64(d.Get("workspace_properties.0.user_volume_size_gib").(int))),
+		}
+
+		if err := modifyWorkspacesWorkspaceProperties(conn, d.Id(), properties, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("workspace_properties.0.running_mode") || d.HasChange("workspace_properties.0.running_mode_auto_stop_timeout_in_minutes") {
+		properties := &workspaces.WorkspaceProperties{
+			RunningMode: aws.String(d.Get("workspace_properties.0.running_mode").(string)),
+		}
+
+		if v, ok := d.GetOk("workspace_properties.0.running_mode_auto_stop_timeout_in_minutes"); ok && aws.StringValue(properties.RunningMode) == workspaces.RunningModeAutoStop {
+			properties.RunningModeAutoStopTimeoutInMinutes = aws.Int64(int64(v.(int)))
+		}
+
+		if err := modifyWorkspacesWorkspaceProperties(conn, d.Id(), properties, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.WorkspacesUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating WorkSpaces Workspace (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	input := &workspaces.TerminateWorkspacesInput{
+		TerminateWorkspaceRequests: []*workspaces.TerminateRequest{
+			{
+				WorkspaceId: aws.String(d.Id()),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Terminating WorkSpaces Workspace: %s", input)
+	output, err := conn.TerminateWorkspaces(input)
+
+	if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error terminating WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if len(output.FailedRequests) > 0 {
+		failure := output.FailedRequests[0]
+
+		if aws.StringValue(failure.ErrorCode) == workspaces.ErrCodeResourceNotFoundException {
+			return nil
+		}
+
+		return fmt.Errorf("error terminating WorkSpaces Workspace (%s): %s: %s", d.Id(), aws.StringValue(failure.ErrorCode), aws.StringValue(failure.ErrorMessage))
+	}
+
+	log.Printf("[DEBUG] Waiting for WorkSpaces Workspace (%s) to be terminated", d.Id())
+	if err := waitForWorkspacesWorkspaceTermination(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) to be terminated: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func modifyWorkspacesWorkspaceProperties(conn *workspaces.WorkSpaces, id string, properties *workspaces.WorkspaceProperties, timeout time.Duration) error {
+	input := &workspaces.ModifyWorkspacePropertiesInput{
+		WorkspaceId:         aws.String(id),
+		WorkspaceProperties: properties,
+	}
+
+	log.Printf("[DEBUG] Modifying WorkSpaces Workspace properties: %s", input)
+	if _, err := conn.ModifyWorkspaceProperties(input); err != nil {
+		return fmt.Errorf("error modifying WorkSpaces Workspace (%s) properties: %s", id, err)
+	}
+
+	if err := waitForWorkspacesWorkspaceUpdate(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) properties update: %s", id, err)
+	}
+
+	return nil
+}
+
+func expandWorkspacesWorkspaceProperties(l []interface{}) *workspaces.WorkspaceProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	properties := &workspaces.WorkspaceProperties{}
+
+	if v, ok := m["compute_type_name"].(string); ok && v != "" {
+		properties.ComputeTypeName = aws.String(v)
+	}
+
+	if v, ok := m["root_volume_size_gib"].(int); ok && v > 0 {
+		properties.RootVolumeSizeGib = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["running_mode"].(string); ok && v != "" {
+		properties.RunningMode = aws.String(v)
+	}
+
+	if v, ok := m["running_mode_auto_stop_timeout_in_minutes"].(int); ok && v > 0 {
+		properties.RunningModeAutoStopTimeoutInMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["user_volume_size_gib"].(int); ok && v > 0 {
+		properties.UserVolumeSizeGib = aws.Int64(int64(v))
+	}
+
+	return properties
+}
+
+func flattenWorkspacesWorkspaceProperties(properties *workspaces.WorkspaceProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"compute_type_name":                         aws.StringValue(properties.ComputeTypeName),
+			"root_volume_size_gib":                      int(aws.Int64Value(properties.RootVolumeSizeGib)),
+			"running_mode":                              aws.StringValue(properties.RunningMode),
+			"running_mode_auto_stop_timeout_in_minutes": int(aws.Int64Value(properties.RunningModeAutoStopTimeoutInMinutes)),
+			"user_volume_size_gib":                      int(aws.Int64Value(properties.UserVolumeSizeGib)),
+		},
+	}
+}
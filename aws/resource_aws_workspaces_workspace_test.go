@@ -0,0 +1,294 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+)
+
+func init() {
+	addTestSweepers("aws_workspaces_workspace", &resource.Sweeper{
+		Name: "aws_workspaces_workspace",
+		F:    testSweepWorkspacesWorkspaces,
+	})
+}
+
+func testSweepWorkspacesWorkspaces(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).workspacesconn
+
+	var resources []*sweep.Resource
+
+	err = conn.DescribeWorkspacesPages(&workspaces.DescribeWorkspacesInput{}, func(page *workspaces.DescribeWorkspacesOutput, lastPage bool) bool {
+		for _, workspace := range page.Workspaces {
+			if aws.StringValue(workspace.State) == workspaces.WorkspaceStateTerminated {
+				continue
+			}
+
+			id := aws.StringValue(workspace.WorkspaceId)
+
+			resources = append(resources, &sweep.Resource{
+				ID: id,
+				Delete: func() error {
+					_, err := conn.TerminateWorkspaces(&workspaces.TerminateWorkspacesInput{
+						TerminateWorkspaceRequests: []*workspaces.TerminateRequest{
+							{
+								WorkspaceId: aws.String(id),
+							},
+						},
+					})
+					if err != nil {
+						return err
+					}
+					return waitForWorkspacesWorkspaceTermination(conn, id, 10*time.Minute)
+				},
+			})
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Workspace sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Workspaces: %s", err)
+	}
+
+	if err := testSweepResources(region, "aws_workspaces_workspace", sweep.Filter{}, resources); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSWorkspacesWorkspace_basic(t *testing.T) {
+	var workspace workspaces.Workspace
+	directoryID := testAccAWSWorkspacesDirectoryID(t)
+	resourceName := "aws_workspaces_workspace.test"
+	bundleDataSourceName := "data.aws_workspaces_bundle.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSWorkspaces(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfig(directoryID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttrPair(resourceName, "bundle_id", bundleDataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "computer_name"),
+					resource.TestCheckResourceAttr(resourceName, "directory_id", directoryID),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address"),
+					resource.TestCheckResourceAttr(resourceName, "root_volume_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", workspaces.WorkspaceStateAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", "Administrator"),
+					resource.TestCheckResourceAttr(resourceName, "user_volume_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.compute_type_name", workspaces.ComputeValue),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", workspaces.RunningModeAlwaysOn),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSWorkspacesWorkspace_WorkspaceProperties(t *testing.T) {
+	var workspace1, workspace2 workspaces.Workspace
+	directoryID := testAccAWSWorkspacesDirectoryID(t)
+	resourceName := "aws_workspaces_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSWorkspaces(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfigWorkspaceProperties(directoryID, workspaces.RunningModeAutoStop, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &workspace1),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", workspaces.RunningModeAutoStop),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfigWorkspaceProperties(directoryID, workspaces.RunningModeAutoStop, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &workspace2),
+					testAccCheckAwsWorkspacesWorkspaceNotRecreated(&workspace1, &workspace2),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSWorkspacesWorkspace_Tags(t *testing.T) {
+	var workspace1, workspace2 workspaces.Workspace
+	directoryID := testAccAWSWorkspacesDirectoryID(t)
+	resourceName := "aws_workspaces_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSWorkspaces(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfigTags1(directoryID, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &workspace1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfigTags1(directoryID, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &workspace2),
+					testAccCheckAwsWorkspacesWorkspaceNotRecreated(&workspace1, &workspace2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsWorkspacesWorkspaceExists(resourceName string, v *workspaces.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		workspace, err := describeWorkspacesWorkspace(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if workspace == nil {
+			return fmt.Errorf("WorkSpaces Workspace (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *workspace
+
+		return nil
+	}
+}
+
+func testAccCheckAwsWorkspacesWorkspaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_workspace" {
+			continue
+		}
+
+		workspace, err := describeWorkspacesWorkspace(conn, rs.Primary.ID)
+
+		if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if workspace != nil && aws.StringValue(workspace.State) != workspaces.WorkspaceStateTerminated {
+			return fmt.Errorf("WorkSpaces Workspace (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsWorkspacesWorkspaceNotRecreated(i, j *workspaces.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.WorkspaceId) != aws.StringValue(j.WorkspaceId) {
+			return fmt.Errorf("WorkSpaces Workspace (%s) recreated", aws.StringValue(i.WorkspaceId))
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsWorkspacesWorkspaceConfigBase() string {
+	return `
+# Standard with Windows 10
+data "aws_workspaces_bundle" "test" {
+  bundle_id = "wsb-gk1wpk43z"
+}
+`
+}
+
+func testAccAwsWorkspacesWorkspaceConfig(directoryID string) string {
+	return testAccAwsWorkspacesWorkspaceConfigBase() + fmt.Sprintf(`
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "${data.aws_workspaces_bundle.test.id}"
+  directory_id = %[1]q
+  user_name    = "Administrator"
+}
+`, directoryID)
+}
+
+func testAccAwsWorkspacesWorkspaceConfigWorkspaceProperties(directoryID, runningMode string, autoStopTimeout int) string {
+	return testAccAwsWorkspacesWorkspaceConfigBase() + fmt.Sprintf(`
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "${data.aws_workspaces_bundle.test.id}"
+  directory_id = %[1]q
+  user_name    = "Administrator"
+
+  workspace_properties {
+    running_mode                              = %[2]q
+    running_mode_auto_stop_timeout_in_minutes = %[3]d
+  }
+}
+`, directoryID, runningMode, autoStopTimeout)
+}
+
+func testAccAwsWorkspacesWorkspaceConfigTags1(directoryID, tagKey1, tagValue1 string) string {
+	return testAccAwsWorkspacesWorkspaceConfigBase() + fmt.Sprintf(`
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "${data.aws_workspaces_bundle.test.id}"
+  directory_id = %[1]q
+  user_name    = "Administrator"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, directoryID, tagKey1, tagValue1)
+}
@@ -0,0 +1,456 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+)
+
+const (
+	// RetryModeLegacy retries with the AWS Go SDK default backoff.
+	RetryModeLegacy = "legacy"
+	// RetryModeStandard retries with the AWS Go SDK default backoff, capped
+	// at the configured maximum backoff.
+	RetryModeStandard = "standard"
+	// RetryModeAdaptive retries as RetryModeStandard and additionally lowers
+	// the client side request rate of a service when it is throttled.
+	RetryModeAdaptive = "adaptive"
+
+	// defaultRetryMaxBackoff is the maximum backoff used by the standard and
+	// adaptive retry modes when none is configured.
+	defaultRetryMaxBackoff = 20 * time.Second
+
+	// minimumAdaptiveRequestRate is the lowest request rate, in requests per
+	// second, the adaptive retry mode lowers a service to.
+	minimumAdaptiveRequestRate = 0.5
+)
+
+func retryModes() []string {
+	return []string{
+		RetryModeLegacy,
+		RetryModeStandard,
+		RetryModeAdaptive,
+	}
+}
+
+// serviceRetryRule describes an API error which is retried in addition to
+// the AWS Go SDK defaults.
+type serviceRetryRule struct {
+	// Operations restricts the rule to the named API operations.
+	Operations []string
+	// OperationPrefixes restricts the rule to API operations with one of the
+	// named prefixes.
+	OperationPrefixes []string
+	// Code is the error code to match.
+	Code string
+	// Message is a substring of the error message to match, if not empty.
+	Message string
+}
+
+// matches returns whether the rule applies to a failed request.
+func (rule serviceRetryRule) matches(r *request.Request) bool {
+	if len(rule.Operations) > 0 || len(rule.OperationPrefixes) > 0 {
+		var matched bool
+
+		for _, operation := range rule.Operations {
+			if r.Operation.Name == operation {
+				matched = true
+				break
+			}
+		}
+
+		for _, prefix := range rule.OperationPrefixes {
+			if strings.HasPrefix(r.Operation.Name, prefix) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return isAWSErr(r.Error, rule.Code, rule.Message)
+}
+
+// serviceRetryRules are the additional retried API errors, keyed by the
+// service ID of the AWS Go SDK client.
+var serviceRetryRules = map[string][]serviceRetryRule{
+	applicationautoscaling.ServiceID: {
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+		{
+			OperationPrefixes: []string{"Describe", "List"},
+			Code:              applicationautoscaling.ErrCodeFailedResourceAccessException,
+		},
+	},
+	appsync.ServiceID: {
+		{
+			Operations: []string{"CreateGraphqlApi"},
+			Code:       appsync.ErrCodeConcurrentModificationException,
+			Message:    "a GraphQL API creation is already in progress",
+		},
+	},
+	dynamodb.ServiceID: {
+		// See https://github.com/aws/aws-sdk-go/pull/1276
+		{
+			Operations: []string{"PutItem", "UpdateItem", "DeleteItem"},
+			Code:       dynamodb.ErrCodeLimitExceededException,
+			Message:    "Subscriber limit exceeded:",
+		},
+	},
+	ec2.ServiceID: {
+		{
+			Operations: []string{"CreateVpnConnection"},
+			Code:       "VpnConnectionLimitExceeded",
+			Message:    "maximum number of mutating objects has been reached",
+		},
+		{
+			Operations: []string{"CreateVpnGateway"},
+			Code:       "VpnGatewayLimitExceeded",
+			Message:    "maximum number of mutating objects has been reached",
+		},
+	},
+	kafka.ServiceID: {
+		{
+			Code:    kafka.ErrCodeTooManyRequestsException,
+			Message: "Too Many Requests",
+		},
+	},
+	kinesis.ServiceID: {
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
+		{
+			OperationPrefixes: []string{"Describe", "List"},
+			Code:              kinesis.ErrCodeLimitExceededException,
+		},
+		{
+			Operations: []string{"CreateStream"},
+			Code:       kinesis.ErrCodeLimitExceededException,
+			Message:    "simultaneously be in CREATING or DELETING",
+		},
+		{
+			Operations: []string{"CreateStream", "DeleteStream"},
+			Code:       kinesis.ErrCodeLimitExceededException,
+			Message:    "Rate exceeded for stream",
+		},
+	},
+	storagegateway.ServiceID: {
+		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+		{
+			Code:    storagegateway.ErrCodeInvalidGatewayRequestException,
+			Message: "The specified gateway proxy network connection is busy",
+		},
+	},
+}
+
+// serviceRetryRulesHandler marks failed requests matching a rule of
+// serviceRetryRules as retryable.
+func serviceRetryRulesHandler(r *request.Request) {
+	if _, ok := r.Error.(awserr.Error); !ok {
+		return
+	}
+
+	for _, rule := range serviceRetryRules[r.ClientInfo.ServiceID] {
+		if rule.matches(r) {
+			r.Retryable = aws.Bool(true)
+			return
+		}
+	}
+}
+
+// retryServiceName returns the name used to configure the request rate of
+// the service of a request, e.g. "ec2", "route53" or "cloudwatchevents".
+func retryServiceName(r *request.Request) string {
+	return strings.ToLower(strings.Replace(r.ClientInfo.ServiceID, " ", "", -1))
+}
+
+// maxBackoffRetryer caps the delay between retries of the retryer of a
+// service client, which may be customized by the AWS Go SDK.
+type maxBackoffRetryer struct {
+	request.Retryer
+
+	maxBackoff time.Duration
+}
+
+// RetryRules returns the delay before retrying a request.
+func (r maxBackoffRetryer) RetryRules(req *request.Request) time.Duration {
+	delay := r.Retryer.RetryRules(req)
+
+	if delay > r.maxBackoff {
+		return r.maxBackoff
+	}
+
+	return delay
+}
+
+// requestRateLimiter is a token bucket limiting the rate of requests to a
+// service. A limiter without a rate does not limit requests until adaptive
+// mode lowers its rate after throttling.
+type requestRateLimiter struct {
+	sync.Mutex
+
+	adaptive bool
+	maxRate  float64
+	rate     float64
+	tokens   float64
+	last     time.Time
+
+	// Requests in the current window of at least one second and the rate
+	// of the previous window, used to estimate the request rate of an
+	// unlimited service when throttled.
+	windowStart    time.Time
+	windowRequests int
+	measuredRate   float64
+}
+
+func newRequestRateLimiter(rate float64, adaptive bool) *requestRateLimiter {
+	return &requestRateLimiter{
+		adaptive: adaptive,
+		maxRate:  rate,
+		rate:     rate,
+		tokens:   math.Max(rate, 1),
+	}
+}
+
+// reserve takes a token, returning how long to wait before sending the
+// request.
+func (l *requestRateLimiter) reserve(now time.Time) time.Duration {
+	l.Lock()
+	defer l.Unlock()
+
+	if now.Sub(l.windowStart) >= time.Second {
+		l.measuredRate = float64(l.windowRequests) / math.Max(now.Sub(l.windowStart).Seconds(), 1)
+		l.windowStart = now
+		l.windowRequests = 0
+	}
+	l.windowRequests++
+
+	if l.rate <= 0 {
+		return 0
+	}
+
+	burst := math.Max(l.rate, 1)
+
+	if !l.last.IsZero() {
+		l.tokens = math.Min(burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// throttled halves the request rate in adaptive mode.
+func (l *requestRateLimiter) throttled() {
+	if !l.adaptive {
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	rate := l.rate
+	if rate <= 0 {
+		rate = math.Max(l.measuredRate, float64(l.windowRequests))
+	}
+
+	l.rate = math.Max(rate/2, minimumAdaptiveRequestRate)
+	l.tokens = math.Min(l.tokens, math.Max(l.rate, 1))
+
+	log.Printf("[DEBUG] Lowering request rate after throttling to %.2f requests per second", l.rate)
+}
+
+// succeeded raises a lowered request rate by 5% in adaptive mode, up to the
+// configured rate.
+func (l *requestRateLimiter) succeeded() {
+	if !l.adaptive {
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	if l.rate <= 0 {
+		return
+	}
+
+	l.rate *= 1.05
+
+	if l.maxRate > 0 && l.rate > l.maxRate {
+		l.rate = l.maxRate
+	}
+}
+
+// requestRateLimiters holds the request rate limiter of each service and
+// region combination.
+type requestRateLimiters struct {
+	sync.Mutex
+
+	adaptive bool
+	limiters map[string]*requestRateLimiter
+	rates    map[string]float64
+}
+
+// limiter returns the limiter of the service and region of a request, or nil
+// if the request rate of the service is not limited.
+func (l *requestRateLimiters) limiter(r *request.Request) *requestRateLimiter {
+	serviceName := retryServiceName(r)
+	rate := l.rates[serviceName]
+
+	if rate <= 0 && !l.adaptive {
+		return nil
+	}
+
+	key := fmt.Sprintf("%s/%s", serviceName, aws.StringValue(r.Config.Region))
+
+	l.Lock()
+	defer l.Unlock()
+
+	limiter, ok := l.limiters[key]
+
+	if !ok {
+		limiter = newRequestRateLimiter(rate, l.adaptive)
+		l.limiters[key] = limiter
+	}
+
+	return limiter
+}
+
+// waitHandler delays each request attempt until the rate limiter of its
+// service allows it.
+func (l *requestRateLimiters) waitHandler(r *request.Request) {
+	limiter := l.limiter(r)
+
+	if limiter == nil {
+		return
+	}
+
+	delay := limiter.reserve(time.Now())
+
+	if delay <= 0 {
+		return
+	}
+
+	if err := aws.SleepWithContext(r.Context(), delay); err != nil {
+		r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while rate limited", err)
+	}
+}
+
+// retryHandler lowers the request rate of throttled services.
+func (l *requestRateLimiters) retryHandler(r *request.Request) {
+	if !r.IsErrorThrottle() {
+		return
+	}
+
+	if limiter := l.limiter(r); limiter != nil {
+		limiter.throttled()
+	}
+}
+
+// completeHandler raises the request rate of services after success.
+func (l *requestRateLimiters) completeHandler(r *request.Request) {
+	if r.Error != nil {
+		return
+	}
+
+	if limiter := l.limiter(r); limiter != nil {
+		limiter.succeeded()
+	}
+}
+
+// awsCodeRetryTimeout returns how long to retry an AWS error code, the given
+// default timeout unless the retry policy of the provider meta allows
+// retrying longer.
+func awsCodeRetryTimeout(meta interface{}, defaultTimeout time.Duration) time.Duration {
+	if client, ok := meta.(*AWSClient); ok && client != nil && client.retryTimeout > defaultTimeout {
+		return client.retryTimeout
+	}
+
+	return defaultTimeout
+}
+
+// retryTimeout returns how long the AWS Go SDK retries a request at most
+// under the standard and adaptive retry modes, or zero in the legacy mode.
+func (c *Config) retryTimeout() time.Duration {
+	if c.RetryMode != RetryModeStandard && c.RetryMode != RetryModeAdaptive {
+		return 0
+	}
+
+	maxBackoff := c.RetryMaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	return time.Duration(c.MaxRetries) * maxBackoff
+}
+
+// configureRetries applies the provider retry configuration to a session
+// before any service client is created from it.
+func (c *Config) configureRetries(sess *session.Session) {
+	sess.Handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.ServiceRetryRules",
+		Fn:   serviceRetryRulesHandler,
+	})
+
+	if c.RetryMode == RetryModeStandard || c.RetryMode == RetryModeAdaptive {
+		maxBackoff := c.RetryMaxBackoff
+		if maxBackoff <= 0 {
+			maxBackoff = defaultRetryMaxBackoff
+		}
+
+		// Wrap the retryer of each request rather than configuring one on the
+		// session, which would replace service specific AWS Go SDK retryers.
+		sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+			Name: "terraform-provider-aws.MaxBackoff",
+			Fn: func(r *request.Request) {
+				r.Retryer = maxBackoffRetryer{
+					Retryer:    r.Retryer,
+					maxBackoff: maxBackoff,
+				}
+			},
+		})
+	}
+
+	if len(c.RetryServiceRequestRates) == 0 && c.RetryMode != RetryModeAdaptive {
+		return
+	}
+
+	limiters := &requestRateLimiters{
+		adaptive: c.RetryMode == RetryModeAdaptive,
+		limiters: make(map[string]*requestRateLimiter),
+		rates:    c.RetryServiceRequestRates,
+	}
+
+	// Signing happens before every attempt, including retries.
+	sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RequestRateLimit",
+		Fn:   limiters.waitHandler,
+	})
+	sess.Handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RequestRateLimitThrottled",
+		Fn:   limiters.retryHandler,
+	})
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RequestRateLimitComplete",
+		Fn:   limiters.completeHandler,
+	})
+}
@@ -0,0 +1,329 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestServiceRetryRulesHandler(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		ServiceID string
+		Operation string
+		Error     error
+		Expected  bool
+	}{
+		{
+			Name:      "operation prefix",
+			ServiceID: kinesis.ServiceID,
+			Operation: "DescribeStream",
+			Error:     awserr.New(kinesis.ErrCodeLimitExceededException, "Rate exceeded", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "operation and message",
+			ServiceID: kinesis.ServiceID,
+			Operation: "DeleteStream",
+			Error:     awserr.New(kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream test", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "message mismatch",
+			ServiceID: kinesis.ServiceID,
+			Operation: "DeleteStream",
+			Error:     awserr.New(kinesis.ErrCodeLimitExceededException, "Other error", nil),
+			Expected:  false,
+		},
+		{
+			Name:      "operation mismatch",
+			ServiceID: ec2.ServiceID,
+			Operation: "CreateVpc",
+			Error:     awserr.New("VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached", nil),
+			Expected:  false,
+		},
+		{
+			Name:      "operation match",
+			ServiceID: ec2.ServiceID,
+			Operation: "CreateVpnGateway",
+			Error:     awserr.New("VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "service without rules",
+			ServiceID: "Route 53",
+			Operation: "CreateHostedZone",
+			Error:     awserr.New("Throttling", "Rate exceeded", nil),
+			Expected:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := &request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceID: testCase.ServiceID},
+				Operation:  &request.Operation{Name: testCase.Operation},
+				Error:      testCase.Error,
+			}
+
+			serviceRetryRulesHandler(r)
+
+			if got := aws.BoolValue(r.Retryable); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestMaxBackoffRetryer(t *testing.T) {
+	retryer := maxBackoffRetryer{
+		Retryer:    client.DefaultRetryer{NumMaxRetries: 25},
+		maxBackoff: 2 * time.Second,
+	}
+
+	r := &request.Request{
+		HTTPResponse: &http.Response{StatusCode: 500},
+		RetryCount:   13,
+		Error:        awserr.New("RequestError", "send request failed", nil),
+	}
+
+	for i := 0; i < 10; i++ {
+		if delay := retryer.RetryRules(r); delay > 2*time.Second {
+			t.Fatalf("expected delay of at most 2s, got: %s", delay)
+		}
+	}
+
+	if got := retryer.MaxRetries(); got != 25 {
+		t.Fatalf("expected 25 maximum retries, got: %d", got)
+	}
+}
+
+func TestRequestRateLimiterReserve(t *testing.T) {
+	limiter := newRequestRateLimiter(2, false)
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if delay := limiter.reserve(now); delay != 0 {
+			t.Fatalf("expected no delay within burst, got: %s", delay)
+		}
+	}
+
+	if delay := limiter.reserve(now); delay != 500*time.Millisecond {
+		t.Fatalf("expected 500ms delay, got: %s", delay)
+	}
+
+	if delay := limiter.reserve(now.Add(2 * time.Second)); delay != 0 {
+		t.Fatalf("expected no delay after refill, got: %s", delay)
+	}
+
+	unlimited := newRequestRateLimiter(0, false)
+
+	for i := 0; i < 100; i++ {
+		if delay := unlimited.reserve(now); delay != 0 {
+			t.Fatalf("expected no delay without rate, got: %s", delay)
+		}
+	}
+}
+
+func TestRequestRateLimiterAdaptive(t *testing.T) {
+	limiter := newRequestRateLimiter(10, true)
+
+	limiter.throttled()
+
+	if limiter.rate != 5 {
+		t.Fatalf("expected rate 5 after throttling, got: %f", limiter.rate)
+	}
+
+	limiter.succeeded()
+
+	if limiter.rate != 5.25 {
+		t.Fatalf("expected rate 5.25 after success, got: %f", limiter.rate)
+	}
+
+	for i := 0; i < 100; i++ {
+		limiter.succeeded()
+	}
+
+	if limiter.rate != 10 {
+		t.Fatalf("expected rate capped at 10, got: %f", limiter.rate)
+	}
+
+	for i := 0; i < 100; i++ {
+		limiter.throttled()
+	}
+
+	if limiter.rate != minimumAdaptiveRequestRate {
+		t.Fatalf("expected minimum rate %f, got: %f", minimumAdaptiveRequestRate, limiter.rate)
+	}
+
+	unlimited := newRequestRateLimiter(0, true)
+	now := time.Now()
+
+	for i := 0; i < 8; i++ {
+		unlimited.reserve(now)
+	}
+
+	unlimited.throttled()
+
+	if unlimited.rate != 4 {
+		t.Fatalf("expected rate 4 after throttling, got: %f", unlimited.rate)
+	}
+
+	nonAdaptive := newRequestRateLimiter(10, false)
+	nonAdaptive.throttled()
+
+	if nonAdaptive.rate != 10 {
+		t.Fatalf("expected unchanged rate without adaptive mode, got: %f", nonAdaptive.rate)
+	}
+}
+
+func TestConfigConfigureRetries(t *testing.T) {
+	newSession := func() *session.Session {
+		sess, err := session.NewSession(&aws.Config{
+			Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+			MaxRetries:  aws.Int(5),
+			Region:      aws.String("us-west-2"),
+		})
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		return sess
+	}
+
+	sess := newSession()
+	signHandlers := sess.Handlers.Sign.Len()
+	validateHandlers := sess.Handlers.Validate.Len()
+	(&Config{}).configureRetries(sess)
+
+	if sess.Handlers.Validate.Len() != validateHandlers {
+		t.Fatal("expected no maximum backoff in legacy mode")
+	}
+	if sess.Handlers.Sign.Len() != signHandlers {
+		t.Fatal("expected no rate limiting without request rates")
+	}
+
+	sess = newSession()
+	(&Config{
+		RetryMode:                RetryModeStandard,
+		RetryMaxBackoff:          10 * time.Second,
+		RetryServiceRequestRates: map[string]float64{"ec2": 5},
+	}).configureRetries(sess)
+
+	req, _ := ec2.New(sess).DescribeVpcsRequest(&ec2.DescribeVpcsInput{})
+	req.Handlers.Validate.Run(req)
+
+	retryer, ok := req.Retryer.(maxBackoffRetryer)
+	if !ok {
+		t.Fatalf("expected maximum backoff retryer in standard mode, got: %T", req.Retryer)
+	}
+	if retryer.maxBackoff != 10*time.Second {
+		t.Fatalf("expected maximum backoff of 10s, got: %s", retryer.maxBackoff)
+	}
+	if retryer.MaxRetries() != 5 {
+		t.Fatalf("expected 5 maximum retries, got: %d", retryer.MaxRetries())
+	}
+	if sess.Handlers.Sign.Len() != signHandlers+1 {
+		t.Fatal("expected rate limiting handler")
+	}
+}
+
+func TestAwsCodeRetryTimeout(t *testing.T) {
+	if got := awsCodeRetryTimeout(nil, 2*time.Minute); got != 2*time.Minute {
+		t.Fatalf("expected default timeout without provider meta, got: %s", got)
+	}
+
+	legacy := &AWSClient{retryTimeout: (&Config{MaxRetries: 25}).retryTimeout()}
+
+	if got := awsCodeRetryTimeout(legacy, 2*time.Minute); got != 2*time.Minute {
+		t.Fatalf("expected default timeout in legacy mode, got: %s", got)
+	}
+
+	standard := &AWSClient{retryTimeout: (&Config{
+		MaxRetries:      25,
+		RetryMode:       RetryModeStandard,
+		RetryMaxBackoff: 30 * time.Second,
+	}).retryTimeout()}
+
+	if got := awsCodeRetryTimeout(standard, 2*time.Minute); got != 25*30*time.Second {
+		t.Fatalf("expected timeout of the retry policy, got: %s", got)
+	}
+
+	adaptive := &AWSClient{retryTimeout: (&Config{
+		MaxRetries: 2,
+		RetryMode:  RetryModeAdaptive,
+	}).retryTimeout()}
+
+	if got := awsCodeRetryTimeout(adaptive, 1*time.Minute); got != 1*time.Minute {
+		t.Fatalf("expected default timeout longer than the retry policy, got: %s", got)
+	}
+
+	// Retry policies of other providers do not apply
+	if got := awsCodeRetryTimeout(legacy, 2*time.Minute); got != 2*time.Minute {
+		t.Fatalf("expected default timeout of the provider, got: %s", got)
+	}
+}
+
+func TestRequestRateLimitersLimiter(t *testing.T) {
+	limiters := &requestRateLimiters{
+		limiters: make(map[string]*requestRateLimiter),
+		rates:    map[string]float64{"route53": 5},
+	}
+
+	newRequest := func(serviceID, region string) *request.Request {
+		return &request.Request{
+			ClientInfo: metadata.ClientInfo{ServiceID: serviceID},
+			Config:     aws.Config{Region: aws.String(region)},
+		}
+	}
+
+	if limiter := limiters.limiter(newRequest("EC2", "us-west-2")); limiter != nil {
+		t.Fatal("expected no limiter for service without rate")
+	}
+
+	usWest2 := limiters.limiter(newRequest("Route 53", "us-west-2"))
+	if usWest2 == nil || usWest2.rate != 5 {
+		t.Fatalf("expected limiter with rate 5, got: %#v", usWest2)
+	}
+	if limiters.limiter(newRequest("Route 53", "us-west-2")) != usWest2 {
+		t.Fatal("expected same limiter for same service and region")
+	}
+	if limiters.limiter(newRequest("Route 53", "eu-west-1")) == usWest2 {
+		t.Fatal("expected separate limiter per region")
+	}
+}
+
+func TestProviderRetrySchema(t *testing.T) {
+	p := Provider().(*schema.Provider)
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"retry": p.Schema["retry"]}, map[string]interface{}{
+		"retry": []interface{}{
+			map[string]interface{}{
+				"mode":        RetryModeAdaptive,
+				"max_backoff": 30,
+				"service_request_rates": map[string]interface{}{
+					"ec2": 2.5,
+				},
+			},
+		},
+	})
+
+	retry := d.Get("retry").([]interface{})[0].(map[string]interface{})
+
+	if got := retry["mode"].(string); got != RetryModeAdaptive {
+		t.Fatalf("expected mode %s, got: %s", RetryModeAdaptive, got)
+	}
+	if got := retry["service_request_rates"].(map[string]interface{})["ec2"].(float64); got != 2.5 {
+		t.Fatalf("expected ec2 rate 2.5, got: %f", got)
+	}
+}
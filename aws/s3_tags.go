@@ -2,27 +2,28 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsS3(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsS3(tagsFromMapS3(o), tagsFromMapS3(n))
+		oldTags := keyvaluetags.New(o).IgnoreAws()
+		newTags := keyvaluetags.New(n).IgnoreAws()
 
 		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			_, err := RetryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
+		if remove := oldTags.Removed(newTags); len(remove) > 0 {
+			log.Printf("[DEBUG] Removing tags: %#v", remove.Keys())
+			_, err := RetryOnAwsCodes(meta, []string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
 				return conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
 					Bucket: aws.String(d.Get("bucket").(string)),
 				})
@@ -31,16 +32,16 @@ func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
 				return err
 			}
 		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+		if len(newTags) > 0 {
+			log.Printf("[DEBUG] Creating tags: %#v", newTags.Map())
 			req := &s3.PutBucketTaggingInput{
 				Bucket: aws.String(d.Get("bucket").(string)),
 				Tagging: &s3.Tagging{
-					TagSet: create,
+					TagSet: newTags.S3Tags(),
 				},
 			}
 
-			_, err := RetryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
+			_, err := RetryOnAwsCodes(meta, []string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
 				return conn.PutBucketTagging(req)
 			})
 			if err != nil {
@@ -52,8 +53,8 @@ func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func getTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
-	resp, err := retryOnAwsCode(s3.ErrCodeNoSuchKey, func() (interface{}, error) {
+func getTagsS3Object(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
+	resp, err := retryOnAwsCode(meta, s3.ErrCodeNoSuchKey, func() (interface{}, error) {
 		return conn.GetObjectTagging(&s3.GetObjectTaggingInput{
 			Bucket: aws.String(d.Get("bucket").(string)),
 			Key:    aws.String(d.Get("key").(string)),
@@ -63,7 +64,7 @@ func getTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
 		return err
 	}
 
-	if err := d.Set("tags", tagsToMapS3(resp.(*s3.GetObjectTaggingOutput).TagSet)); err != nil {
+	if err := d.Set("tags", keyvaluetags.S3KeyValueTags(resp.(*s3.GetObjectTaggingOutput).TagSet).IgnoreAws().Map()); err != nil {
 		return err
 	}
 
@@ -71,8 +72,8 @@ func getTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
 }
 
 func setTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 
@@ -91,7 +92,7 @@ func setTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
 				Bucket: aws.String(d.Get("bucket").(string)),
 				Key:    aws.String(d.Get("key").(string)),
 				Tagging: &s3.Tagging{
-					TagSet: tagsFromMapS3(n),
+					TagSet: keyvaluetags.New(n).IgnoreAws().S3Tags(),
 				},
 			})
 			if err != nil {
@@ -103,57 +104,6 @@ func setTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsS3(oldTags, newTags []*s3.Tag) ([]*s3.Tag, []*s3.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*s3.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapS3(create), remove
-}
-
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
-	result := make([]*s3.Tag, 0, len(m))
-	for k, v := range m {
-		t := &s3.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredS3(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
-}
-
-// tagsToMap turns the list of tags into a map.
-func tagsToMapS3(ts []*s3.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredS3(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
-}
-
 // return a slice of s3 tags associated with the given s3 bucket. Essentially
 // s3.GetBucketTagging, except returns an empty slice instead of an error when
 // there are no tags.
@@ -172,18 +122,3 @@ func getTagSetS3(s3conn *s3.S3, bucket string) ([]*s3.Tag, error) {
 
 	return response.TagSet, nil
 }
-
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredS3(t *s3.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
-}
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -11,13 +10,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // tagsSchema returns the schema to use for tags.
-//
 func tagsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
@@ -41,38 +41,188 @@ func tagsSchemaForceNew() *schema.Schema {
 	}
 }
 
-func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
+// resourceWithTagsConfig extends a resource that has a "tags" map argument
+// with the provider level default_tags and ignore_tags. The configured tags
+// merged with the default tags are exposed in the computed "tags_all"
+// attribute and are the tags sent to AWS, while "tags" continues to only hold
+// the configured tags. Ignored tags are removed from both.
+func resourceWithTagsConfig(r *schema.Resource) {
+	s, ok := r.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap || !s.Optional {
+		return
+	}
+	if _, ok := r.Schema["tags_all"]; ok {
+		return
+	}
 
-		// Set tags
-		if len(remove) > 0 {
-			var tagKeys []*string
-			for _, tag := range remove {
-				tagKeys = append(tagKeys, tag.Key)
-			}
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			_, err := conn.RemoveTags(&elbv2.RemoveTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				TagKeys:      tagKeys,
-			})
-			if err != nil {
-				return err
-			}
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+
+	customizeDiff := resourceTagsAllCustomizeDiff(s.ForceNew)
+	if r.CustomizeDiff != nil {
+		customizeDiff = customdiff.Sequence(customizeDiff, r.CustomizeDiff)
+	}
+	r.CustomizeDiff = customizeDiff
+
+	create, read, update := r.Create, r.Read, r.Update
+
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		return resourceApplyWithDefaultTags(create, d, meta)
+	}
+
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		tags := keyvaluetags.New(d.Get("tags").(map[string]interface{}))
+
+		// Not every resource reads its tags back from AWS, so start from
+		// the tags that were last applied.
+		if err := d.Set("tags", tags.Merge(keyvaluetags.New(d.Get("tags_all").(map[string]interface{}))).Map()); err != nil {
+			return fmt.Errorf("error setting tags: %s", err)
 		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-			_, err := conn.AddTags(&elbv2.AddTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
+
+		if err := read(d, meta); err != nil {
+			return err
+		}
+
+		if d.Id() == "" {
+			return nil
+		}
+
+		return resourceSetTagsAll(d, meta, tags)
+	}
+
+	if update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return resourceApplyWithDefaultTags(update, d, meta)
+		}
+	}
+}
+
+// resourceApplyWithDefaultTags calls a resource create or update function
+// with "tags" holding the configured tags merged with the default tags.
+func resourceApplyWithDefaultTags(f func(*schema.ResourceData, interface{}) error, d *schema.ResourceData, meta interface{}) error {
+	configTags := keyvaluetags.New(d.Get("tags").(map[string]interface{}))
+
+	tags := defaultTagsConfigFromMeta(meta).MergeTags(configTags).IgnoreConfig(ignoreTagsConfigFromMeta(meta))
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	err := f(d, meta)
+
+	if d.Id() == "" {
+		return err
+	}
+
+	if err != nil {
+		if err := resourceSetTagsAll(d, meta, configTags); err != nil {
+			log.Printf("[WARN] %s", err)
+		}
+
+		return err
+	}
+
+	return resourceSetTagsAll(d, meta, configTags)
+}
+
+// resourceSetTagsAll moves the tags read from AWS into "tags_all" and sets
+// "tags" to those tags without any default tags missing from configuration.
+func resourceSetTagsAll(d *schema.ResourceData, meta interface{}, configTags keyvaluetags.KeyValueTags) error {
+	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreConfig(ignoreTagsConfigFromMeta(meta))
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
+	tags = tags.RemoveDefaultConfig(defaultTagsConfigFromMeta(meta)).Merge(tags.Only(configTags))
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+// resourceTagsAllCustomizeDiff plans "tags_all" as the configured tags merged
+// with the default tags.
+func resourceTagsAllCustomizeDiff(forceNew bool) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown("tags") {
+			return d.SetNewComputed("tags_all")
+		}
+
+		tags := keyvaluetags.New(d.Get("tags").(map[string]interface{}))
+		tags = defaultTagsConfigFromMeta(meta).MergeTags(tags).IgnoreConfig(ignoreTagsConfigFromMeta(meta))
+
+		if err := d.SetNew("tags_all", tags.Map()); err != nil {
+			return fmt.Errorf("error setting tags_all diff: %s", err)
+		}
+
+		if forceNew && d.Id() != "" && d.HasChange("tags_all") {
+			return d.ForceNew("tags_all")
+		}
+
+		return nil
+	}
+}
+
+// dataSourceWithTagsConfig extends a data source that has a "tags" map
+// attribute with the provider level ignore_tags.
+func dataSourceWithTagsConfig(r *schema.Resource) {
+	s, ok := r.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap || !s.Computed {
+		return
+	}
+
+	read := r.Read
+
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if err := read(d, meta); err != nil {
+			return err
+		}
+
+		ignoreConfig := ignoreTagsConfigFromMeta(meta)
+		if ignoreConfig == nil {
+			return nil
 		}
+
+		tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreConfig(ignoreConfig)
+
+		if err := d.Set("tags", tags.Map()); err != nil {
+			return fmt.Errorf("error setting tags: %s", err)
+		}
+
+		return nil
+	}
+}
+
+// ignoreTagsConfigFromMeta returns the provider ignore_tags, if any.
+func ignoreTagsConfigFromMeta(meta interface{}) *keyvaluetags.IgnoreConfig {
+	client, ok := meta.(*AWSClient)
+	if !ok {
+		return nil
+	}
+
+	return client.ignoreTagsConfig
+}
+
+// defaultTagsConfigFromMeta returns the provider default_tags, if any.
+func defaultTagsConfigFromMeta(meta interface{}) *keyvaluetags.DefaultConfig {
+	client, ok := meta.(*AWSClient)
+	if !ok {
+		return nil
+	}
+
+	return client.defaultTagsConfig
+}
+
+func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		return keyvaluetags.Elbv2UpdateTags(conn, d.Id(), o, n)
 	}
 
 	return nil
@@ -83,7 +233,10 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 		oraw, nraw := d.GetChange("volume_tags")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
+		create := oldTags.Updated(newTags).IgnoreAws().Ec2Tags()
+		remove := oldTags.Removed(newTags).IgnoreAws().Ec2Tags()
 
 		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 		if err != nil {
@@ -160,13 +313,16 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 }
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
+// tags field to be named "tags", with the tags to apply in "tags_all"
 func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
+		create := oldTags.Updated(newTags).IgnoreAws().Ec2Tags()
+		remove := oldTags.Removed(newTags).IgnoreAws().Ec2Tags()
 
 		// Set tags
 		if len(remove) > 0 {
@@ -238,136 +394,6 @@ func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
-
-	// Build the list of what to remove
-	var remove []*ec2.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			remove = append(remove, t)
-		} else if ok {
-			// already present so remove from new
-			delete(create, aws.StringValue(t.Key))
-		}
-	}
-
-	return tagsFromMap(create), remove
-}
-
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
-	result := make([]*ec2.Tag, 0, len(m))
-	for k, v := range m {
-		t := &ec2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnored(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
-}
-
-// tagsToMap turns the list of tags into a map.
-func tagsToMap(ts []*ec2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnored(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
-}
-
-func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*elbv2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapELBv2(create), remove
-}
-
-// tagsToMapELBv2 turns the list of tags into a map.
-func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredELBv2(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
-}
-
-// tagsFromMapELBv2 returns the tags for the given map of data.
-func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
-	var result []*elbv2.Tag
-	for k, v := range m {
-		t := &elbv2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredELBv2(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
-}
-
-// tagIgnored compares a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
-}
-
-// and for ELBv2 as well
-func tagIgnoredELBv2(t *elbv2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
-}
-
 // tagsMapToHash returns a stable hash value for a raw tags map.
 // The returned value map be negative (i.e. not suitable for a 'Set' function).
 func tagsMapToHash(tags map[string]interface{}) int {
@@ -397,7 +423,7 @@ func ec2TagSpecificationsFromMap(m map[string]interface{}, t string) []*ec2.TagS
 	return []*ec2.TagSpecification{
 		{
 			ResourceType: aws.String(t),
-			Tags:         tagsFromMap(m),
+			Tags:         keyvaluetags.New(m).IgnoreAws().Ec2Tags(),
 		},
 	}
 }
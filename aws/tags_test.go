@@ -2,116 +2,16 @@ package aws
 
 import (
 	"fmt"
-	"reflect"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-func TestDiffTags(t *testing.T) {
-	cases := []struct {
-		Old, New       map[string]interface{}
-		Create, Remove map[string]string
-	}{
-		// Add
-		{
-			Old: map[string]interface{}{
-				"foo": "bar",
-			},
-			New: map[string]interface{}{
-				"foo": "bar",
-				"bar": "baz",
-			},
-			Create: map[string]string{
-				"bar": "baz",
-			},
-			Remove: map[string]string{},
-		},
-
-		// Modify
-		{
-			Old: map[string]interface{}{
-				"foo": "bar",
-			},
-			New: map[string]interface{}{
-				"foo": "baz",
-			},
-			Create: map[string]string{
-				"foo": "baz",
-			},
-			Remove: map[string]string{
-				"foo": "bar",
-			},
-		},
-
-		// Overlap
-		{
-			Old: map[string]interface{}{
-				"foo":   "bar",
-				"hello": "world",
-			},
-			New: map[string]interface{}{
-				"foo":   "baz",
-				"hello": "world",
-			},
-			Create: map[string]string{
-				"foo": "baz",
-			},
-			Remove: map[string]string{
-				"foo": "bar",
-			},
-		},
-
-		// Remove
-		{
-			Old: map[string]interface{}{
-				"foo": "bar",
-				"bar": "baz",
-			},
-			New: map[string]interface{}{
-				"foo": "bar",
-			},
-			Create: map[string]string{},
-			Remove: map[string]string{
-				"bar": "baz",
-			},
-		},
-	}
-
-	for i, tc := range cases {
-		c, r := diffTags(tagsFromMap(tc.Old), tagsFromMap(tc.New))
-		cm := tagsToMap(c)
-		rm := tagsToMap(r)
-		if !reflect.DeepEqual(cm, tc.Create) {
-			t.Fatalf("%d: bad create: %#v", i, cm)
-		}
-		if !reflect.DeepEqual(rm, tc.Remove) {
-			t.Fatalf("%d: bad remove: %#v", i, rm)
-		}
-	}
-}
-
-func TestIgnoringTags(t *testing.T) {
-	var ignoredTags []*ec2.Tag
-	ignoredTags = append(ignoredTags, &ec2.Tag{
-
-		Key:   aws.String("aws:cloudformation:logical-id"),
-		Value: aws.String("foo"),
-	})
-	ignoredTags = append(ignoredTags, &ec2.Tag{
-		Key:   aws.String("aws:foo:bar"),
-		Value: aws.String("baz"),
-	})
-	for _, tag := range ignoredTags {
-		if !tagIgnored(tag) {
-			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
-		}
-	}
-}
-
 func TestTagsMapToHash(t *testing.T) {
 	cases := []struct {
 		Left, Right map[string]interface{}
@@ -156,11 +56,76 @@ func TestTagsMapToHash(t *testing.T) {
 	}
 }
 
+func TestResourceWithTagsConfig(t *testing.T) {
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Read:   func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Update: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+	}
+
+	resourceWithTagsConfig(r)
+
+	if _, ok := r.Schema["tags_all"]; !ok {
+		t.Fatal("expected tags_all attribute")
+	}
+	if r.CustomizeDiff == nil {
+		t.Fatal("expected CustomizeDiff")
+	}
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	meta := &AWSClient{
+		defaultTagsConfig: &keyvaluetags.DefaultConfig{
+			Tags: keyvaluetags.New(map[string]string{
+				"owner": "team",
+				"env":   "test",
+			}),
+		},
+		ignoreTagsConfig: &keyvaluetags.IgnoreConfig{
+			Keys: keyvaluetags.New([]string{"ignored"}),
+		},
+	}
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"tags": map[string]interface{}{
+			"env":     "prod",
+			"ignored": "true",
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	diff, err := r.Diff(nil, terraform.NewResourceConfig(raw), meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for k, v := range map[string]string{
+		"tags_all.%":     "2",
+		"tags_all.owner": "team",
+		"tags_all.env":   "prod",
+	} {
+		if attr, ok := diff.Attributes[k]; !ok || attr.New != v {
+			t.Fatalf("expected %s to be %q, got diff: %#v", k, v, diff.Attributes)
+		}
+	}
+
+	if _, ok := diff.Attributes["tags_all.ignored"]; ok {
+		t.Fatalf("expected tags_all.ignored to be ignored, got diff: %#v", diff.Attributes)
+	}
+}
+
 // testAccCheckTags can be used to check the tags on a resource.
 func testAccCheckTags(
 	ts *[]*ec2.Tag, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMap(*ts)
+		m := keyvaluetags.Ec2KeyValueTags(*ts).IgnoreAws().Map()
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
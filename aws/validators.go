@@ -896,6 +896,35 @@ func validateIAMPolicyJson(v interface{}, k string) (ws []string, errors []error
 	return
 }
 
+// validateIAMIdentityPolicy returns a SchemaValidateFunc for policies attached
+// to IAM identities. Besides the validateIAMPolicyJson checks, the policy is
+// statically linted and must not be longer than maxLength non-whitespace
+// characters, 0 for no limit.
+func validateIAMIdentityPolicy(maxLength int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		if ws, errors = validateIAMPolicyJson(v, k); len(errors) > 0 {
+			return
+		}
+
+		errors = lintIAMPolicyDocument(v.(string), k, false, maxLength)
+		return
+	}
+}
+
+// validateIAMResourcePolicy returns a SchemaValidateFunc for resource-based
+// policies such as S3 bucket and role trust policies, which require a
+// principal. An empty policy is allowed.
+func validateIAMResourcePolicy(maxLength int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		if ws, errors = validation.ValidateJsonString(v, k); len(errors) > 0 || v.(string) == "" {
+			return
+		}
+
+		errors = lintIAMPolicyDocument(v.(string), k, true, maxLength)
+		return
+	}
+}
+
 func validateCloudFormationTemplate(v interface{}, k string) (ws []string, errors []error) {
 	if looksLikeJsonString(v) {
 		if _, err := structure.NormalizeJsonString(v); err != nil {
@@ -762,6 +762,138 @@ func TestValidateIAMPolicyJsonString(t *testing.T) {
 	}
 }
 
+func TestValidateIAMIdentityPolicy(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:Get*","s3:ListBucket"],"Resource":"arn:aws:s3:::example/*"}]}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    `{"Statement":{"Effect":"Deny","NotAction":"iam:*","NotResource":"*","Condition":{"ForAnyValue:StringLikeIfExists":{"aws:TagKeys":["a*"]},"Bool":{"aws:MultiFactorAuthPresent":false}}}}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    `{"xyz":[}}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Version":"2019-01-01","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotAction":"s3:PutObject","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Action":["s3*:GetObject","GetObject","s3:Get Object"],"Resource":"*"}]}`,
+			ErrCount: 3,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"example-bucket"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","NotResource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEqual":{"aws:username":"example"},"NullIfExists":{"aws:TokenIssueTime":"true"}}}]}`,
+			ErrCount: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateIAMIdentityPolicy(0)(tc.Value, "policy")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d: %v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+
+	_, errors := validateIAMIdentityPolicy(73)(`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`, "policy")
+	if len(errors) != 0 {
+		t.Fatalf("Expected whitespace not to count towards the size limit, got: %v", errors)
+	}
+
+	_, errors = validateIAMIdentityPolicy(72)(`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`, "policy")
+	if len(errors) != 1 {
+		t.Fatalf("Expected the size limit to trigger a validation error, got: %v", errors)
+	}
+}
+
+func TestValidateIAMResourcePolicy(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    ``,
+			ErrCount: 0,
+		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","123456789012","AIDAJQABLZS4A3QDU576Q"]},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"},{"Effect":"Deny","NotPrincipal":{"CanonicalUser":"79a59df900b949e55d96a1e698fbacedfd6e09d98eacf8f8d5218e7cd47ef2be"},"Action":"s3:*","Resource":"*"}]}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    `{"xyz":[}}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Principal":"ec2.amazonaws.com","Action":"sts:AssumeRole"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::*:root","example"]},"Action":"s3:GetObject","Resource":"*"}]}`,
+			ErrCount: 2,
+		},
+		{
+			Value:    `{"Statement":[{"Effect":"Allow","Principal":{"Service":"*"},"NotPrincipal":{"Account":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			ErrCount: 3,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateIAMResourcePolicy(0)(tc.Value, "policy")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d: %v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
+
 func TestValidateCloudFormationTemplate(t *testing.T) {
 	type testCases struct {
 		Value    string
@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func describeWorkspacesDirectory(conn *workspaces.WorkSpaces, id string) (*workspaces.WorkspaceDirectory, error) {
+	input := &workspaces.DescribeWorkspaceDirectoriesInput{
+		DirectoryIds: []*string{aws.String(id)},
+	}
+	var directory *workspaces.WorkspaceDirectory
+
+	err := conn.DescribeWorkspaceDirectoriesPages(input, func(page *workspaces.DescribeWorkspaceDirectoriesOutput, lastPage bool) bool {
+		for _, d := range page.Directories {
+			if aws.StringValue(d.DirectoryId) == id {
+				directory = d
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return directory, err
+}
+
+func refreshWorkspacesDirectoryState(conn *workspaces.WorkSpaces, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		directory, err := describeWorkspacesDirectory(conn, id)
+
+		if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if directory == nil || aws.StringValue(directory.State) == workspaces.WorkspaceDirectoryStateDeregistered {
+			return nil, "", nil
+		}
+
+		return directory, aws.StringValue(directory.State), nil
+	}
+}
+
+func waitForWorkspacesDirectoryRegistration(conn *workspaces.WorkSpaces, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workspaces.WorkspaceDirectoryStateRegistering},
+		Target:  []string{workspaces.WorkspaceDirectoryStateRegistered},
+		Refresh: refreshWorkspacesDirectoryState(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func decodeWorkspacesIpGroupAssociationID(id string) (string, string, error) {
+	parts := strings.Split(id, "_")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected DIRECTORY-ID_IP-GROUP-ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func describeWorkspacesIpGroup(conn *workspaces.WorkSpaces, id string) (*workspaces.IpGroup, error) {
+	output, err := conn.DescribeIpGroups(&workspaces.DescribeIpGroupsInput{
+		GroupIds: []*string{aws.String(id)},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range output.Result {
+		if aws.StringValue(group.GroupId) == id {
+			return group, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func describeWorkspacesWorkspace(conn *workspaces.WorkSpaces, id string) (*workspaces.Workspace, error) {
+	input := &workspaces.DescribeWorkspacesInput{
+		WorkspaceIds: []*string{aws.String(id)},
+	}
+	var workspace *workspaces.Workspace
+
+	err := conn.DescribeWorkspacesPages(input, func(page *workspaces.DescribeWorkspacesOutput, lastPage bool) bool {
+		for _, w := range page.Workspaces {
+			if aws.StringValue(w.WorkspaceId) == id {
+				workspace = w
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return workspace, err
+}
+
+func refreshWorkspacesWorkspaceState(conn *workspaces.WorkSpaces, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		workspace, err := describeWorkspacesWorkspace(conn, id)
+
+		if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if workspace == nil || aws.StringValue(workspace.State) == workspaces.WorkspaceStateTerminated {
+			return nil, "", nil
+		}
+
+		if aws.StringValue(workspace.State) == workspaces.WorkspaceStateError {
+			return workspace, workspaces.WorkspaceStateError, fmt.Errorf("%s: %s", aws.StringValue(workspace.ErrorCode), aws.StringValue(workspace.ErrorMessage))
+		}
+
+		return workspace, aws.StringValue(workspace.State), nil
+	}
+}
+
+func waitForWorkspacesWorkspaceCreation(conn *workspaces.WorkSpaces, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			workspaces.WorkspaceStatePending,
+			workspaces.WorkspaceStateStarting,
+		},
+		Target: []string{
+			workspaces.WorkspaceStateAvailable,
+			workspaces.WorkspaceStateStopped,
+		},
+		Refresh: refreshWorkspacesWorkspaceState(conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForWorkspacesWorkspaceUpdate(conn *workspaces.WorkSpaces, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workspaces.WorkspaceStateUpdating},
+		Target: []string{
+			workspaces.WorkspaceStateAvailable,
+			workspaces.WorkspaceStateStopped,
+		},
+		Refresh: refreshWorkspacesWorkspaceState(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForWorkspacesWorkspaceTermination(conn *workspaces.WorkSpaces, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			workspaces.WorkspaceStatePending,
+			workspaces.WorkspaceStateAvailable,
+			workspaces.WorkspaceStateImpaired,
+			workspaces.WorkspaceStateUnhealthy,
+			workspaces.WorkspaceStateRebooting,
+			workspaces.WorkspaceStateStarting,
+			workspaces.WorkspaceStateRebuilding,
+			workspaces.WorkspaceStateMaintenance,
+			workspaces.WorkspaceStateAdminMaintenance,
+			workspaces.WorkspaceStateSuspended,
+			workspaces.WorkspaceStateUpdating,
+			workspaces.WorkspaceStateStopping,
+			workspaces.WorkspaceStateStopped,
+			workspaces.WorkspaceStateTerminating,
+		},
+		Target:  []string{},
+		Refresh: refreshWorkspacesWorkspaceState(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func listWorkspacesTags(conn *workspaces.WorkSpaces, id string) ([]*workspaces.Tag, error) {
+	output, err := conn.DescribeTags(&workspaces.DescribeTagsInput{
+		ResourceId: aws.String(id),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.TagList, nil
+}